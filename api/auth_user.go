@@ -41,7 +41,7 @@ func (server *Server) getUser(ctx *gin.Context) {
 		return
 	}
 
-	balance, err := crypt.GetBalance(user.Address)
+	balance, err := server.chains.Default().GetBalance(user.Address)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
@@ -49,13 +49,20 @@ func (server *Server) getUser(ctx *gin.Context) {
 	}
 
 	rsp := interfaces.UserResponse{
-		Username:    user.Username,
-		Email:       user.Email,
-		Balance:     balance,
-		Address:     user.Address,
-		Biometrics:  user.Biometrics,
-		Avatar:      user.Avatar,
-		IsFirstTime: user.IsUsed,
+		Username:          user.Username,
+		Email:             user.Email,
+		Balance:           balance,
+		Address:           user.Address,
+		Biometrics:        user.Biometrics,
+		Avatar:            user.Avatar,
+		IsFirstTime:       user.IsUsed,
+		PreferredCurrency: user.PreferredCurrency,
+	}
+
+	rsp.Badges, err = server.userBadges(ctx, user.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
 	}
 
 	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
@@ -128,7 +135,7 @@ func (server *Server) getUserByAddress(ctx *gin.Context) {
 		return
 	}
 
-	balance, err := crypt.GetBalance(user.Address)
+	balance, err := server.chains.Default().GetBalance(user.Address)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
@@ -136,13 +143,14 @@ func (server *Server) getUserByAddress(ctx *gin.Context) {
 	}
 
 	rsp := interfaces.UserResponse{
-		Username:    user.Username,
-		Email:       user.Email,
-		Balance:     balance,
-		Address:     user.Address,
-		Biometrics:  user.Biometrics,
-		Avatar:      user.Avatar,
-		IsFirstTime: user.IsUsed,
+		Username:          user.Username,
+		Email:             user.Email,
+		Balance:           balance,
+		Address:           user.Address,
+		Biometrics:        user.Biometrics,
+		Avatar:            user.Avatar,
+		IsFirstTime:       user.IsUsed,
+		PreferredCurrency: user.PreferredCurrency,
 	}
 
 	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
@@ -233,7 +241,7 @@ func (server *Server) changePassword(ctx *gin.Context) {
 }
 
 // @Summary Get Private Key
-// @Description Get private key of user
+// @Description Get private key of user. The key cannot be exported while the user owns a campaign with milestones whose funds are not yet deposited into escrow.
 // @Accept  json
 // @Produce  json
 // @Tags Profile
@@ -273,6 +281,19 @@ func (server *Server) getPrivateKey(ctx *gin.Context) {
 		return
 	}
 
+	// settle pays milestone funds out of the contract to the owner's wallet before depositing them
+	// into escrow, so the key stays here until they are deposited
+	unescrowed, err := server.store.CountOwnerUnescrowedCampaigns(ctx, user.Address)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if unescrowed > 0 {
+		newErr := errors.New("private key cannot be exported until your milestone funds are deposited into escrow")
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(newErr, http.StatusConflict))
+		return
+	}
+
 	privateKey, address, err := crypt.DecryptPrivateKey(user.FilePath, server.config.PassPhase)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get badge metadata
+// @Description Get the ERC-1155 metadata of a supporter badge. The badge contract's base URI points here, so the response is the bare metadata JSON that wallets read rather than the usual envelope.
+// @Produce  json
+// @Tags Badges
+// @Param id path string true "Badge token ID, in decimal or as 64 hex digits, optionally followed by .json"
+// @Success		200				{object}    interfaces.BadgeMetadata	"success"
+// @Router /badges/{id} [get]
+func (server *Server) getBadgeMetadata(ctx *gin.Context) {
+	id, err := interfaces.BadgeTokenID(strings.TrimSuffix(ctx.Param("id"), ".json"))
+	if err != nil || id < 1 {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid badge ID"), http.StatusBadRequest))
+		return
+	}
+
+	badge, err := server.store.GetBadge(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("badge not found"), http.StatusNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.NewBadgeMetadata(badge))
+}
+
+// @Summary Get my badges
+// @Description Get the supporter badges minted, or being minted, to the user's addresses, including linked wallets
+// @Produce  json
+// @Tags Badges
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Badge}	"success"
+// @Router /user/badges [get]
+func (server *Server) listMyBadges(ctx *gin.Context) {
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	badges, err := server.userBadges(ctx, user.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, badges))
+}
+
+func (server *Server) userBadges(ctx *gin.Context, username string) ([]interfaces.Badge, error) {
+	rows, err := server.store.ListUserBadges(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	badges := make([]interfaces.Badge, 0, len(rows))
+	for _, row := range rows {
+		badges = append(badges, interfaces.NewBadge(row))
+	}
+	return badges, nil
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBadgeMetadata(t *testing.T) {
+	badge := db.Badges{ID: 255, ChainID: 11155111, CampaignID: 7, TierID: 2, Name: "Clean Water: Poster", Image: "https://example.com/water.png"}
+
+	testCases := []struct {
+		name          string
+		id            string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			id:   "255",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBadge(gomock.Any(), gomock.Eq(badge.ID)).Times(1).Return(badge, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var metadata interfaces.BadgeMetadata
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &metadata))
+				require.Equal(t, badge.Name, metadata.Name)
+				require.Equal(t, badge.Image, metadata.Image)
+				require.Len(t, metadata.Attributes, 3)
+			},
+		},
+		{
+			name: "HexID",
+			id:   "00000000000000000000000000000000000000000000000000000000000000ff.json",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBadge(gomock.Any(), gomock.Eq(badge.ID)).Times(1).Return(badge, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			id:   "9",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBadge(gomock.Any(), gomock.Any()).Times(1).Return(db.Badges{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidID",
+			id:   "abc",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBadge(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/badges/"+tc.id, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
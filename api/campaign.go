@@ -4,14 +4,17 @@ import (
 	"database/sql"
 	// "encoding/json"
 	"errors"
-	"fmt"
+	"math/big"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
-	"github.com/demola234/defiraise/defi"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/indexer"
 	crypt "github.com/demola234/defiraise/defi"
 	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/relayer"
 	"github.com/demola234/defiraise/token"
 	"github.com/demola234/defiraise/utils"
 	"github.com/gin-gonic/gin"
@@ -21,179 +24,73 @@ type CampaignCache struct {
 	Campaigns []interfaces.Campaigns `json:"campaigns"`
 }
 
+var weiPerEther = big.NewInt(1e18)
+
+// weiFloat converts a contract amount for the float fields of a campaign response
+func weiFloat(wei *big.Int) float64 {
+	amount, _ := new(big.Float).SetInt(wei).Float64()
+	return amount
+}
+
 // @Summary Get campaigns
-// @Description Get campaigns
+// @Description Get indexed campaigns in (contract version, campaign ID) order
 // @Accept  json
 // @Produce  json
 // @Tags Campaigns
 // @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
-// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Campaigns}	"success"
+// @Param currency query string false "Fiat currency for amounts (default: the user's preferred currency)"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: every version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.Campaigns}}	"success"
 // @Router /campaigns [get]
 func (server *Server) getCampaigns(ctx *gin.Context) {
-
-	cacheKey := string(interfaces.CacheKey(interfaces.AllCampaigns))
-	redisCache := utils.NewRedisCache()
-
-	// 🔍 Try fetching data from Redis
-	var cachedCampaigns []interfaces.Campaigns
-	err := redisCache.Get(cacheKey, &cachedCampaigns)
-	if err == nil {
-		fmt.Println("Cache hit")
-		ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, cachedCampaigns))
+	var req interfaces.ListCampaignRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
 		return
 	}
 
-	fmt.Println("Cache miss");
-
-
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
+		return
+	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-
 	if authPayload == nil {
 		err := errors.New(interfaces.ErrUserNotFound)
 		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
 		return
 	}
 
-	user, err := server.store.GetUser(ctx, authPayload.Username)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-		return
-	}
-
-	campaigns, err := defi.GetCampaigns(user.Address)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-		return
-	}
-
-	camps := make([]interfaces.Campaigns, len(campaigns))
-
-	// if camps is empty
-	if len(camps) == 0 {
-		ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, []string{}))
-		return
-	}
-
-	for i, campaign := range campaigns {
-		userInfo, _ := server.store.GetUserByAddress(ctx, campaign.Owner)
-
-		totalNumber, err := defi.GetTotalDonationsByCampaignId(i)
-
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-			return
-		}
-
-		//  Loop through the donators and amounts
-		donators, amounts, _, err := defi.GetDonorsAddressesAndAmounts(i)
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-			return
-		}
-
-		dons := make([]interfaces.DonorDetails, len(donators))
-
-		if len(donators) != 0 {
-			for k := range donators {
-				getUser, _ := server.store.GetUserByAddress(ctx, donators[k])
-
-				dons[k] = interfaces.DonorDetails{
-					Amount:   (float64(amounts[k]) / 1e18),
-					Donor:    donators[k],
-					Image:    getUser.Avatar,
-					Username: getUser.Username,
-				}
-			}
-
-			// wei to either
-
-			// if the deadline has been reached and skipped remove the campaign from the list of campaigns
-
-			camps[i] = interfaces.Campaigns{
-				CampaignType:       campaign.CampaignType,
-				Title:              campaign.Title,
-				Deadline:           time.Unix(int64(campaign.Deadline), 0),
-				Description:        campaign.Description,
-				Goal:               float64(campaign.Goal),
-				Image:              campaign.Image,
-				TotalAmountDonated: float64(campaign.TotalFunds),
-				TotalNumber:        totalNumber.Int64(),
-				Owner:              campaign.Owner,
-				ID:                 int(campaign.ID),
-				Donations:          dons,
-				User: []interfaces.UserResponseInfo{
-					{
-						Username: userInfo.Username,
-						Email:    userInfo.Email,
-						Address:  userInfo.Address,
-						Avatar:   userInfo.Avatar,
-					},
-				},
-			}
-		} else {
-			// skip the current iteration and remove empty campaign with empty description and title from list of campaigns to be displayed to the user on the frontend side of the application
-			// remove campaign if deadline is less than current time do not display to the user
-			// if deadline is less than current time
-
-			camps[i] = interfaces.Campaigns{
-				CampaignType:       campaign.CampaignType,
-				Title:              campaign.Title,
-				Deadline:           time.Unix(int64(campaign.Deadline), 0),
-				Description:        campaign.Description,
-				Goal:               float64(campaign.Goal),
-				Image:              campaign.Image,
-				TotalAmountDonated: float64(campaign.TotalFunds),
-				TotalNumber:        totalNumber.Int64(),
-				Owner:              campaign.Owner,
-				ID:                 int(campaign.ID),
-				Donations:          dons,
-				User: []interfaces.UserResponseInfo{
-					{
-						Username: userInfo.Username,
-						Email:    userInfo.Email,
-						Address:  userInfo.Address,
-						Avatar:   userInfo.Avatar,
-					},
-				},
-			}
-
-		}
-	}
-
-	redisCache.Set(cacheKey, camps, 10*time.Minute)
-
-	//  if deadline is less than current time remove the element from the list of campaigns to be displayed to the user
-
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, camps))
+	server.respondCampaignPage(ctx, req, chain, campaignFilter{})
 }
 
 // @Summary Get latest active campaigns
-// @Description Get latest active campaigns
+// @Description Get indexed campaigns whose deadline has not passed, in (contract version, campaign ID) order
 // @Accept  json
 // @Produce  json
 // @Tags Campaigns
 // @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
-// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Campaigns}	"success"
+// @Param currency query string false "Fiat currency for amounts (default: the user's preferred currency)"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: every version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.Campaigns}}	"success"
 // @Router /campaigns/latestCampaigns [get]
 func (server *Server) getLatestActiveCampaigns(ctx *gin.Context) {
-	cacheKey := string(interfaces.CacheKey(interfaces.LatestActiveCampaigns))
-	redisCache := utils.NewRedisCache()
-
-	// 🔍 Try fetching data from Redis
-	var cachedCampaigns []interfaces.Campaigns
-	err := redisCache.Get(cacheKey, &cachedCampaigns)
-	if err == nil {
-		fmt.Println("Cache hit for Latest Active Campaigns")
-		ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, cachedCampaigns))
+	var req interfaces.ListCampaignRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
 		return
 	}
 
-	fmt.Println("Cache miss for Latest Active Campaigns")
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
+		return
+	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	if authPayload == nil {
@@ -201,216 +98,108 @@ func (server *Server) getLatestActiveCampaigns(ctx *gin.Context) {
 		return
 	}
 
-	user, err := server.store.GetUser(ctx, authPayload.Username)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-		return
-	}
-
-	campaigns, err := defi.GetCampaigns(user.Address)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-		return
-	}
-
-	var activeCampaigns []interfaces.Campaigns
-	for i, campaign := range campaigns {
-		deadline := time.Unix(int64(campaign.Deadline), 0)
-		if time.Now().After(deadline) {
-			continue // Skip expired campaigns
-		}
-
-		userInfo, _ := server.store.GetUserByAddress(ctx, campaign.Owner)
-		totalNumber, _ := defi.GetTotalDonationsByCampaignId(i)
-
-		activeCampaigns = append(activeCampaigns, interfaces.Campaigns{
-			CampaignType: campaign.CampaignType,
-			Title:        campaign.Title,
-			Deadline:     deadline,
-			Description:  campaign.Description,
-			Goal:         float64(campaign.Goal),
-			Image:        campaign.Image,
-			TotalAmountDonated: float64(campaign.TotalFunds),
-			TotalNumber:        totalNumber.Int64(),
-			Owner:              campaign.Owner,
-			ID:                 int(campaign.ID),
-			User: []interfaces.UserResponseInfo{
-				{
-					Username: userInfo.Username,
-					Email:    userInfo.Email,
-					Address:  userInfo.Address,
-					Avatar:   userInfo.Avatar,
-				},
-			},
-		})
-	}
-
-	// ✅ Cache results
-	redisCache.Set(cacheKey, activeCampaigns, 10*time.Minute)
-
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, activeCampaigns))
+	server.respondCampaignPage(ctx, req, chain, campaignFilter{activeOnly: true})
 }
 
-
 // @Summary Get Campaigns by category
-// @Description Get Campaigns by category
+// @Description Get active indexed campaigns of a category, in (contract version, campaign ID) order
 // @Accept  json
 // @Produce  json
 // @Tags Campaigns
 // @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
 // @Param id path int true "Category ID"
-// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Campaigns}	"success"
+// @Param currency query string false "Fiat currency for amounts (default: the user's preferred currency)"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: every version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.Campaigns}}	"success"
 // @Router /campaigns/categories/{id} [get]
 func (server *Server) getCampaignsByCategory(ctx *gin.Context) {
+	var req interfaces.ListCampaignRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
 	id := ctx.Param("id")
-	idL, err := strconv.Atoi(id)
-	if err != nil {
+	if _, err := strconv.Atoi(id); err != nil {
 		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid category ID"), http.StatusBadRequest))
 		return
 	}
 
-	cacheKey := fmt.Sprintf("campaigns_category_%d", idL)
-	redisCache := utils.NewRedisCache()
-
-	// 🔍 Check Redis Cache
-	var cachedCampaigns []interfaces.Campaigns
-	err = redisCache.Get(cacheKey, &cachedCampaigns)
-	if err == nil {
-		fmt.Println("Cache hit for Campaigns By Category")
-		ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, cachedCampaigns))
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
 		return
 	}
 
-	fmt.Println("Cache miss for Campaigns By Category")
-
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	if authPayload == nil {
 		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New(interfaces.ErrUserNotFound), http.StatusNotFound))
 		return
 	}
 
-	campaigns, err := defi.GetCampaignByCategory(int64(idL))
+	// campaigns are indexed under their category's name, which only the contract maps to its ID
+	categories, err := chain.GetCategories()
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
 
-	activeCampaigns := []interfaces.Campaigns{}
-	for i, campaign := range campaigns {
-		deadline := time.Unix(int64(campaign.Deadline), 0)
-		if time.Now().After(deadline) {
-			continue
+	var category sql.NullString
+	for _, c := range categories {
+		if c.ID == id {
+			category = sql.NullString{String: c.Name, Valid: true}
+			break
 		}
-
-		userInfo, _ := server.store.GetUserByAddress(ctx, campaign.Owner)
-		totalNumber, _ := defi.GetTotalDonationsByCampaignId(i)
-
-		activeCampaigns = append(activeCampaigns, interfaces.Campaigns{
-			CampaignType:       campaign.CampaignType,
-			Title:              campaign.Title,
-			Deadline:           deadline,
-			Description:        campaign.Description,
-			Goal:               float64(campaign.Goal),
-			Image:              campaign.Image,
-			TotalAmountDonated: float64(campaign.TotalFunds),
-			TotalNumber:        totalNumber.Int64(),
-			Owner:              campaign.Owner,
-			ID:                 int(campaign.ID),
-			User: []interfaces.UserResponseInfo{
-				{
-					Username: userInfo.Username,
-					Email:    userInfo.Email,
-					Address:  userInfo.Address,
-					Avatar:   userInfo.Avatar,
-				},
-			},
-		})
+	}
+	if !category.Valid {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("category not found"), http.StatusNotFound))
+		return
 	}
 
-	// ✅ Store in Redis
-	redisCache.Set(cacheKey, activeCampaigns, 10*time.Minute)
-
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, activeCampaigns))
+	server.respondCampaignPage(ctx, req, chain, campaignFilter{activeOnly: true, category: category})
 }
 
-
 // @Summary Get Campaigns by owner
-// @Description Get Campaigns by owner
+// @Description Get the user's active indexed campaigns, in (contract version, campaign ID) order
 // @Accept  json
 // @Produce  json
 // @Tags Campaigns
 // @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
-// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Campaigns}	"success"
+// @Param currency query string false "Fiat currency for amounts (default: the user's preferred currency)"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: every version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.Campaigns}}	"success"
 // @Router /campaigns/owner [get]
 func (server *Server) getCampaignsByOwner(ctx *gin.Context) {
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if authPayload == nil {
-		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New(interfaces.ErrUserNotFound), http.StatusNotFound))
+	var req interfaces.ListCampaignRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
 		return
 	}
 
-	user, err := server.store.GetUser(ctx, authPayload.Username)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
 		return
 	}
 
-	cacheKey := fmt.Sprintf("campaigns_owner_%s", user.Address)
-	redisCache := utils.NewRedisCache()
-
-	// 🔍 Try fetching from Redis
-	var cachedCampaigns []interfaces.Campaigns
-	err = redisCache.Get(cacheKey, &cachedCampaigns)
-	if err == nil {
-		fmt.Println("Cache hit for Campaigns By Owner")
-		ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, cachedCampaigns))
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload == nil {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New(interfaces.ErrUserNotFound), http.StatusNotFound))
 		return
 	}
 
-	fmt.Println("Cache miss for Campaigns By Owner")
-
-	campaigns, err := defi.GetCampaignsByOwner(user.Address)
+	user, err := server.store.GetUser(ctx, authPayload.Username)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
 
-	activeCampaigns := []interfaces.Campaigns{}
-	for i, campaign := range campaigns {
-		deadline := time.Unix(int64(campaign.Deadline), 0)
-		if time.Now().After(deadline) {
-			continue
-		}
-
-		userInfo, _ := server.store.GetUserByAddress(ctx, campaign.Owner)
-		totalNumber, _ := defi.GetTotalDonationsByCampaignId(i)
-
-		activeCampaigns = append(activeCampaigns, interfaces.Campaigns{
-			CampaignType:       campaign.CampaignType,
-			Title:              campaign.Title,
-			Deadline:           deadline,
-			Description:        campaign.Description,
-			Goal:               float64(campaign.Goal),
-			Image:              campaign.Image,
-			TotalAmountDonated: float64(campaign.TotalFunds),
-			TotalNumber:        totalNumber.Int64(),
-			Owner:              campaign.Owner,
-			ID:                 int(campaign.ID),
-			User: []interfaces.UserResponseInfo{
-				{
-					Username: userInfo.Username,
-					Email:    userInfo.Email,
-					Address:  userInfo.Address,
-					Avatar:   userInfo.Avatar,
-				},
-			},
-		})
-	}
-
-	// ✅ Cache results
-	redisCache.Set(cacheKey, activeCampaigns, 10*time.Minute)
-
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, activeCampaigns))
+	owner := sql.NullString{String: user.Address, Valid: true}
+	server.respondCampaignPage(ctx, req, chain, campaignFilter{activeOnly: true, owner: owner})
 }
 
 func (server *Server) getCampaign(ctx *gin.Context) {
@@ -422,6 +211,17 @@ func (server *Server) getCampaign(ctx *gin.Context) {
 		return
 	}
 
+	var currencyReq interfaces.CurrencyRequest
+	if err := ctx.ShouldBindQuery(&currencyReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
 	if authPayload == nil {
@@ -446,21 +246,21 @@ func (server *Server) getCampaign(ctx *gin.Context) {
 		return
 	}
 
-	campaign, err := defi.GetCampaign(idL)
+	campaign, err := chain.GetCampaign(idL)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
 
-	totalNumber, err := defi.GetTotalDonationsByCampaignId(idL)
+	totalNumber, err := chain.GetTotalDonationsByCampaignId(idL)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
 
-	donators, amounts, _, err := defi.GetDonorsAddressesAndAmounts(idL)
+	donators, amounts, _, err := chain.GetDonorsAddressesAndAmounts(idL)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
@@ -486,15 +286,17 @@ func (server *Server) getCampaign(ctx *gin.Context) {
 	deadline := time.Unix(int64(campaign.Deadline), 0)
 
 	camp := interfaces.Campaigns{
-		CampaignType: campaign.CampaignType,
-		Title:        campaign.Title,
-		Description:  campaign.Description,
-		Deadline:     deadline,
-		Goal:         float64(campaign.Goal / 1000000000000000000),
-		Image:        campaign.Image,
-		TotalNumber:  totalNumber.Int64(),
-		Owner:        campaign.Owner,
-		ID:           int(campaign.ID),
+		CampaignType:    campaign.CampaignType,
+		Title:           campaign.Title,
+		Description:     campaign.Description,
+		Deadline:        deadline,
+		Goal:            weiFloat(new(big.Int).Quo(campaign.Goal, weiPerEther)),
+		Image:           campaign.Image,
+		TotalNumber:     totalNumber.Int64(),
+		Owner:           campaign.Owner,
+		ID:              int(campaign.ID),
+		ChainID:         chain.ID,
+		ContractVersion: chain.Version,
 		User: []interfaces.UserResponseInfo{
 			{
 				Username: user.Username,
@@ -503,14 +305,27 @@ func (server *Server) getCampaign(ctx *gin.Context) {
 				Avatar:   user.Avatar,
 			},
 		},
-		TotalAmountDonated: float64(campaign.TotalFunds / 1000000000000000000),
+		TotalAmountDonated: weiFloat(new(big.Int).Quo(campaign.TotalFunds, weiPerEther)),
 		Donations:          dons,
+		Amounts:            interfaces.NewCampaignAmounts(chain.NativeSymbol, campaign.Goal.String(), campaign.TotalFunds.String()),
 	}
 
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, camp))
+	// a single campaign is valued in its own display currency unless another is requested
+	camps := []interfaces.Campaigns{camp}
+	if err := server.withFiat(ctx, camps, strings.ToUpper(currencyReq.Currency)); err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, camps[0]))
 }
 
 func (server *Server) getCampaignTypes(ctx *gin.Context) {
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
 	if authPayload == nil {
@@ -529,7 +344,7 @@ func (server *Server) getCampaignTypes(ctx *gin.Context) {
 		return
 	}
 
-	campaignTypes, err := defi.GetCampaignTypes(user.Address)
+	campaignTypes, err := chain.GetCampaignTypes(user.Address)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
@@ -545,9 +360,24 @@ func (server *Server) getCampaignTypes(ctx *gin.Context) {
 // @Tags Campaigns
 // @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
 // @Param id path int true "Campaign ID"
-// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.DonorDetails}	"success"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.DonorDetails}}	"success"
 // @Router /campaigns/donors/{id} [get]
 func (server *Server) getCampaignDonors(ctx *gin.Context) {
+	var pageReq interfaces.PageRequest
+	if err := ctx.ShouldBindQuery(&pageReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+
 	id := ctx.Param("id")
 	// convert string id to int
 	idL, err := strconv.Atoi(id)
@@ -574,29 +404,35 @@ func (server *Server) getCampaignDonors(ctx *gin.Context) {
 	// 	return
 	// }
 
-	donators, amounts, _, err := defi.GetDonorsAddressesAndAmounts(idL)
+	donators, amounts, _, err := chain.GetDonorsAddressesAndAmounts(idL)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
 
-	dons := make([]interfaces.DonorDetails, len(donators))
-
-	if len(donators) != 0 {
-		for k := range donators {
-			getUser, _ := server.store.GetUserByAddress(ctx, donators[k])
+	start, end, next, err := pageOffset(len(donators), pageReq)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
 
-			dons[k] = interfaces.DonorDetails{
-				Amount:   (float64(amounts[k]) / 1e18),
-				Donor:    donators[k],
-				Image:    getUser.Avatar,
-				Username: getUser.Username,
-			}
-		}
+	dons := make([]interfaces.DonorDetails, 0, end-start)
+	for k := start; k < end; k++ {
+		getUser, _ := server.store.GetUserByAddress(ctx, donators[k])
 
+		dons = append(dons, interfaces.DonorDetails{
+			Amount:   (float64(amounts[k]) / 1e18),
+			Donor:    donators[k],
+			Image:    getUser.Avatar,
+			Username: getUser.Username,
+		})
 	}
 
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, dons))
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.Page{
+		Items:      dons,
+		NextCursor: next,
+		TotalCount: int64(len(donators)),
+	}))
 }
 
 // @Summary Donate to campaign
@@ -617,6 +453,11 @@ func (server *Server) donateToCampaign(ctx *gin.Context) {
 		return
 	}
 
+	chain, ok := server.chainByID(ctx, donation.ChainID, donation.ContractVersion)
+	if !ok {
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
 	if authPayload == nil {
@@ -656,8 +497,19 @@ func (server *Server) donateToCampaign(ctx *gin.Context) {
 		return
 	}
 
+	// the same wei is checked against the tier, sent and recorded
+	wei := utils.EtherToWeiInt(amount)
+
+	var tier db.RewardTiers
+	if donation.RewardTierID != 0 {
+		tier, ok = server.donationRewardTier(ctx, chain, int64(idL), donation.RewardTierID, wei)
+		if !ok {
+			return
+		}
+	}
+
 	// convert balance from string to float64
-	balance, err := defi.GetBalance(user.Address)
+	balance, err := chain.GetBalance(user.Address)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
 		return
@@ -670,7 +522,7 @@ func (server *Server) donateToCampaign(ctx *gin.Context) {
 		return
 	}
 
-	campaign, err := defi.GetCampaign(idL)
+	campaign, err := chain.GetCampaign(idL)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
@@ -693,24 +545,54 @@ func (server *Server) donateToCampaign(ctx *gin.Context) {
 	}
 
 	// check if campaign amount is greater than amount to be donated
-	if float64(amount) > float64(campaign.Goal) {
+	if wei.Cmp(campaign.Goal) > 0 {
 		newErr := errors.New("amount to be donated is greater than campaign amount")
 		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(newErr, http.StatusBadRequest))
 		return
 	}
 
 	// if goal is reached, close campaign
-	if float64(campaign.TotalFunds) >= float64(campaign.Goal) {
+	if campaign.TotalFunds.Cmp(campaign.Goal) >= 0 {
 		newErr := errors.New("campaign has closed")
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(newErr, http.StatusInternalServerError))
 		return
 	}
 
-	msg, err := defi.Donate(amount, idL, privateKey, address)
+	sponsorship, ok := server.sponsorGas(ctx, chain, user, relayer.ActionDonate, wei, func() (uint64, error) {
+		return chain.DonateGas(ctx, wei, idL, address)
+	})
+	if !ok {
+		return
+	}
+
+	// the tier's claim is reserved before the donation is sent so concurrent donors cannot oversell it
+	if tier.ID != 0 && !server.reserveRewardTier(ctx, tier.ID) {
+		return
+	}
+
+	msg, err := chain.DonateWei(wei, idL, privateKey, address)
 	if err != nil {
+		if tier.ID != 0 {
+			server.releaseRewardTier(ctx, tier.ID)
+		}
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
+	server.recordSponsoredAction(ctx, sponsorship, msg)
+
+	record, recordErr := indexer.RecordSentDonation(ctx, server.store, indexer.SentDonation{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      int64(idL),
+		DonorAddress:    address,
+		Amount:          wei,
+		Token:           chain.NativeSymbol,
+		TxHash:          msg,
+	})
+
+	if tier.ID != 0 {
+		server.claimReward(ctx, tier, user, record, recordErr)
+	}
 
 	redisCache := utils.NewRedisCache()
 	redisCache.InvalidateAllCampaignCaches()
@@ -729,6 +611,7 @@ func (server *Server) donateToCampaign(ctx *gin.Context) {
 // @Param   goal        formData   string    true  "Goal"
 // @Param   deadline        formData   string    true  "Deadline"
 // @Param   category        formData   string    true  "Category"
+// @Param   chain_id        formData   int    false  "Chain ID (default: the default chain)"
 // @Param   image        formData   file    true  "Image"
 // @Success		200				{object}   interfaces.DocSuccessResponse "hex"
 // @Router /campaigns [post]
@@ -745,6 +628,21 @@ func (server *Server) createCampaign(ctx *gin.Context) {
 	campaignDeadline := ctx.Request.FormValue("deadline")
 	campaignCategory := ctx.Request.FormValue("category")
 
+	var chainID int64
+	if value := ctx.Request.FormValue("chain_id"); value != "" {
+		chainID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid chain ID"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	// new campaigns are always created on the current contract version
+	chain, ok := server.chainByID(ctx, chainID, 0)
+	if !ok {
+		return
+	}
+
 	// upload image to cloudinary
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
@@ -802,52 +700,24 @@ func (server *Server) createCampaign(ctx *gin.Context) {
 		return
 	}
 
-	campaigns, err := defi.CreateCampaign(campaignTitle, campaignCategory, campaignDescription, goal, deadline, uploadResult, privateKey, address)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+	sponsorship, ok := server.sponsorGas(ctx, chain, user, relayer.ActionCreateCampaign, nil, func() (uint64, error) {
+		return chain.CreateCampaignGas(ctx, campaignTitle, campaignCategory, campaignDescription, goal, deadline, uploadResult, address)
+	})
+	if !ok {
 		return
 	}
 
-	redisCache := utils.NewRedisCache()
-	redisCache.InvalidateAllCampaignCaches()
-
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, campaigns))
-}
-
-// @Summary Get My Donations
-// @Description Get My Donations
-// @Accept  json
-// @Produce  json
-// @Tags Campaigns
-// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
-// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]crypt.Campaign}	"success"
-// @Router /campaigns/donations [get]
-func (server *Server) getMyDonations(ctx *gin.Context) {
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-
-	if authPayload == nil {
-		err := errors.New(interfaces.ErrUserNotFound)
-		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
-		return
-	}
-
-	user, err := server.store.GetUser(ctx, authPayload.Username)
+	campaigns, err := chain.CreateCampaign(campaignTitle, campaignCategory, campaignDescription, goal, deadline, uploadResult, privateKey, address)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
-			return
-		}
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
+	server.recordSponsoredAction(ctx, sponsorship, campaigns)
 
-	donations, err := defi.GetCampaignsByOwner(user.Address)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-		return
-	}
+	redisCache := utils.NewRedisCache()
+	redisCache.InvalidateAllCampaignCaches()
 
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, donations))
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, campaigns))
 }
 
 // @Summary Withdraw from campaign
@@ -868,6 +738,23 @@ func (server *Server) withdrawFromCampaign(ctx *gin.Context) {
 		return
 	}
 
+	chain, ok := server.chainByID(ctx, withdraw.ChainID, withdraw.ContractVersion)
+	if !ok {
+		return
+	}
+
+	// funds of a campaign with milestones are paid into escrow by settle and released per milestone
+	staged, err := server.hasMilestones(ctx, chain, int64(withdraw.CampaignId))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if staged {
+		err := errors.New("campaign funds are released per milestone")
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
 	if authPayload == nil {
@@ -892,11 +779,19 @@ func (server *Server) withdrawFromCampaign(ctx *gin.Context) {
 		return
 	}
 
-	msg, err := defi.PayOut(withdraw.CampaignId, address, privateKey)
+	sponsorship, ok := server.sponsorGas(ctx, chain, user, relayer.ActionWithdraw, nil, func() (uint64, error) {
+		return chain.PayOutGas(ctx, withdraw.CampaignId, address)
+	})
+	if !ok {
+		return
+	}
+
+	msg, err := chain.PayOut(withdraw.CampaignId, address, privateKey)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
+	server.recordSponsoredAction(ctx, sponsorship, msg)
 
 	redisCache := utils.NewRedisCache()
 	redisCache.InvalidateAllCampaignCaches()
@@ -904,32 +799,22 @@ func (server *Server) withdrawFromCampaign(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, msg))
 }
 
-// @Summary Get Current ETH Price
-// @Description Get Current ETH Price
-// @Accept  json
-// @Produce  json
-// @Tags Campaigns
-// @Success		200				{object}    interfaces.DocSuccessResponse	"success"
-// @Router /currentPrice [get]
-func (server *Server) currentEthPrice(ctx *gin.Context) {
-	price, err := defi.GetEthPrice()
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-		return
-	}
-
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, price))
-}
-
 // @Summary Get Campaign Categories
 // @Description Get Campaign Categories
 // @Accept  json
 // @Produce  json
 // @Tags Campaigns
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
 // @Success		200				{object}    interfaces.DocSuccessResponse{data=[]crypt.CampaignCategory}	"success"
 // @Failure		404				{object}    interfaces.DocSuccessResponse
 // @Router /categories [get]
 func (server *Server) getCategories(ctx *gin.Context) {
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 
 	if authPayload == nil {
@@ -948,7 +833,7 @@ func (server *Server) getCategories(ctx *gin.Context) {
 		return
 	}
 
-	campaigns, err := defi.GetCategories()
+	campaigns, err := chain.GetCategories()
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
@@ -968,130 +853,3 @@ func (server *Server) getCategories(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, camps))
 
 }
-
-// @Summary Search Campaign by name
-// @Description Search Campaign by name
-// @Accept  json
-// @Produce  json
-// @Tags Campaigns
-// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
-// @Param   data        body   interfaces.SearchCampaignRequest[types]    true  "SearchCampaignRequest"
-// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Campaigns}	"success"
-// @Router /search [post]
-func (server *Server) searchCampaignByName(ctx *gin.Context) {
-	var req interfaces.SearchCampaignRequest
-
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
-		return
-	}
-
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if authPayload == nil {
-		err := errors.New(interfaces.ErrUserNotFound)
-		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
-	}
-
-	campaigns, err := defi.SearchCampaigns(req.Name)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-		return
-	}
-
-	camps := make([]interfaces.Campaigns, len(campaigns))
-
-	for i, campaign := range campaigns {
-		userInfo, _ := server.store.GetUserByAddress(ctx, campaign.Owner)
-
-		totalNumber, err := defi.GetTotalDonationsByCampaignId(i)
-
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-			return
-		}
-
-		//  Loop through the donators and amounts
-		donators, amounts, _, err := defi.GetDonorsAddressesAndAmounts(i)
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
-			return
-		}
-
-		dons := make([]interfaces.DonorDetails, len(donators))
-
-		// convert unix to time.Time
-		deadline := time.Unix(int64(campaign.Deadline), 0)
-		// if deadline is less than current time
-		if deadline.Before(time.Now()) {
-			// skip the current iteration
-			continue
-		} else {
-			if len(donators) != 0 {
-				for k := range donators {
-					getUser, _ := server.store.GetUserByAddress(ctx, donators[k])
-
-					dons[k] = interfaces.DonorDetails{
-						Amount:   (float64(amounts[k]) / 1e18),
-						Donor:    donators[k],
-						Image:    getUser.Avatar,
-						Username: getUser.Username,
-					}
-
-					// wei to either
-					goal := (float64(campaign.Goal) / 1e18)
-					totalAmountDonated := (float64(campaign.TotalFunds) / 1e18)
-
-					camps[i] = interfaces.Campaigns{
-						CampaignType:       campaign.CampaignType,
-						Title:              campaign.Title,
-						Deadline:           time.Unix(int64(campaign.Deadline), 0),
-						Description:        campaign.Description,
-						Goal:               goal,
-						Image:              campaign.Image,
-						TotalAmountDonated: totalAmountDonated,
-						TotalNumber:        totalNumber.Int64(),
-						Owner:              campaign.Owner,
-						ID:                 int(campaign.ID),
-						Donations:          dons,
-						User: []interfaces.UserResponseInfo{
-							{
-								Username: userInfo.Username,
-								Email:    userInfo.Email,
-								Address:  userInfo.Address,
-								Avatar:   userInfo.Avatar,
-							},
-						},
-					}
-				}
-			} else {
-				goal := float64(campaign.Goal / 1e18)
-				totalAmountDonated := float64(campaign.TotalFunds / 1e18)
-
-				camps[i] = interfaces.Campaigns{
-					CampaignType:       campaign.CampaignType,
-					Title:              campaign.Title,
-					Deadline:           time.Unix(int64(campaign.Deadline), 0),
-					Description:        campaign.Description,
-					Goal:               goal,
-					Image:              campaign.Image,
-					TotalAmountDonated: totalAmountDonated,
-					TotalNumber:        totalNumber.Int64(),
-					Owner:              campaign.Owner,
-					ID:                 int(campaign.ID),
-					Donations:          dons,
-					User: []interfaces.UserResponseInfo{
-						{
-							Username: userInfo.Username,
-							Email:    userInfo.Email,
-							Address:  userInfo.Address,
-							Avatar:   userInfo.Avatar,
-						},
-					},
-				}
-			}
-		}
-
-	}
-
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, camps))
-}
@@ -0,0 +1,208 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/token"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// searchCursor is the keyset position of the last campaign on a search page
+type searchCursor struct {
+	ID         int64     `json:"id"`
+	Version    int32     `json:"version"`
+	Deadline   time.Time `json:"deadline,omitempty"`
+	TotalFunds string    `json:"total_funds,omitempty"`
+}
+
+// @Summary Search campaigns
+// @Description Full-text search over indexed campaigns with filters, sorting and cursor pagination
+// @Accept  json
+// @Produce  json
+// @Tags Campaigns
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param query query string false "Text matched against title, description and category"
+// @Param status query string false "active, expired or funded"
+// @Param min_goal query number false "Minimum goal in ETH"
+// @Param max_goal query number false "Maximum goal in ETH"
+// @Param min_percent_funded query number false "Minimum percent funded"
+// @Param max_percent_funded query number false "Maximum percent funded"
+// @Param sort query string false "newest, ending_soon or most_funded (default: newest)"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: every version)"
+// @Param currency query string false "Fiat currency for amounts (default: the user's preferred currency)"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.Campaigns}}	"success"
+// @Router /campaigns/search [get]
+func (server *Server) searchCampaigns(ctx *gin.Context) {
+	var req interfaces.SearchCampaignRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload == nil {
+		err := errors.New(interfaces.ErrUserNotFound)
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+		return
+	}
+
+	var cursor searchCursor
+	if req.Cursor != "" {
+		if err := decodeCursor(req.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	query := sql.NullString{String: req.Query, Valid: req.Query != ""}
+	status := sql.NullString{String: req.Status, Valid: req.Status != ""}
+	minGoal := nullWei(req.MinGoal)
+	maxGoal := nullWei(req.MaxGoal)
+	minPercent := nullFloat(req.MinPercentFunded)
+	maxPercent := nullFloat(req.MaxPercentFunded)
+	cursorID := sql.NullInt64{Int64: cursor.ID, Valid: req.Cursor != ""}
+	cursorVersion := sql.NullInt32{Int32: cursor.Version, Valid: req.Cursor != ""}
+	// without a contract_version, every version of the chain is searched
+	contractVersion := sql.NullInt32{Int32: int32(req.ContractVersion), Valid: req.ContractVersion != 0}
+
+	// fetch one extra row to learn whether another page exists
+	pageSize := req.PageSize()
+	limit := pageSize + 1
+
+	var campaigns []db.IndexedCampaigns
+	var err error
+
+	switch req.Sort {
+	case interfaces.SortEndingSoon:
+		campaigns, err = server.store.SearchCampaignsEndingSoon(ctx, db.SearchCampaignsEndingSoonParams{
+			ChainID:          chain.ID,
+			ContractVersion:  contractVersion,
+			Query:            query,
+			Status:           status,
+			MinGoal:          minGoal,
+			MaxGoal:          maxGoal,
+			MinPercentFunded: minPercent,
+			MaxPercentFunded: maxPercent,
+			CursorDeadline:   sql.NullTime{Time: cursor.Deadline, Valid: req.Cursor != ""},
+			CursorVersion:    cursorVersion,
+			CursorID:         cursorID,
+			Limit:            limit,
+		})
+	case interfaces.SortMostFunded:
+		campaigns, err = server.store.SearchCampaignsMostFunded(ctx, db.SearchCampaignsMostFundedParams{
+			ChainID:          chain.ID,
+			ContractVersion:  contractVersion,
+			Query:            query,
+			Status:           status,
+			MinGoal:          minGoal,
+			MaxGoal:          maxGoal,
+			MinPercentFunded: minPercent,
+			MaxPercentFunded: maxPercent,
+			CursorFunds:      sql.NullString{String: cursor.TotalFunds, Valid: req.Cursor != ""},
+			CursorVersion:    cursorVersion,
+			CursorID:         cursorID,
+			Limit:            limit,
+		})
+	default:
+		campaigns, err = server.store.SearchCampaignsNewest(ctx, db.SearchCampaignsNewestParams{
+			ChainID:          chain.ID,
+			ContractVersion:  contractVersion,
+			Query:            query,
+			Status:           status,
+			MinGoal:          minGoal,
+			MaxGoal:          maxGoal,
+			MinPercentFunded: minPercent,
+			MaxPercentFunded: maxPercent,
+			CursorVersion:    cursorVersion,
+			CursorID:         cursorID,
+			Limit:            limit,
+		})
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	total, err := server.store.CountSearchCampaigns(ctx, db.CountSearchCampaignsParams{
+		ChainID:          chain.ID,
+		ContractVersion:  contractVersion,
+		Query:            query,
+		Status:           status,
+		MinGoal:          minGoal,
+		MaxGoal:          maxGoal,
+		MinPercentFunded: minPercent,
+		MaxPercentFunded: maxPercent,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	page := interfaces.Page{TotalCount: total}
+
+	if len(campaigns) > int(pageSize) {
+		campaigns = campaigns[:pageSize]
+
+		last := campaigns[len(campaigns)-1]
+		page.NextCursor, err = encodeCursor(searchCursor{
+			ID:         last.CampaignID,
+			Version:    last.ContractVersion,
+			Deadline:   last.Deadline,
+			TotalFunds: last.TotalFunds,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	items := make([]interfaces.Campaigns, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		owner, _ := server.store.GetUserByAddress(ctx, campaign.Owner)
+		items = append(items, interfaces.NewIndexedCampaign(campaign, owner, chain.NativeSymbol))
+	}
+
+	currency, err := server.listCurrency(ctx, req.Currency)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if err := server.withFiat(ctx, items, currency); err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	page.Items = items
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
+}
+
+// nullWei converts an optional ether filter into a nullable wei amount
+func nullWei(ether *float64) sql.NullString {
+	if ether == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: utils.EtherToWei(*ether), Valid: true}
+}
+
+func nullFloat(value *float64) sql.NullFloat64 {
+	if value == nil {
+		return sql.NullFloat64{}
+	}
+
+	return sql.NullFloat64{Float64: *value, Valid: true}
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type campaignPage struct {
+	Data struct {
+		Items      []interfaces.Campaigns `json:"items"`
+		NextCursor string                 `json:"next_cursor"`
+		TotalCount int64                  `json:"total_count"`
+	} `json:"data"`
+}
+
+func TestListCampaigns(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xAbC0000000000000000000000000000000000005"}
+	deadline := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	// campaign IDs restart on every contract version, so a page can end on an ID that comes again
+	indexed := []db.IndexedCampaigns{
+		{ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 0, Owner: user.Address, Goal: "1000000000000000000", TotalFunds: "0", Deadline: deadline},
+		{ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 1, Owner: user.Address, Goal: "1000000000000000000", TotalFunds: "0", Deadline: deadline},
+		{ChainID: defi.SepoliaChainID, ContractVersion: 2, CampaignID: 0, Owner: user.Address, Goal: "1000000000000000000", TotalFunds: "0", Deadline: deadline},
+	}
+	secondPage, err := encodeCursor(campaignCursor{ID: 1, Version: 1})
+	require.NoError(t, err)
+
+	owner := sql.NullString{String: user.Address, Valid: true}
+
+	testCases := []struct {
+		name          string
+		url           string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "FirstPage",
+			url:  "/api/v1/campaigns?currency=USD&limit=2",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListIndexedCampaigns(gomock.Any(), gomock.Eq(db.ListIndexedCampaignsParams{ChainID: defi.SepoliaChainID, Limit: 3})).
+					Times(1).
+					Return(indexed, nil)
+				store.EXPECT().
+					CountIndexedCampaigns(gomock.Any(), gomock.Eq(db.CountIndexedCampaignsParams{ChainID: defi.SepoliaChainID})).
+					Times(1).
+					Return(int64(3), nil)
+				store.EXPECT().GetUserByAddress(gomock.Any(), gomock.Eq(user.Address)).Times(2).Return(user, nil)
+				store.EXPECT().ListCampaignCurrencies(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var page campaignPage
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+				require.Equal(t, int64(3), page.Data.TotalCount)
+				require.Len(t, page.Data.Items, 2)
+				require.Equal(t, secondPage, page.Data.NextCursor)
+				require.Equal(t, user.Username, page.Data.Items[0].User[0].Username)
+				require.Equal(t, 2000.0, page.Data.Items[0].Amounts.Fiat.Goal)
+			},
+		},
+		{
+			name: "NextPage",
+			url:  "/api/v1/campaigns?currency=USD&limit=2&cursor=" + secondPage,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListIndexedCampaigns(gomock.Any(), gomock.Eq(db.ListIndexedCampaignsParams{
+						ChainID:       defi.SepoliaChainID,
+						CursorID:      sql.NullInt64{Int64: 1, Valid: true},
+						CursorVersion: sql.NullInt32{Int32: 1, Valid: true},
+						Limit:         3,
+					})).
+					Times(1).
+					Return(indexed[2:], nil)
+				store.EXPECT().CountIndexedCampaigns(gomock.Any(), gomock.Any()).Times(1).Return(int64(3), nil)
+				store.EXPECT().GetUserByAddress(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignCurrencies(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var page campaignPage
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+				require.Len(t, page.Data.Items, 1)
+				require.Equal(t, 2, page.Data.Items[0].ContractVersion)
+				require.Empty(t, page.Data.NextCursor)
+			},
+		},
+		{
+			name: "LatestActive",
+			url:  "/api/v1/campaigns/latestCampaigns?currency=USD&contract_version=1",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListIndexedCampaigns(gomock.Any(), gomock.Eq(db.ListIndexedCampaignsParams{
+						ChainID:         defi.SepoliaChainID,
+						ContractVersion: sql.NullInt32{Int32: 1, Valid: true},
+						ActiveOnly:      true,
+						Limit:           interfaces.DefaultPageSize + 1,
+					})).
+					Times(1).
+					Return([]db.IndexedCampaigns{}, nil)
+				store.EXPECT().
+					CountIndexedCampaigns(gomock.Any(), gomock.Eq(db.CountIndexedCampaignsParams{
+						ChainID:         defi.SepoliaChainID,
+						ContractVersion: sql.NullInt32{Int32: 1, Valid: true},
+						ActiveOnly:      true,
+					})).
+					Times(1).
+					Return(int64(0), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Owner",
+			url:  "/api/v1/campaigns/owner?currency=USD",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					ListIndexedCampaigns(gomock.Any(), gomock.Eq(db.ListIndexedCampaignsParams{
+						ChainID:    defi.SepoliaChainID,
+						ActiveOnly: true,
+						Owner:      owner,
+						Limit:      interfaces.DefaultPageSize + 1,
+					})).
+					Times(1).
+					Return([]db.IndexedCampaigns{}, nil)
+				store.EXPECT().
+					CountIndexedCampaigns(gomock.Any(), gomock.Eq(db.CountIndexedCampaignsParams{
+						ChainID:    defi.SepoliaChainID,
+						ActiveOnly: true,
+						Owner:      owner,
+					})).
+					Times(1).
+					Return(int64(0), nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidCursor",
+			url:  "/api/v1/campaigns?cursor=not-a-cursor",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListIndexedCampaigns(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.prices = fixedPrices{"USD": 2000}
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// @Summary Get chains
+// @Description Get the chains campaigns can be created on, with their contract versions. Pass a chain's ID as chain_id and a version as contract_version to route a request to it.
+// @Accept  json
+// @Produce  json
+// @Tags Chains
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.ChainResponse}	"success"
+// @Router /chains [get]
+func (server *Server) getChains(ctx *gin.Context) {
+	defaultID := server.chains.Default().ID
+
+	chains := server.chains.All()
+	rsp := make([]interfaces.ChainResponse, len(chains))
+	for i, chain := range chains {
+		rsp[i] = interfaces.ChainResponse{
+			ChainID:         chain.ID,
+			Name:            chain.Name,
+			ContractAddress: chain.ContractAddress,
+			ExplorerURL:     chain.ExplorerURL,
+			NativeSymbol:    chain.NativeSymbol,
+			Default:         chain.ID == defaultID,
+			Testnet:         chain.Testnet,
+			Contracts:       make([]interfaces.ContractResponse, 0, len(chain.Contracts)),
+		}
+
+		for _, contract := range chain.Contracts {
+			version := interfaces.ContractResponse{
+				Version: contract.Version,
+				Address: contract.Address,
+				Proxy:   contract.Proxy,
+				Current: contract.Version == chain.Version,
+			}
+			if contract.Proxy {
+				// the implementation is informational, so an unreachable chain does not fail the listing
+				view, _ := chain.At(contract.Version)
+				implementation, err := view.Implementation(ctx)
+				if err != nil {
+					log.Warn().Err(err).Int64("chain_id", chain.ID).Int("version", contract.Version).Msg("cannot read proxy implementation")
+				}
+				version.Implementation = implementation
+			}
+			rsp[i].Contracts = append(rsp[i].Contracts, version)
+		}
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Get chain provider status
+// @Description Get the health and request metrics of each chain's RPC providers
+// @Accept  json
+// @Produce  json
+// @Tags Chains
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.ChainStatusResponse}	"success"
+// @Router /chains/status [get]
+func (server *Server) getChainStatus(ctx *gin.Context) {
+	chains := server.chains.All()
+	rsp := make([]interfaces.ChainStatusResponse, len(chains))
+	for i, chain := range chains {
+		rsp[i] = interfaces.ChainStatusResponse{
+			ChainID:   chain.ID,
+			Name:      chain.Name,
+			Providers: chain.Providers(),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// chain resolves the chain and contract version named by the chain_id and contract_version query
+// parameters, writing a 400 response when either is unknown
+func (server *Server) chain(ctx *gin.Context) (*defi.Chain, bool) {
+	var req interfaces.ChainRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return nil, false
+	}
+
+	return server.chainByID(ctx, req.ChainID, req.ContractVersion)
+}
+
+// chainByID resolves a chain at a contract version from a request body, where zero selects the default
+// chain and its current version
+func (server *Server) chainByID(ctx *gin.Context, id int64, version int) (*defi.Chain, bool) {
+	chain, err := server.chains.Get(id)
+	if err == nil {
+		chain, err = chain.At(version)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return nil, false
+	}
+
+	return chain, true
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChains(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/chains", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp struct {
+		Data []interfaces.ChainResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	require.Len(t, rsp.Data, 1)
+	require.Equal(t, defi.SepoliaChainID, rsp.Data[0].ChainID)
+	require.Equal(t, "ETH", rsp.Data[0].NativeSymbol)
+	require.True(t, rsp.Data[0].Default)
+	require.Equal(t, []interfaces.ContractResponse{
+		{Version: 1, Address: rsp.Data[0].ContractAddress, Current: true},
+	}, rsp.Data[0].Contracts)
+}
+
+func TestCampaignTotalsChain(t *testing.T) {
+	username := utils.RandomString(6)
+
+	testCases := []struct {
+		name       string
+		query      string
+		buildStubs func(store *mockdb.MockStore)
+		expectCode int
+	}{
+		{
+			name: "DefaultChain",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SumCampaignDonationRecords(gomock.Any(), gomock.Eq(db.SumCampaignDonationRecordsParams{ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7})).
+					Times(1).
+					Return(db.SumCampaignDonationRecordsRow{TotalAmount: "0", TotalFiat: "0"}, nil)
+			},
+			expectCode: http.StatusOK,
+		},
+		{
+			name:  "UnknownChain",
+			query: "?chain_id=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SumCampaignDonationRecords(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectCode: http.StatusBadRequest,
+		},
+		{
+			name:  "UnknownContractVersion",
+			query: "?contract_version=2",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SumCampaignDonationRecords(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/campaigns/totals/7"+tc.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectCode, recorder.Code)
+		})
+	}
+}
+
+func TestGetChainStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mockdb.NewMockStore(ctrl))
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/chains/status", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	addAuthorization(t, request, server.tokenMaker, authorizationBearer, utils.RandomString(6), time.Minute)
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp struct {
+		Data []interfaces.ChainStatusResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	require.Len(t, rsp.Data, 1)
+	require.Equal(t, defi.SepoliaChainID, rsp.Data[0].ChainID)
+	require.Len(t, rsp.Data[0].Providers, 1)
+	require.True(t, rsp.Data[0].Providers[0].Healthy)
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/price"
+	"github.com/demola234/defiraise/token"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// @Summary Set preferred currency
+// @Description Set the fiat currency campaign amounts are shown in on list endpoints
+// @Accept  json
+// @Produce  json
+// @Tags Profile
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param   data        body   interfaces.SetCurrencyRequest    true  "Currency, e.g. USD, EUR, NGN"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.UserResponse}	"success"
+// @Router /user/currency [post]
+func (server *Server) setPreferredCurrency(ctx *gin.Context) {
+	var req interfaces.SetCurrencyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload == nil {
+		err := errors.New(interfaces.ErrUserNotFound)
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+		return
+	}
+
+	user, err := server.store.UpdateUserPreferredCurrency(ctx, db.UpdateUserPreferredCurrencyParams{
+		Username:          authPayload.Username,
+		PreferredCurrency: strings.ToUpper(req.Currency),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewUserResponse(user)))
+}
+
+// @Summary Set campaign display currency
+// @Description Set the fiat currency a campaign's goal is displayed in. Only the campaign owner can set it.
+// @Accept  json
+// @Produce  json
+// @Tags Campaigns
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.SetCurrencyRequest    true  "Currency, e.g. USD, EUR, NGN"
+// @Success		200				{object}    interfaces.DocSuccessResponse	"success"
+// @Router /campaigns/{id}/currency [post]
+func (server *Server) setCampaignCurrency(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid campaign ID"), http.StatusBadRequest))
+		return
+	}
+
+	var req interfaces.SetCurrencyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	campaign, err := chain.GetCampaign(id)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if !strings.EqualFold(campaign.Owner, user.Address) {
+		err := errors.New("only the campaign owner can set its currency")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	currency, err := server.store.SetCampaignCurrency(ctx, db.SetCampaignCurrencyParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      int64(id),
+		Currency:        strings.ToUpper(req.Currency),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, currency))
+}
+
+// listCurrency returns the requested currency, or the current user's preferred currency when none is requested
+func (server *Server) listCurrency(ctx *gin.Context, requested string) (string, error) {
+	if requested != "" {
+		return strings.ToUpper(requested), nil
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload == nil {
+		return price.DefaultCurrency, nil
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		return "", err
+	}
+	if user.PreferredCurrency == "" {
+		return price.DefaultCurrency, nil
+	}
+
+	return user.PreferredCurrency, nil
+}
+
+// withFiat sets the display currency of each campaign and values its amounts at the current price.
+// An empty currency values each campaign in its own display currency. Amounts stay in the token
+// alone when no price is available, so a price outage does not fail campaign listings.
+func (server *Server) withFiat(ctx *gin.Context, campaigns []interfaces.Campaigns, currency string) error {
+	if len(campaigns) == 0 {
+		return nil
+	}
+
+	// campaign IDs are only unique within a chain's contract version
+	type contract struct {
+		chainID int64
+		version int
+	}
+	type chainCampaign struct {
+		contract
+		campaignID int64
+	}
+
+	ids := make(map[contract][]int64)
+	for _, campaign := range campaigns {
+		key := contract{campaign.ChainID, campaign.ContractVersion}
+		ids[key] = append(ids[key], int64(campaign.ID))
+	}
+
+	byCampaign := make(map[chainCampaign]string, len(campaigns))
+	for key, campaignIDs := range ids {
+		displayCurrencies, err := server.store.ListCampaignCurrencies(ctx, db.ListCampaignCurrenciesParams{
+			ChainID:         key.chainID,
+			ContractVersion: int32(key.version),
+			CampaignIds:     campaignIDs,
+		})
+		if err != nil {
+			return err
+		}
+		for _, displayCurrency := range displayCurrencies {
+			byCampaign[chainCampaign{key, displayCurrency.CampaignID}] = displayCurrency.Currency
+		}
+	}
+
+	prices := make(map[string]*float64)
+	for i := range campaigns {
+		campaign := &campaigns[i]
+
+		campaign.DisplayCurrency = price.DefaultCurrency
+		if displayCurrency, ok := byCampaign[chainCampaign{contract{campaign.ChainID, campaign.ContractVersion}, int64(campaign.ID)}]; ok {
+			campaign.DisplayCurrency = displayCurrency
+		}
+
+		fiat := currency
+		if fiat == "" {
+			fiat = campaign.DisplayCurrency
+		}
+
+		pair := campaign.Amounts.Token + "/" + fiat
+		if _, ok := prices[pair]; !ok {
+			prices[pair] = nil
+
+			quote, err := server.prices.GetPrice(ctx, campaign.Amounts.Token, fiat)
+			if err != nil {
+				log.Warn().Err(err).Str("pair", pair).Msg("cannot value campaign amounts")
+			} else {
+				prices[pair] = &quote.Price
+			}
+		}
+
+		if value := prices[pair]; value != nil {
+			campaign.Amounts = campaign.Amounts.WithFiat(fiat, *value)
+		}
+	}
+
+	return nil
+}
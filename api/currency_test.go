@@ -0,0 +1,138 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/price"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedPrices quotes every token at a fixed price per currency
+type fixedPrices map[string]float64
+
+func (prices fixedPrices) Name() string {
+	return "fixed"
+}
+
+func (prices fixedPrices) GetPrice(ctx context.Context, base string, currency string) (price.Quote, error) {
+	value, ok := prices[currency]
+	if !ok {
+		return price.Quote{}, price.ErrNoPrice
+	}
+	return price.Quote{Base: base, Currency: currency, Price: value, Source: prices.Name(), UpdatedAt: time.Now()}, nil
+}
+
+func TestSetPreferredCurrency(t *testing.T) {
+	username := utils.RandomString(6)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"currency": "ngn"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUserPreferredCurrency(gomock.Any(), gomock.Eq(db.UpdateUserPreferredCurrencyParams{
+						Username:          username,
+						PreferredCurrency: "NGN",
+					})).
+					Times(1).
+					Return(db.Users{Username: username, PreferredCurrency: "NGN"}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"preferred_currency":"NGN"`)
+			},
+		},
+		{
+			name: "UnsupportedCurrency",
+			body: gin.H{"currency": "XYZ"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateUserPreferredCurrency(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/user/currency", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestWithFiat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListCampaignCurrencies(gomock.Any(), gomock.Eq(db.ListCampaignCurrenciesParams{ChainID: defi.SepoliaChainID, CampaignIds: []int64{1, 2}})).
+		AnyTimes().
+		Return([]db.CampaignCurrencies{{ChainID: defi.SepoliaChainID, CampaignID: 2, Currency: "EUR"}}, nil)
+
+	server := newTestServer(t, store)
+	server.prices = fixedPrices{"USD": 2000, "EUR": 1800}
+
+	newCampaigns := func() []interfaces.Campaigns {
+		return []interfaces.Campaigns{
+			{ID: 1, ChainID: defi.SepoliaChainID, Amounts: interfaces.NewCampaignAmounts("ETH", "2000000000000000000", "500000000000000000")},
+			{ID: 2, ChainID: defi.SepoliaChainID, Amounts: interfaces.NewCampaignAmounts("ETH", "1000000000000000000", "1500000000000000000")},
+		}
+	}
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	// each campaign in its own display currency
+	campaigns := newCampaigns()
+	require.NoError(t, server.withFiat(ctx, campaigns, ""))
+
+	require.Equal(t, price.DefaultCurrency, campaigns[0].DisplayCurrency)
+	require.Equal(t, 1.5, campaigns[0].Amounts.Remaining)
+	require.Equal(t, &interfaces.FiatAmounts{Currency: "USD", Price: 2000, Goal: 4000, Raised: 1000, Remaining: 3000}, campaigns[0].Amounts.Fiat)
+
+	require.Equal(t, "EUR", campaigns[1].DisplayCurrency)
+	require.Equal(t, 0.0, campaigns[1].Amounts.Remaining)
+	require.Equal(t, 2700.0, campaigns[1].Amounts.Fiat.Raised)
+
+	// a requested currency without a price leaves the amounts in the token
+	campaigns = newCampaigns()
+	require.NoError(t, server.withFiat(ctx, campaigns, "NGN"))
+	require.Nil(t, campaigns[0].Amounts.Fiat)
+	require.Equal(t, "EUR", campaigns[1].DisplayCurrency)
+}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	crypt "github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/relayer"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// defaultMaxDeadlineExtension bounds how far past its original deadline a campaign can be extended
+	// when MAX_DEADLINE_EXTENSION is not set
+	defaultMaxDeadlineExtension = 30 * 24 * time.Hour
+	// deadlineVoteMargin is left between the end of a vote on an extension and the deadline it extends,
+	// since the contract only extends active campaigns
+	deadlineVoteMargin = time.Hour
+)
+
+var (
+	// ErrInvalidExtension is returned by CheckDeadlineExtension for a deadline the campaign cannot move to
+	ErrInvalidExtension = errors.New("invalid deadline extension")
+	// ErrGoalReached is returned by CheckDeadlineExtension for a campaign that has reached its goal
+	ErrGoalReached = errors.New("campaign has reached its goal")
+)
+
+// @Summary Extend a campaign's deadline
+// @Description Move an active campaign's deadline later, up to MAX_DEADLINE_EXTENSION past its original deadline. A campaign that has reached its goal cannot be extended. With DEADLINE_EXTENSION_VOTE set, extending a campaign that has donations opens an extend_deadline proposal instead, answered with 202, and the extension is sent once donors pass it.
+// @Accept  json
+// @Produce  json
+// @Tags Campaigns
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.ExtendDeadlineRequest    true  "New deadline"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.DeadlineChange}	"success"
+// @Success		202				{object}    interfaces.DocSuccessResponse{data=interfaces.Proposal}	"put to a donor vote"
+// @Router /campaigns/{id}/deadline [post]
+func (server *Server) extendCampaignDeadline(ctx *gin.Context) {
+	var req interfaces.ExtendDeadlineRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	campaign, user, ok := server.activeOwnedCampaign(ctx)
+	if !ok {
+		return
+	}
+	if err := CheckDeadlineExtension(ctx, server.store, server.config, campaign, req.NewDeadline); err != nil {
+		respondExtensionError(ctx, err)
+		return
+	}
+
+	raised, _ := new(big.Int).SetString(campaign.TotalFunds, 10)
+	if server.config.DeadlineVote && raised.Sign() > 0 {
+		endsAt, ok := deadlineVoteEnd(time.Now().Add(defaultProposalVoting), campaign.Deadline)
+		if !ok {
+			err := errors.New("campaign ends too soon for a donor vote on the extension")
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+			return
+		}
+
+		proposal, err := server.store.CreateCampaignProposal(ctx, db.CreateCampaignProposalParams{
+			ChainID:         campaign.ChainID,
+			ContractVersion: campaign.ContractVersion,
+			CampaignID:      campaign.CampaignID,
+			Kind:            interfaces.ProposalExtendDeadline,
+			Title:           "Extend the deadline",
+			Description:     fmt.Sprintf("Move the deadline from %s to %s", campaign.Deadline.Format(time.RFC3339), req.NewDeadline.Format(time.RFC3339)),
+			NewDeadline:     sql.NullTime{Time: req.NewDeadline, Valid: true},
+			Quorum:          defaultProposalQuorum,
+			EndsAt:          endsAt,
+			CreatedBy:       user.Username,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+
+		rsp := interfaces.NewProposal(proposal, db.SumProposalVotesRow{ForWeight: "0", AgainstWeight: "0"})
+		ctx.JSON(http.StatusAccepted, interfaces.Response(http.StatusAccepted, rsp))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, campaign.ChainID, int(campaign.ContractVersion))
+	if !ok {
+		return
+	}
+	privateKey, _, err := crypt.DecryptPrivateKey(user.FilePath, server.config.PassPhase)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	sponsorship, ok := server.sponsorGas(ctx, chain, user, relayer.ActionExtendDeadline, nil, func() (uint64, error) {
+		return chain.ExtendDeadlineGas(ctx, campaign.CampaignID, req.NewDeadline, user.Address)
+	})
+	if !ok {
+		return
+	}
+
+	hash, err := chain.ExtendDeadline(ctx, campaign.CampaignID, req.NewDeadline, privateKey)
+	if err != nil {
+		respondDeadlineError(ctx, err)
+		return
+	}
+	server.recordSponsoredAction(ctx, sponsorship, hash)
+
+	server.respondDeadlineChange(ctx, campaign, interfaces.DeadlineExtended, req.NewDeadline, hash, user.Username)
+}
+
+// @Summary Close a campaign early
+// @Description End an active campaign now. It then settles like any campaign past its deadline: paid out if it reached its goal, refunded otherwise. A campaign with donations still awaiting confirmation cannot be closed.
+// @Accept  json
+// @Produce  json
+// @Tags Campaigns
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.DeadlineChange}	"success"
+// @Router /campaigns/{id}/close [post]
+func (server *Server) closeCampaignEarly(ctx *gin.Context) {
+	campaign, user, ok := server.activeOwnedCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	pending, err := server.store.CountPendingCampaignDonationRecords(ctx, db.CountPendingCampaignDonationRecordsParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if pending > 0 {
+		err := fmt.Errorf("%d donations to the campaign are still awaiting confirmation", pending)
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, campaign.ChainID, int(campaign.ContractVersion))
+	if !ok {
+		return
+	}
+	privateKey, _, err := crypt.DecryptPrivateKey(user.FilePath, server.config.PassPhase)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	sponsorship, ok := server.sponsorGas(ctx, chain, user, relayer.ActionCloseCampaign, nil, func() (uint64, error) {
+		return chain.CloseCampaignGas(ctx, campaign.CampaignID, user.Address)
+	})
+	if !ok {
+		return
+	}
+
+	hash, err := chain.CloseCampaign(ctx, campaign.CampaignID, privateKey)
+	if err != nil {
+		respondDeadlineError(ctx, err)
+		return
+	}
+	server.recordSponsoredAction(ctx, sponsorship, hash)
+
+	server.respondDeadlineChange(ctx, campaign, interfaces.DeadlineClosed, time.Now(), hash, user.Username)
+}
+
+// activeOwnedCampaign resolves the campaign of the :id path parameter like ownedCampaign, writing a
+// 400 response when it has ended
+func (server *Server) activeOwnedCampaign(ctx *gin.Context) (db.IndexedCampaigns, db.Users, bool) {
+	campaign, user, ok := server.ownedCampaign(ctx)
+	if !ok {
+		return db.IndexedCampaigns{}, db.Users{}, false
+	}
+	if !time.Now().Before(campaign.Deadline) {
+		err := errors.New("campaign has ended")
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return db.IndexedCampaigns{}, db.Users{}, false
+	}
+
+	return campaign, user, true
+}
+
+// CheckDeadlineExtension checks that an active campaign's deadline can move to newDeadline: later than
+// its deadline, at most MAX_DEADLINE_EXTENSION past the deadline it was created with, and only while it
+// has not reached its goal. Extensions made directly and through proposals are checked alike.
+func CheckDeadlineExtension(ctx context.Context, store db.Store, configs utils.Config, campaign db.IndexedCampaigns, newDeadline time.Time) error {
+	if !time.Now().Before(campaign.Deadline) {
+		return fmt.Errorf("%w: campaign has ended", ErrInvalidExtension)
+	}
+	if !newDeadline.After(campaign.Deadline) {
+		return fmt.Errorf("%w: new_deadline must be after the campaign's deadline", ErrInvalidExtension)
+	}
+
+	original, err := originalDeadline(ctx, store, campaign)
+	if err != nil {
+		return err
+	}
+	maxExtension := configs.MaxDeadlineExtension
+	if maxExtension <= 0 {
+		maxExtension = defaultMaxDeadlineExtension
+	}
+	if newDeadline.Sub(original) > maxExtension {
+		return fmt.Errorf("%w: the deadline can move at most %s past the original deadline of %s", ErrInvalidExtension, maxExtension, original.Format(time.RFC3339))
+	}
+
+	goal, _ := new(big.Int).SetString(campaign.Goal, 10)
+	raised, _ := new(big.Int).SetString(campaign.TotalFunds, 10)
+	if goal == nil || raised == nil {
+		return errors.New("campaign amounts are not indexed")
+	}
+	if raised.Cmp(goal) >= 0 {
+		return ErrGoalReached
+	}
+	return nil
+}
+
+// respondExtensionError answers with the status of an error from CheckDeadlineExtension
+func respondExtensionError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidExtension):
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+	case errors.Is(err, ErrGoalReached):
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+	default:
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+	}
+}
+
+// originalDeadline returns the deadline a campaign was created with, before any extension
+func originalDeadline(ctx context.Context, store db.Store, campaign db.IndexedCampaigns) (time.Time, error) {
+	changes, err := store.ListCampaignDeadlineChanges(ctx, db.ListCampaignDeadlineChangesParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, change := range changes {
+		if change.Kind == interfaces.DeadlineExtended {
+			return change.PreviousDeadline, nil
+		}
+	}
+	return campaign.Deadline, nil
+}
+
+// respondDeadlineChange records a deadline change sent to the contract, moves the indexed deadline
+// ahead of the indexer and drops the cached campaign lists. Should the transaction fail, the indexer
+// restores the contract's deadline on its next pass.
+func (server *Server) respondDeadlineChange(ctx *gin.Context, campaign db.IndexedCampaigns, kind string, deadline time.Time, hash string, username string) {
+	change, err := server.store.CreateCampaignDeadlineChange(ctx, db.CreateCampaignDeadlineChangeParams{
+		ChainID:          campaign.ChainID,
+		ContractVersion:  campaign.ContractVersion,
+		CampaignID:       campaign.CampaignID,
+		Kind:             kind,
+		PreviousDeadline: campaign.Deadline,
+		Deadline:         deadline,
+		TxHash:           hash,
+		CreatedBy:        username,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	_, err = server.store.UpdateIndexedCampaignDeadline(ctx, db.UpdateIndexedCampaignDeadlineParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+		Deadline:        deadline,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	redisCache := utils.NewRedisCache()
+	redisCache.InvalidateAllCampaignCaches()
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewDeadlineChange(change)))
+}
+
+func respondDeadlineError(ctx *gin.Context, err error) {
+	if errors.Is(err, crypt.ErrDeadlineChangesUnsupported) {
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+}
+
+// deadlineVoteEnd moves the end of a vote on a deadline extension ahead of the deadline it extends,
+// reporting false when too little time is left for a vote
+func deadlineVoteEnd(end time.Time, deadline time.Time) (time.Time, bool) {
+	if latest := deadline.Add(-deadlineVoteMargin); end.After(latest) {
+		end = latest
+	}
+	return end, end.After(time.Now())
+}
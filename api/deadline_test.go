@@ -0,0 +1,264 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadlineVoteEnd(t *testing.T) {
+	deadline := time.Now().Add(24 * time.Hour)
+
+	end, ok := deadlineVoteEnd(time.Now().Add(time.Hour), deadline)
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Hour), end, time.Minute)
+
+	end, ok = deadlineVoteEnd(time.Now().Add(72*time.Hour), deadline)
+	require.True(t, ok)
+	require.True(t, end.Equal(deadline.Add(-deadlineVoteMargin)))
+
+	_, ok = deadlineVoteEnd(time.Now().Add(72*time.Hour), time.Now().Add(30*time.Minute))
+	require.False(t, ok)
+}
+
+func TestExtendCampaignDeadline(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001"}
+	deadline := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	campaign := db.IndexedCampaigns{
+		ChainID:         defi.SepoliaChainID,
+		ContractVersion: 1,
+		CampaignID:      7,
+		Owner:           user.Address,
+		Goal:            "2000000000000000000",
+		TotalFunds:      "1000000000000000000",
+		Deadline:        deadline,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		deadlineVote  bool
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:         "DonorVote",
+			body:         gin.H{"new_deadline": deadline.Add(72 * time.Hour)},
+			deadlineVote: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignDeadlineChanges(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().
+					CreateCampaignProposal(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateCampaignProposalParams) (db.CampaignProposals, error) {
+						require.Equal(t, interfaces.ProposalExtendDeadline, arg.Kind)
+						require.True(t, arg.NewDeadline.Time.Equal(deadline.Add(72*time.Hour)))
+						require.True(t, arg.EndsAt.Before(deadline))
+						return db.CampaignProposals{ID: 1, Kind: arg.Kind, Status: interfaces.ProposalOpen, ForWeight: "0", AgainstWeight: "0", TotalWeight: "0"}, nil
+					})
+				store.EXPECT().CreateCampaignDeadlineChange(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"kind":"extend_deadline"`)
+			},
+		},
+		{
+			name: "EarlierDeadline",
+			body: gin.H{"new_deadline": deadline.Add(-time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().CreateCampaignDeadlineChange(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TooFar",
+			body: gin.H{"new_deadline": deadline.Add(defaultMaxDeadlineExtension)},
+			buildStubs: func(store *mockdb.MockStore) {
+				// the campaign was already extended by a day from its original deadline
+				changes := []db.CampaignDeadlineChanges{{Kind: interfaces.DeadlineExtended, PreviousDeadline: deadline.Add(-24 * time.Hour), Deadline: deadline}}
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignDeadlineChanges(gomock.Any(), gomock.Any()).Times(1).Return(changes, nil)
+				store.EXPECT().CreateCampaignDeadlineChange(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Funded",
+			body: gin.H{"new_deadline": deadline.Add(24 * time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				funded := campaign
+				funded.TotalFunds = funded.Goal
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(funded, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignDeadlineChanges(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().CreateCampaignDeadlineChange(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Ended",
+			body: gin.H{"new_deadline": deadline.Add(24 * time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				ended := campaign
+				ended.Deadline = time.Now().Add(-time.Hour)
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(ended, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().CreateCampaignDeadlineChange(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotOwner",
+			body: gin.H{"new_deadline": deadline.Add(24 * time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.Users{Username: user.Username, Address: "0x01"}, nil)
+				store.EXPECT().CreateCampaignDeadlineChange(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "MissingDeadline",
+			body: gin.H{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.config.DeadlineVote = tc.deadlineVote
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/campaigns/7/deadline", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCloseCampaignEarly(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001"}
+	campaign := db.IndexedCampaigns{
+		ChainID:         defi.SepoliaChainID,
+		ContractVersion: 1,
+		CampaignID:      7,
+		Owner:           user.Address,
+		Deadline:        time.Now().Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "PendingDonations",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().
+					CountPendingCampaignDonationRecords(gomock.Any(), gomock.Eq(db.CountPendingCampaignDonationRecordsParams{ChainID: campaign.ChainID, ContractVersion: 1, CampaignID: 7})).
+					Times(1).
+					Return(int64(2), nil)
+				store.EXPECT().CreateCampaignDeadlineChange(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "Ended",
+			buildStubs: func(store *mockdb.MockStore) {
+				ended := campaign
+				ended.Deadline = time.Now().Add(-time.Minute)
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(ended, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().CountPendingCampaignDonationRecords(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotOwner",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.Users{Username: user.Username, Address: "0x01"}, nil)
+				store.EXPECT().CountPendingCampaignDonationRecords(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/campaigns/7/close", nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
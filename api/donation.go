@@ -0,0 +1,211 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/indexer"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/token"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get My Donations
+// @Description Get every donation made from the user's custodial wallet, with totals per campaign
+// @Accept  json
+// @Produce  json
+// @Tags Campaigns
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.DonationHistoryResponse}	"success"
+// @Router /campaigns/myDonations [get]
+func (server *Server) getMyDonations(ctx *gin.Context) {
+	var pageReq interfaces.PageRequest
+	if err := ctx.ShouldBindQuery(&pageReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	var cursor idCursor
+	if pageReq.Cursor != "" {
+		if err := decodeCursor(pageReq.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	if authPayload == nil {
+		err := errors.New(interfaces.ErrUserNotFound)
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+		return
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	donors, err := server.donorAddresses(ctx, user)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	pageSize := pageReq.PageSize()
+	records, err := server.store.ListDonationRecordsByDonors(ctx, db.ListDonationRecordsByDonorsParams{
+		Donors:   donors,
+		CursorID: sql.NullInt64{Int64: cursor.ID, Valid: pageReq.Cursor != ""},
+		Limit:    pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	sums, err := server.store.SumDonationRecordsByCampaign(ctx, donors)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := interfaces.DonationHistoryResponse{
+		Totals:       make([]interfaces.CampaignDonationTotal, len(sums)),
+		FiatCurrency: indexer.FiatCurrency,
+	}
+
+	totalWei := new(big.Int)
+	for i, sum := range sums {
+		rsp.Totals[i] = interfaces.CampaignDonationTotal{
+			ChainID:         sum.ChainID,
+			ContractVersion: int(sum.ContractVersion),
+			CampaignID:      sum.CampaignID,
+			CampaignTitle:   sum.CampaignTitle,
+			DonationCount:   sum.DonationCount,
+			TotalAmount:     utils.WeiToEther(sum.TotalAmount),
+			TotalFiat:       parseFiat(sum.TotalFiat),
+		}
+		rsp.DonationCount += sum.DonationCount
+		rsp.TotalDonatedFiat += rsp.Totals[i].TotalFiat
+
+		if wei, ok := new(big.Int).SetString(sum.TotalAmount, 10); ok {
+			totalWei.Add(totalWei, wei)
+		}
+	}
+	rsp.TotalDonated = utils.WeiToEther(totalWei.String())
+	rsp.Donations.TotalCount = rsp.DonationCount
+
+	if len(records) > int(pageSize) {
+		records = records[:pageSize]
+
+		rsp.Donations.NextCursor, err = encodeCursor(idCursor{ID: records[len(records)-1].ID})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	items := make([]interfaces.DonationHistoryItem, len(records))
+	for i, record := range records {
+		items[i] = interfaces.DonationHistoryItem{
+			ID:            record.ID,
+			CampaignID:    record.CampaignID,
+			CampaignTitle: record.CampaignTitle,
+			Donor:         record.DonorAddress,
+			Amount:        utils.WeiToEther(record.Amount),
+			Token:         record.Token,
+			TxHash:        record.TxHash.String,
+			BlockNumber:   record.BlockNumber.Int64,
+			Status:        record.Status,
+			FiatCurrency:  record.FiatCurrency,
+			DonatedAt:     record.DonatedAt,
+		}
+		if record.FiatAmount.Valid {
+			fiat := parseFiat(record.FiatAmount.String)
+			items[i].FiatAmount = &fiat
+		}
+	}
+	rsp.Donations.Items = items
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Get campaign donation totals
+// @Description Get the recorded donation count and totals of a campaign, with fiat valued at each donation's time
+// @Accept  json
+// @Produce  json
+// @Tags Campaigns
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.CampaignTotals}	"success"
+// @Router /campaigns/totals/{id} [get]
+func (server *Server) getCampaignTotals(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid campaign ID"), http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload == nil {
+		err := errors.New(interfaces.ErrUserNotFound)
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+		return
+	}
+
+	sum, err := server.store.SumCampaignDonationRecords(ctx, db.SumCampaignDonationRecordsParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      id,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.CampaignTotals{
+		CampaignID:    id,
+		DonationCount: sum.DonationCount,
+		TotalAmount:   utils.WeiToEther(sum.TotalAmount),
+		TotalFiat:     parseFiat(sum.TotalFiat),
+		FiatCurrency:  indexer.FiatCurrency,
+	}))
+}
+
+// parseFiat reads a NUMERIC fiat amount, treating anything unreadable as zero
+func parseFiat(amount string) float64 {
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// donorAddresses returns the lower-cased addresses whose donations count as the user's. Linked wallets
+// are left out: linking one takes no proof of ownership, so anyone could claim another donor's history.
+func (server *Server) donorAddresses(ctx *gin.Context, user db.Users) ([]string, error) {
+	if user.Address == "" {
+		return []string{}, nil
+	}
+	return []string{strings.ToLower(user.Address)}, nil
+}
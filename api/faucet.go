@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/faucet"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/gin-gonic/gin"
+)
+
+// onboardingTimeout bounds the onboarding grants sent in the background after a user is verified
+const onboardingTimeout = 5 * time.Minute
+
+var errFaucetDisabled = errors.New("the faucet is not enabled")
+
+// @Summary Get my onboarding grants
+// @Description List the onboarding grants the faucet has sent to the user's wallet on test networks
+// @Produce  json
+// @Tags Faucet
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.FaucetGrant}	"success"
+// @Router /faucet [get]
+func (server *Server) listFaucetGrants(ctx *gin.Context) {
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	grants, err := server.store.ListUserFaucetGrants(ctx, user.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewFaucetGrants(grants)))
+}
+
+// @Summary Claim the onboarding grant
+// @Description Send the onboarding grant of a test network to the user's wallet, when it was not sent after email verification or failed
+// @Accept  json
+// @Produce  json
+// @Tags Faucet
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param   data        body   interfaces.ClaimFaucetGrantRequest    true  "Claim Faucet Grant Request body"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.FaucetGrant}	"success"
+// @Failure		400				{object}   string	"Bad request"
+// @Failure		409				{object}   string	"Already granted"
+// @Failure		429				{object}   string	"Too many grants from the network"
+// @Router /faucet [post]
+func (server *Server) claimFaucetGrant(ctx *gin.Context) {
+	var req interfaces.ClaimFaucetGrantRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+	if server.faucet == nil {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errFaucetDisabled, http.StatusNotFound))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, req.ChainID, 0)
+	if !ok {
+		return
+	}
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	grants, err := server.faucet.Grant(ctx, chain, user, ctx.ClientIP())
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewFaucetGrants(grants)))
+	case errors.Is(err, faucet.ErrNotTestnet):
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+	case errors.Is(err, faucet.ErrUnverified):
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+	case errors.Is(err, faucet.ErrAlreadyGranted):
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+	case errors.Is(err, faucet.ErrIPLimit):
+		ctx.JSON(http.StatusTooManyRequests, interfaces.ErrorResponse(err, http.StatusTooManyRequests))
+	default:
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+	}
+}
+
+// onboard sends a newly verified user the onboarding grants in the background when the faucet is enabled
+func (server *Server) onboard(user db.Users, ip string) {
+	if server.faucet == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), onboardingTimeout)
+		defer cancel()
+		server.faucet.Onboard(ctx, user, ip)
+	}()
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/faucet"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimFaucetGrant(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), IsEmailVerified: true}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		body          string
+		enabled       bool
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "IPLimit",
+			body:    `{}`,
+			enabled: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().ClaimFaucetGrantTx(gomock.Any(), gomock.Any()).Times(1).Return(db.FaucetGrants{}, db.ErrFaucetIPLimit)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+			},
+		},
+		{
+			name: "Disabled",
+			body: `{}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimFaucetGrantTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:    "UnknownChain",
+			body:    `{"chain_id": 424242}`,
+			enabled: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimFaucetGrantTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			if tc.enabled {
+				configs := utils.Config{FaucetKey: hex.EncodeToString(crypto.FromECDSA(key)), FaucetAmount: 0.01}
+				server.faucet, err = faucet.NewFaucet(store, server.chains, server.tokens, configs, nil)
+				require.NoError(t, err)
+			}
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/faucet", bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/relayer"
+	"github.com/gin-gonic/gin"
+)
+
+// defaultSponsorshipDays is the period the sponsored gas summary covers when no days are given
+const defaultSponsorshipDays = 30
+
+var errGasSponsorshipDisabled = errors.New("gas sponsorship is not enabled")
+
+// @Summary Get my gas budget
+// @Description Get what is left of the gas the platform sponsors for the user's approved actions on a chain, in the chain's native currency
+// @Produce  json
+// @Tags Gas
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.GasBudget}	"success"
+// @Router /gas/budget [get]
+func (server *Server) getGasBudget(ctx *gin.Context) {
+	if server.relayer == nil {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errGasSponsorshipDisabled, http.StatusNotFound))
+		return
+	}
+
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	budget, err := server.relayer.Budget(ctx, user.Username, chain.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, budget))
+}
+
+// @Summary Get sponsored gas
+// @Description Account for the gas the relayer wallet has sponsored, per chain and action. Moderators only.
+// @Produce  json
+// @Tags Gas
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param days query int false "Number of days to cover (default: 30)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.GasSponsorshipSummary}	"success"
+// @Router /gas/sponsorships [get]
+func (server *Server) getGasSponsorships(ctx *gin.Context) {
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+	if !server.isModerator(user.Username) {
+		err := errors.New("only moderators can see sponsored gas")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+	if server.relayer == nil {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errGasSponsorshipDisabled, http.StatusNotFound))
+		return
+	}
+
+	days := defaultSponsorshipDays
+	if value := ctx.Query("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid days"), http.StatusBadRequest))
+			return
+		}
+		days = parsed
+	}
+
+	summary, err := server.relayer.Summary(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, summary))
+}
+
+// sponsorGas tops up the user's custodial wallet with the gas of an approved action when the relayer
+// is enabled, writing the error response when the action cannot be sponsored. estimate returns the gas
+// limit of the action's call. The returned top-up is nil when nothing was sent.
+func (server *Server) sponsorGas(ctx *gin.Context, chain *defi.Chain, user db.Users, action string, value *big.Int, estimate func() (uint64, error)) (*db.GasSponsorships, bool) {
+	if server.relayer == nil {
+		return nil, true
+	}
+
+	gas, err := estimate()
+	if err != nil {
+		if errors.Is(err, defi.ErrDeadlineChangesUnsupported) {
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+			return nil, false
+		}
+		err = fmt.Errorf("cannot estimate gas: %w", err)
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return nil, false
+	}
+
+	sponsorship, err := server.relayer.Sponsor(ctx, chain, user, action, gas, value)
+	switch {
+	case err == nil:
+		return sponsorship, true
+	case errors.Is(err, relayer.ErrUnverified):
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+	case errors.Is(err, relayer.ErrBudgetExceeded), errors.Is(err, relayer.ErrTooManyGrants), errors.Is(err, relayer.ErrDailyCapReached):
+		ctx.JSON(http.StatusTooManyRequests, interfaces.ErrorResponse(err, http.StatusTooManyRequests))
+	case errors.Is(err, relayer.ErrGasPriceTooHigh):
+		ctx.JSON(http.StatusServiceUnavailable, interfaces.ErrorResponse(err, http.StatusServiceUnavailable))
+	default:
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+	}
+	return nil, false
+}
+
+// recordSponsoredAction links a sponsored action's transaction to its top-up
+func (server *Server) recordSponsoredAction(ctx *gin.Context, sponsorship *db.GasSponsorships, hash string) {
+	if sponsorship != nil {
+		server.relayer.RecordAction(ctx, sponsorship, hash)
+	}
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/relayer"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGasSponsorships(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		query         string
+		moderators    string
+		enabled       bool
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			query:      "?days=7",
+			moderators: user.Username,
+			enabled:    true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SummarizeGasSponsorships(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, since time.Time) ([]db.SummarizeGasSponsorshipsRow, error) {
+						require.WithinDuration(t, time.Now().AddDate(0, 0, -7), since, time.Minute)
+						return []db.SummarizeGasSponsorshipsRow{{ChainID: 1, Action: relayer.ActionDonate, Sponsorships: 3, Users: 2, Amount: "9000000000000000"}}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"amount":0.009`)
+			},
+		},
+		{
+			name:       "NotModerator",
+			moderators: "someone-else",
+			enabled:    true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SummarizeGasSponsorships(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "Disabled",
+			moderators: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SummarizeGasSponsorships(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "InvalidDays",
+			query:      "?days=0",
+			moderators: user.Username,
+			enabled:    true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SummarizeGasSponsorships(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.config.Moderators = tc.moderators
+			if tc.enabled {
+				server.relayer, err = relayer.NewRelayer(store, utils.Config{RelayerKey: hex.EncodeToString(crypto.FromECDSA(key))})
+				require.NoError(t, err)
+			}
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/gas/sponsorships"+tc.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
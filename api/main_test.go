@@ -6,6 +6,7 @@ import (
 	"time"
 
 	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
 	"github.com/demola234/defiraise/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -15,8 +16,12 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 	config := utils.Config{
 		TokenSymmetricKey:   utils.RandomString(32),
 		AccessTokenDuration: time.Minute,
+		CryptoDeployURL:     "https://sepolia.example.com",
 	}
-	newServer, err := NewServer(config, store)
+	chains, err := defi.LoadChainRegistry(config)
+	require.NoError(t, err)
+
+	newServer, err := NewServer(config, store, chains)
 	require.NoError(t, err)
 	require.NotNil(t, newServer)
 
@@ -0,0 +1,305 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/matching"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// recentMatchesLimit is the number of matches shown in a matching pool report
+const recentMatchesLimit = 20
+
+// @Summary Create a matching pool
+// @Description Open a sponsor matching pool with its own funding wallet. Once the wallet is funded, every confirmed donation of at least min_donation to an eligible campaign is matched at ratio_percent, at most max_match per donation, until cap has been matched or ends_at passes. Campaigns are eligible when they are listed in campaign_ids or their category is in categories; with neither, every campaign on the chain's contract version is.
+// @Accept  json
+// @Produce  json
+// @Tags Matching
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param   data        body   interfaces.CreateMatchingPoolRequest    true  "Matching pool"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.MatchingPool}	"success"
+// @Router /matching-pools [post]
+func (server *Server) createMatchingPool(ctx *gin.Context) {
+	var req interfaces.CreateMatchingPoolRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
+		return
+	}
+
+	if req.MaxMatch > req.Cap {
+		err := errors.New("max_match cannot be more than the cap")
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	filepath, address, err := defi.GenerateAccountKeyStone(server.config.PassPhase)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	params := db.CreateMatchingPoolParams{
+		Sponsor:         user.Username,
+		Name:            req.Name,
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		WalletAddress:   address,
+		FilePath:        filepath,
+		RatioPercent:    req.RatioPercent,
+		Cap:             utils.EtherToWei(req.Cap),
+		MinDonation:     "0",
+		CampaignIds:     req.CampaignIDs,
+		Categories:      req.Categories,
+	}
+	if params.CampaignIds == nil {
+		params.CampaignIds = []int64{}
+	}
+	if params.Categories == nil {
+		params.Categories = []string{}
+	}
+	if req.MaxMatch > 0 {
+		params.MaxMatch = sql.NullString{String: utils.EtherToWei(req.MaxMatch), Valid: true}
+	}
+	if req.MinDonation > 0 {
+		params.MinDonation = utils.EtherToWei(req.MinDonation)
+	}
+	if req.EndsAt != nil {
+		params.EndsAt = sql.NullTime{Time: *req.EndsAt, Valid: true}
+	}
+
+	pool, err := server.store.CreateMatchingPool(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewMatchingPool(pool)))
+}
+
+// @Summary Get matching pools
+// @Description Get the matching pools, newest first
+// @Accept  json
+// @Produce  json
+// @Tags Matching
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.MatchingPool}}	"success"
+// @Router /matching-pools [get]
+func (server *Server) listMatchingPools(ctx *gin.Context) {
+	var pageReq interfaces.PageRequest
+	if err := ctx.ShouldBindQuery(&pageReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	var cursor idCursor
+	if pageReq.Cursor != "" {
+		if err := decodeCursor(pageReq.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	pageSize := pageReq.PageSize()
+	pools, err := server.store.ListMatchingPools(ctx, db.ListMatchingPoolsParams{
+		CursorID: sql.NullInt64{Int64: cursor.ID, Valid: pageReq.Cursor != ""},
+		Limit:    pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	total, err := server.store.CountMatchingPools(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	page := interfaces.Page{TotalCount: total}
+	if len(pools) > int(pageSize) {
+		pools = pools[:pageSize]
+
+		page.NextCursor, err = encodeCursor(idCursor{ID: pools[len(pools)-1].ID})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	items := make([]interfaces.MatchingPool, len(pools))
+	for i, pool := range pools {
+		items[i] = interfaces.NewMatchingPool(pool)
+	}
+	page.Items = items
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
+}
+
+// @Summary Get a matching pool report
+// @Description Get a matching pool with what is left of its cap, its wallet balance, what it has matched for each campaign and its most recent matches, including the donations it skipped and why
+// @Accept  json
+// @Produce  json
+// @Tags Matching
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Matching pool ID"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.MatchingPoolReport}	"success"
+// @Router /matching-pools/{id} [get]
+func (server *Server) getMatchingPool(ctx *gin.Context) {
+	pool, ok := server.pathMatchingPool(ctx)
+	if !ok {
+		return
+	}
+
+	totals, err := server.store.SumMatchingPoolMatches(ctx, pool.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	matches, err := server.store.ListMatchingPoolMatches(ctx, db.ListMatchingPoolMatchesParams{
+		PoolID: pool.ID,
+		Limit:  recentMatchesLimit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := interfaces.MatchingPoolReport{
+		MatchingPool:  interfaces.NewMatchingPool(pool),
+		Remaining:     utils.WeiToEther(matching.Remaining(pool).String()),
+		Campaigns:     make([]interfaces.CampaignMatches, len(totals)),
+		RecentMatches: make([]interfaces.PoolMatch, len(matches)),
+	}
+	for i, total := range totals {
+		rsp.Campaigns[i] = interfaces.CampaignMatches{
+			CampaignID:    total.CampaignID,
+			Matches:       total.MatchCount,
+			MatchedAmount: utils.WeiToEther(total.MatchedAmount),
+		}
+	}
+	for i, match := range matches {
+		rsp.RecentMatches[i] = interfaces.NewPoolMatch(match)
+	}
+
+	// the report is still useful without the balance when the chain cannot be read
+	if chain, err := server.chains.Get(pool.ChainID); err == nil {
+		if balance, err := chain.GetBalance(pool.WalletAddress); err == nil {
+			rsp.WalletBalance = balance
+		} else {
+			log.Warn().Err(err).Int64("pool_id", pool.ID).Msg("cannot read matching pool balance")
+		}
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Close a matching pool
+// @Description Stop a matching pool from matching donations and refund what is left in its wallet, less the transfer fee, to the sponsor's wallet. Only the sponsor can close a pool. Closing a closed pool whose refund failed tries the refund again.
+// @Accept  json
+// @Produce  json
+// @Tags Matching
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Matching pool ID"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.MatchingPool}	"success"
+// @Router /matching-pools/{id}/close [post]
+func (server *Server) closeMatchingPool(ctx *gin.Context) {
+	pool, ok := server.pathMatchingPool(ctx)
+	if !ok {
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+	if pool.Sponsor != user.Username {
+		err := errors.New("only the sponsor can close a matching pool")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, pool.ChainID, int(pool.ContractVersion))
+	if !ok {
+		return
+	}
+
+	// closing first stops the matcher reserving more of the pool before its wallet is emptied
+	pool, err := server.store.CloseMatchingPool(ctx, pool.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(errors.New("matching pool is already closed and refunded"), http.StatusConflict))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	privateKey, _, err := defi.DecryptPrivateKey(pool.FilePath, server.config.PassPhase)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	hash, _, err := chain.Sweep(ctx, user.Address, privateKey)
+	if err != nil {
+		// an empty wallet leaves nothing to refund
+		if errors.Is(err, defi.ErrNothingToSweep) {
+			ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewMatchingPool(pool)))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	pool, err = server.store.SetMatchingPoolRefund(ctx, db.SetMatchingPoolRefundParams{
+		ID:           pool.ID,
+		RefundTxHash: sql.NullString{String: hash, Valid: true},
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewMatchingPool(pool)))
+}
+
+// pathMatchingPool loads the :id matching pool, writing the error response when it cannot
+func (server *Server) pathMatchingPool(ctx *gin.Context) (db.MatchingPools, bool) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid matching pool ID"), http.StatusBadRequest))
+		return db.MatchingPools{}, false
+	}
+
+	pool, err := server.store.GetMatchingPool(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("matching pool not found"), http.StatusNotFound))
+			return db.MatchingPools{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.MatchingPools{}, false
+	}
+
+	return pool, true
+}
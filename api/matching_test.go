@@ -0,0 +1,239 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMatchingPool(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"name": "Water week", "ratio_percent": 100, "cap": 2, "max_match": 0.5, "categories": []string{"water"}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateMatchingPool(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateMatchingPoolParams) (db.MatchingPools, error) {
+						t.Cleanup(func() { os.Remove(arg.FilePath) })
+						require.Equal(t, user.Username, arg.Sponsor)
+						require.Equal(t, defi.SepoliaChainID, arg.ChainID)
+						require.NotEmpty(t, arg.WalletAddress)
+						require.Equal(t, utils.EtherToWei(2), arg.Cap)
+						require.Equal(t, sql.NullString{String: utils.EtherToWei(0.5), Valid: true}, arg.MaxMatch)
+						require.Equal(t, "0", arg.MinDonation)
+						require.Equal(t, []int64{}, arg.CampaignIds)
+						require.Equal(t, []string{"water"}, arg.Categories)
+						return db.MatchingPools{ID: 1, Sponsor: arg.Sponsor, Cap: arg.Cap, MatchedTotal: "0", Status: interfaces.MatchingPoolActive}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"cap":2`)
+				require.NotContains(t, recorder.Body.String(), "file_path")
+			},
+		},
+		{
+			name: "MaxMatchAboveCap",
+			body: gin.H{"name": "Water week", "ratio_percent": 100, "cap": 1, "max_match": 2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateMatchingPool(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidRatio",
+			body: gin.H{"name": "Water week", "ratio_percent": 5000, "cap": 1},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateMatchingPool(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/matching-pools", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetMatchingPool(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	// no chain is configured with this ID, so the report is served without the wallet balance
+	pool := db.MatchingPools{ID: 4, Sponsor: "sponsor", ChainID: 999, ContractVersion: 1, Cap: "2000000000000000000", MatchedTotal: "500000000000000000", Status: interfaces.MatchingPoolActive}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetMatchingPool(gomock.Any(), gomock.Eq(pool.ID)).Times(1).Return(pool, nil)
+	store.EXPECT().SumMatchingPoolMatches(gomock.Any(), gomock.Eq(pool.ID)).Times(1).Return([]db.SumMatchingPoolMatchesRow{
+		{CampaignID: 7, MatchCount: 2, MatchedAmount: "500000000000000000"},
+	}, nil)
+	store.EXPECT().ListMatchingPoolMatches(gomock.Any(), gomock.Eq(db.ListMatchingPoolMatchesParams{PoolID: pool.ID, Limit: recentMatchesLimit})).Times(1).Return([]db.MatchingPoolMatches{
+		{ID: 2, CampaignID: 7, Amount: "0", Status: interfaces.MatchSkipped, Reason: sql.NullString{String: "the campaign has ended", Valid: true}},
+	}, nil)
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/matching-pools/%d", pool.ID), nil)
+	require.NoError(t, err)
+
+	addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	body := recorder.Body.String()
+	require.Contains(t, body, `"remaining":1.5`)
+	require.Contains(t, body, `"campaigns":[{"campaign_id":7,"matches":2,"matched_amount":0.5}]`)
+	require.Contains(t, body, `"reason":"the campaign has ended"`)
+}
+
+func TestCloseMatchingPool(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	pool := db.MatchingPools{ID: 4, Sponsor: user.Username, ChainID: defi.SepoliaChainID, ContractVersion: 1, Cap: "1", MatchedTotal: "0", Status: interfaces.MatchingPoolActive}
+
+	testCases := []struct {
+		name          string
+		pool          db.MatchingPools
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "NotSponsor",
+			pool: func() db.MatchingPools {
+				other := pool
+				other.Sponsor = "someone-else"
+				return other
+			}(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CloseMatchingPool(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyRefunded",
+			pool: pool,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CloseMatchingPool(gomock.Any(), gomock.Eq(pool.ID)).Times(1).Return(db.MatchingPools{}, sql.ErrNoRows)
+				store.EXPECT().SetMatchingPoolRefund(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetMatchingPool(gomock.Any(), gomock.Eq(pool.ID)).Times(1).Return(tc.pool, nil)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/api/v1/matching-pools/%d/close", pool.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListMatchingPools(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListMatchingPools(gomock.Any(), gomock.Eq(db.ListMatchingPoolsParams{Limit: 2})).
+		Times(1).
+		Return([]db.MatchingPools{{ID: 9, Cap: "1", MatchedTotal: "0"}, {ID: 8, Cap: "1", MatchedTotal: "0"}}, nil)
+	store.EXPECT().CountMatchingPools(gomock.Any()).Times(1).Return(int64(5), nil)
+
+	server := newTestServer(t, store)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/matching-pools?limit=1", nil)
+	require.NoError(t, err)
+
+	addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+	server.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp struct {
+		Data struct {
+			Items      []interfaces.MatchingPool `json:"items"`
+			NextCursor string                    `json:"next_cursor"`
+			TotalCount int64                     `json:"total_count"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	require.Len(t, rsp.Data.Items, 1)
+	require.Equal(t, int64(9), rsp.Data.Items[0].ID)
+	require.Equal(t, int64(5), rsp.Data.TotalCount)
+
+	var cursor idCursor
+	require.NoError(t, decodeCursor(rsp.Data.NextCursor, &cursor))
+	require.Equal(t, int64(9), cursor.ID)
+}
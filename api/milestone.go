@@ -0,0 +1,530 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Set campaign milestones
+// @Description Split a campaign's funds into milestones. A campaign with milestones is paid into escrow when it ends funded, and each milestone is released to the owner once donors or a moderator approve the owner's evidence. Milestones can only be set once, before the deadline, and must leave enough of the goal to pay the gas of the escrow deposit at ESCROW_GAS_PRICE.
+// @Accept  json
+// @Produce  json
+// @Tags Milestones
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.CreateMilestonesRequest    true  "Milestones, amounts in the chain's native currency"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Milestone}	"success"
+// @Router /campaigns/{id}/milestones [post]
+func (server *Server) createCampaignMilestones(ctx *gin.Context) {
+	var req interfaces.CreateMilestonesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	campaign, _, ok := server.ownedCampaign(ctx)
+	if !ok {
+		return
+	}
+	if time.Now().After(campaign.Deadline) {
+		err := errors.New("campaign has ended")
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	existing, err := server.store.ListCampaignMilestones(ctx, db.ListCampaignMilestonesParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if len(existing) > 0 {
+		err := errors.New("campaign milestones are already set")
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+		return
+	}
+
+	params := db.CreateCampaignMilestonesParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	}
+	total := new(big.Int)
+	for _, milestone := range req.Milestones {
+		amount := utils.EtherToWei(milestone.Amount)
+		wei, _ := new(big.Int).SetString(amount, 10)
+		total.Add(total, wei)
+
+		params.Titles = append(params.Titles, milestone.Title)
+		params.Descriptions = append(params.Descriptions, milestone.Description)
+		params.Amounts = append(params.Amounts, amount)
+	}
+
+	// the owner's wallet pays the gas of depositing the milestone total into escrow out of the rest
+	goal, ok := new(big.Int).SetString(campaign.Goal, 10)
+	reserve := escrowDepositReserve(server.config)
+	if !ok || new(big.Int).Add(total, reserve).Cmp(goal) > 0 {
+		err := fmt.Errorf("milestones must leave at least %g of the campaign goal for the gas of the escrow deposit", utils.WeiToEther(reserve.String()))
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	milestones, err := server.store.CreateCampaignMilestones(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := make([]interfaces.Milestone, len(milestones))
+	for i, milestone := range milestones {
+		rsp[i] = interfaces.NewMilestone(milestone, db.SumMilestoneVotesRow{ApproveWeight: "0", RejectWeight: "0"})
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Get campaign milestones
+// @Description Get a campaign's milestones in order, with their status and donor vote tally
+// @Accept  json
+// @Produce  json
+// @Tags Milestones
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Milestone}	"success"
+// @Router /campaigns/{id}/milestones [get]
+func (server *Server) listCampaignMilestones(ctx *gin.Context) {
+	campaign, ok := server.pathCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	milestones, err := server.store.ListCampaignMilestones(ctx, db.ListCampaignMilestonesParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := make([]interfaces.Milestone, len(milestones))
+	for i, milestone := range milestones {
+		rsp[i], err = server.milestoneResponse(ctx, milestone)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Submit milestone evidence
+// @Description Submit evidence that a milestone is complete, opening it to donor votes and moderator review. Milestones are submitted in order, and a rejected milestone can be submitted again.
+// @Accept  json
+// @Produce  json
+// @Tags Milestones
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param milestone_id path int true "Milestone ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.SubmitMilestoneRequest    true  "Evidence"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Milestone}	"success"
+// @Router /campaigns/{id}/milestones/{milestone_id}/submit [post]
+func (server *Server) submitCampaignMilestone(ctx *gin.Context) {
+	var req interfaces.SubmitMilestoneRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	campaign, _, ok := server.ownedCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	milestone, ok := server.campaignMilestone(ctx, campaign)
+	if !ok {
+		return
+	}
+
+	milestones, err := server.store.ListCampaignMilestones(ctx, db.ListCampaignMilestonesParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	for _, previous := range milestones {
+		if previous.Position < milestone.Position && previous.Status != interfaces.MilestoneApproved && previous.Status != interfaces.MilestoneReleased {
+			err := errors.New("earlier milestones must be approved first")
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+			return
+		}
+	}
+
+	// votes on a rejected submission do not carry over to the new evidence
+	if err := server.store.DeleteMilestoneVotes(ctx, milestone.ID); err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	milestone, err = server.store.SubmitCampaignMilestone(ctx, db.SubmitCampaignMilestoneParams{
+		ID:          milestone.ID,
+		Evidence:    req.Evidence,
+		EvidenceURL: req.EvidenceURL,
+	})
+	if err != nil {
+		server.respondMilestoneUpdateError(ctx, err)
+		return
+	}
+
+	server.respondMilestone(ctx, milestone)
+}
+
+// @Summary Vote on a milestone
+// @Description Approve or reject a submitted milestone as a donor. Votes are weighted by the voter's donations to the campaign; a milestone is approved once approving donors gave more than half of the campaign's donations, and rejected once rejecting donors gave at least half.
+// @Accept  json
+// @Produce  json
+// @Tags Milestones
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param milestone_id path int true "Milestone ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.MilestoneDecisionRequest    true  "Vote"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Milestone}	"success"
+// @Router /campaigns/{id}/milestones/{milestone_id}/vote [post]
+func (server *Server) voteCampaignMilestone(ctx *gin.Context) {
+	var req interfaces.MilestoneDecisionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	campaign, ok := server.pathCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	milestone, ok := server.campaignMilestone(ctx, campaign)
+	if !ok {
+		return
+	}
+	if milestone.Status != interfaces.MilestoneSubmitted {
+		err := errors.New("milestone is not open for votes")
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+		return
+	}
+
+	donors, err := server.donorAddresses(ctx, user)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	weight, err := server.store.SumDonorCampaignDonationRecords(ctx, db.SumDonorCampaignDonationRecordsParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+		Donors:          donors,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if wei, ok := new(big.Int).SetString(weight, 10); !ok || wei.Sign() <= 0 {
+		err := errors.New("only donors to the campaign can vote")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	// a user votes once with the donations of all their wallets
+	_, err = server.store.UpsertMilestoneVote(ctx, db.UpsertMilestoneVoteParams{
+		MilestoneID:  milestone.ID,
+		VoterAddress: strings.ToLower(user.Address),
+		Approve:      *req.Approve,
+		Weight:       weight,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	votes, err := server.store.SumMilestoneVotes(ctx, milestone.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	donated, err := server.store.SumCampaignDonationRecords(ctx, db.SumCampaignDonationRecordsParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	switch milestoneOutcome(votes.ApproveWeight, votes.RejectWeight, donated.TotalAmount) {
+	case interfaces.MilestoneApproved:
+		milestone, err = server.store.ApproveCampaignMilestone(ctx, db.ApproveCampaignMilestoneParams{
+			ID:         milestone.ID,
+			ApprovedBy: interfaces.MilestoneApprovedByDonors,
+		})
+	case interfaces.MilestoneRejected:
+		milestone, err = server.store.RejectCampaignMilestone(ctx, db.RejectCampaignMilestoneParams{
+			ID:         milestone.ID,
+			ApprovedBy: interfaces.MilestoneApprovedByDonors,
+		})
+	}
+	if err != nil {
+		server.respondMilestoneUpdateError(ctx, err)
+		return
+	}
+
+	server.respondMilestone(ctx, milestone)
+}
+
+// @Summary Review a milestone
+// @Description Approve or reject a submitted milestone as a moderator, without waiting for donor votes. Moderators are the users listed in MODERATORS.
+// @Accept  json
+// @Produce  json
+// @Tags Milestones
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param milestone_id path int true "Milestone ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.MilestoneDecisionRequest    true  "Decision"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Milestone}	"success"
+// @Router /campaigns/{id}/milestones/{milestone_id}/review [post]
+func (server *Server) reviewCampaignMilestone(ctx *gin.Context) {
+	var req interfaces.MilestoneDecisionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	campaign, ok := server.pathCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+	if !server.isModerator(user.Username) {
+		err := errors.New("only moderators can review milestones")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	milestone, ok := server.campaignMilestone(ctx, campaign)
+	if !ok {
+		return
+	}
+
+	var err error
+	if *req.Approve {
+		milestone, err = server.store.ApproveCampaignMilestone(ctx, db.ApproveCampaignMilestoneParams{
+			ID:         milestone.ID,
+			ApprovedBy: "moderator:" + user.Username,
+		})
+	} else {
+		milestone, err = server.store.RejectCampaignMilestone(ctx, db.RejectCampaignMilestoneParams{
+			ID:         milestone.ID,
+			ApprovedBy: "moderator:" + user.Username,
+		})
+	}
+	if err != nil {
+		server.respondMilestoneUpdateError(ctx, err)
+		return
+	}
+
+	server.respondMilestone(ctx, milestone)
+}
+
+// milestoneCampaign resolves the indexed campaign of the :id path parameter on the requested chain
+func (server *Server) pathCampaign(ctx *gin.Context) (db.IndexedCampaigns, bool) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid campaign ID"), http.StatusBadRequest))
+		return db.IndexedCampaigns{}, false
+	}
+
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return db.IndexedCampaigns{}, false
+	}
+
+	campaign, err := server.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      id,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("campaign not found"), http.StatusNotFound))
+			return db.IndexedCampaigns{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.IndexedCampaigns{}, false
+	}
+
+	return campaign, true
+}
+
+// ownedCampaign resolves the campaign of the :id path parameter and the current user, writing a 403
+// response unless the user owns it
+func (server *Server) ownedCampaign(ctx *gin.Context) (db.IndexedCampaigns, db.Users, bool) {
+	campaign, ok := server.pathCampaign(ctx)
+	if !ok {
+		return db.IndexedCampaigns{}, db.Users{}, false
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return db.IndexedCampaigns{}, db.Users{}, false
+	}
+	if !strings.EqualFold(campaign.Owner, user.Address) {
+		err := errors.New("only the campaign owner can do this")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return db.IndexedCampaigns{}, db.Users{}, false
+	}
+
+	return campaign, user, true
+}
+
+// campaignMilestone loads the :milestone_id milestone, writing a 404 response unless it belongs to campaign
+func (server *Server) campaignMilestone(ctx *gin.Context, campaign db.IndexedCampaigns) (db.CampaignMilestones, bool) {
+	id, err := strconv.ParseInt(ctx.Param("milestone_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid milestone ID"), http.StatusBadRequest))
+		return db.CampaignMilestones{}, false
+	}
+
+	milestone, err := server.store.GetCampaignMilestone(ctx, id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.CampaignMilestones{}, false
+	}
+	if err != nil || milestone.ChainID != campaign.ChainID || milestone.ContractVersion != campaign.ContractVersion || milestone.CampaignID != campaign.CampaignID {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("milestone not found"), http.StatusNotFound))
+		return db.CampaignMilestones{}, false
+	}
+
+	return milestone, true
+}
+
+func (server *Server) milestoneResponse(ctx *gin.Context, milestone db.CampaignMilestones) (interfaces.Milestone, error) {
+	votes, err := server.store.SumMilestoneVotes(ctx, milestone.ID)
+	if err != nil {
+		return interfaces.Milestone{}, err
+	}
+	return interfaces.NewMilestone(milestone, votes), nil
+}
+
+func (server *Server) respondMilestone(ctx *gin.Context, milestone db.CampaignMilestones) {
+	rsp, err := server.milestoneResponse(ctx, milestone)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// respondMilestoneUpdateError writes a 409 response when a status update found the milestone in
+// another status, which the update queries report as no rows
+func (server *Server) respondMilestoneUpdateError(ctx *gin.Context, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		err := errors.New("milestone is not in a status that allows this")
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+}
+
+// isModerator reports whether a user is listed in MODERATORS
+func (server *Server) isModerator(username string) bool {
+	for _, moderator := range strings.Split(server.config.Moderators, ",") {
+		if moderator = strings.TrimSpace(moderator); moderator != "" && strings.EqualFold(moderator, username) {
+			return true
+		}
+	}
+	return false
+}
+
+// milestoneOutcome decides a donor vote from the weights of the votes and the campaign's donations,
+// all in wei: approved once approvals pass half of the donations, rejected once rejections reach half,
+// otherwise empty while the vote is open
+func milestoneOutcome(approveWeight, rejectWeight, donated string) string {
+	approve, ok1 := new(big.Int).SetString(approveWeight, 10)
+	reject, ok2 := new(big.Int).SetString(rejectWeight, 10)
+	total, ok3 := new(big.Int).SetString(donated, 10)
+	if !ok1 || !ok2 || !ok3 || total.Sign() <= 0 {
+		return ""
+	}
+
+	switch {
+	case new(big.Int).Lsh(approve, 1).Cmp(total) > 0:
+		return interfaces.MilestoneApproved
+	case new(big.Int).Lsh(reject, 1).Cmp(total) >= 0:
+		return interfaces.MilestoneRejected
+	}
+	return ""
+}
+
+// hasMilestones reports whether a campaign's funds are released per milestone rather than withdrawn at once
+func (server *Server) hasMilestones(ctx *gin.Context, chain *defi.Chain, campaignID int64) (bool, error) {
+	milestones, err := server.store.ListCampaignMilestones(ctx, db.ListCampaignMilestonesParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      campaignID,
+	})
+	return len(milestones) > 0, err
+}
+
+// defaultEscrowGasPrice is the gas price in gwei the escrow deposit is reserved for when ESCROW_GAS_PRICE
+// is not configured
+const defaultEscrowGasPrice = 100
+
+// escrowDepositReserve returns the wei a campaign's goal must keep beside its milestones to pay the gas
+// of the escrow deposit, a plain transfer, at ESCROW_GAS_PRICE
+func escrowDepositReserve(configs utils.Config) *big.Int {
+	gwei := configs.EscrowGasPrice
+	if gwei <= 0 {
+		gwei = defaultEscrowGasPrice
+	}
+	return defi.TransferCost(utils.EtherToWeiInt(gwei / 1e9))
+}
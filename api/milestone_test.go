@@ -0,0 +1,390 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMilestoneOutcome(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		approve, reject, total string
+		outcome                string
+	}{
+		{name: "Majority", approve: "51", reject: "0", total: "100", outcome: interfaces.MilestoneApproved},
+		{name: "HalfApproves", approve: "50", reject: "0", total: "100", outcome: ""},
+		{name: "HalfRejects", approve: "50", reject: "50", total: "100", outcome: interfaces.MilestoneRejected},
+		{name: "Open", approve: "10", reject: "20", total: "100", outcome: ""},
+		{name: "NoDonations", approve: "0", reject: "0", total: "0", outcome: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.outcome, milestoneOutcome(tc.approve, tc.reject, tc.total))
+		})
+	}
+}
+
+func TestCreateCampaignMilestones(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xAbC0000000000000000000000000000000000001"}
+	campaign := db.IndexedCampaigns{
+		ChainID:         defi.SepoliaChainID,
+		ContractVersion: 1,
+		CampaignID:      7,
+		Owner:           "0xabc0000000000000000000000000000000000001",
+		Goal:            "2000000000000000000",
+		Deadline:        time.Now().Add(time.Hour),
+	}
+	listParams := db.ListCampaignMilestonesParams{ChainID: campaign.ChainID, ContractVersion: 1, CampaignID: 7}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"milestones": []gin.H{
+				{"title": "Prototype", "description": "Build it", "amount": 0.5},
+				{"title": "Launch", "description": "Ship it", "amount": 1.4},
+			}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignMilestones(gomock.Any(), gomock.Eq(listParams)).Times(1).Return(nil, nil)
+				store.EXPECT().
+					CreateCampaignMilestones(gomock.Any(), gomock.Eq(db.CreateCampaignMilestonesParams{
+						ChainID:         campaign.ChainID,
+						ContractVersion: 1,
+						CampaignID:      7,
+						Titles:          []string{"Prototype", "Launch"},
+						Descriptions:    []string{"Build it", "Ship it"},
+						Amounts:         []string{"500000000000000000", "1400000000000000000"},
+					})).
+					Times(1).
+					Return([]db.CampaignMilestones{
+						{ID: 1, CampaignID: 7, Position: 1, Title: "Prototype", Amount: "500000000000000000", Status: interfaces.MilestonePending},
+						{ID: 2, CampaignID: 7, Position: 2, Title: "Launch", Amount: "1400000000000000000", Status: interfaces.MilestonePending},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"amount":1.4`)
+			},
+		},
+		{
+			name: "OverGoal",
+			body: gin.H{"milestones": []gin.H{{"title": "Everything", "description": "All of it", "amount": 3}}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignMilestones(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().CreateCampaignMilestones(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "WholeGoal",
+			body: gin.H{"milestones": []gin.H{{"title": "Everything", "description": "All of it", "amount": 2}}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignMilestones(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().CreateCampaignMilestones(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NoGasReserve",
+			body: gin.H{"milestones": []gin.H{{"title": "Nearly everything", "description": "Almost all of it", "amount": 1.999}}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignMilestones(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().CreateCampaignMilestones(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotOwner",
+			body: gin.H{"milestones": []gin.H{{"title": "Prototype", "description": "Build it", "amount": 1}}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.Users{Username: user.Username, Address: "0x01"}, nil)
+				store.EXPECT().CreateCampaignMilestones(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadySet",
+			body: gin.H{"milestones": []gin.H{{"title": "Prototype", "description": "Build it", "amount": 1}}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignMilestones(gomock.Any(), gomock.Any()).Times(1).Return([]db.CampaignMilestones{{ID: 1}}, nil)
+				store.EXPECT().CreateCampaignMilestones(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "CampaignNotFound",
+			body: gin.H{"milestones": []gin.H{{"title": "Prototype", "description": "Build it", "amount": 1}}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(db.IndexedCampaigns{}, sql.ErrNoRows)
+				store.EXPECT().CreateCampaignMilestones(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/campaigns/7/milestones", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestVoteCampaignMilestone(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xAbC0000000000000000000000000000000000002"}
+	campaign := db.IndexedCampaigns{ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7}
+	milestone := db.CampaignMilestones{ID: 3, ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7, Amount: "1", Status: interfaces.MilestoneSubmitted}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Approves",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Eq(milestone.ID)).Times(1).Return(milestone, nil)
+				store.EXPECT().
+					SumDonorCampaignDonationRecords(gomock.Any(), gomock.Eq(db.SumDonorCampaignDonationRecordsParams{
+						ChainID:         defi.SepoliaChainID,
+						ContractVersion: 1,
+						CampaignID:      7,
+						Donors:          []string{"0xabc0000000000000000000000000000000000002"},
+					})).
+					Times(1).
+					Return("60", nil)
+				store.EXPECT().
+					UpsertMilestoneVote(gomock.Any(), gomock.Eq(db.UpsertMilestoneVoteParams{
+						MilestoneID:  milestone.ID,
+						VoterAddress: "0xabc0000000000000000000000000000000000002",
+						Approve:      true,
+						Weight:       "60",
+					})).
+					Times(1)
+				store.EXPECT().SumMilestoneVotes(gomock.Any(), gomock.Eq(milestone.ID)).Times(2).Return(db.SumMilestoneVotesRow{ApproveWeight: "60", RejectWeight: "0", VoteCount: 1}, nil)
+				store.EXPECT().SumCampaignDonationRecords(gomock.Any(), gomock.Any()).Times(1).Return(db.SumCampaignDonationRecordsRow{TotalAmount: "100"}, nil)
+
+				approved := milestone
+				approved.Status = interfaces.MilestoneApproved
+				store.EXPECT().
+					ApproveCampaignMilestone(gomock.Any(), gomock.Eq(db.ApproveCampaignMilestoneParams{ID: milestone.ID, ApprovedBy: interfaces.MilestoneApprovedByDonors})).
+					Times(1).
+					Return(approved, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"status":"approved"`)
+			},
+		},
+		{
+			name: "NotDonor",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Any()).Times(1).Return(milestone, nil)
+				store.EXPECT().SumDonorCampaignDonationRecords(gomock.Any(), gomock.Any()).Times(1).Return("0", nil)
+				store.EXPECT().UpsertMilestoneVote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "UnverifiedLinkedWallet",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Any()).Times(1).Return(milestone, nil)
+				store.EXPECT().ListActiveWalletAddresses(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					SumDonorCampaignDonationRecords(gomock.Any(), gomock.Eq(db.SumDonorCampaignDonationRecordsParams{
+						ChainID:         defi.SepoliaChainID,
+						ContractVersion: 1,
+						CampaignID:      7,
+						Donors:          []string{"0xabc0000000000000000000000000000000000002"},
+					})).
+					Times(1).
+					Return("0", nil)
+				store.EXPECT().UpsertMilestoneVote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "NotSubmitted",
+			buildStubs: func(store *mockdb.MockStore) {
+				pending := milestone
+				pending.Status = interfaces.MilestonePending
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Any()).Times(1).Return(pending, nil)
+				store.EXPECT().UpsertMilestoneVote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/api/v1/campaigns/7/milestones/%d/vote", milestone.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader([]byte(`{"approve":true}`)))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestReviewCampaignMilestone(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	campaign := db.IndexedCampaigns{ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7}
+	milestone := db.CampaignMilestones{ID: 3, ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7, Amount: "1", Status: interfaces.MilestoneSubmitted}
+
+	testCases := []struct {
+		name          string
+		moderators    string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "Moderator",
+			moderators: "admin, " + user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Any()).Times(1).Return(milestone, nil)
+				store.EXPECT().
+					RejectCampaignMilestone(gomock.Any(), gomock.Eq(db.RejectCampaignMilestoneParams{ID: milestone.ID, ApprovedBy: "moderator:" + user.Username})).
+					Times(1).
+					Return(milestone, nil)
+				store.EXPECT().SumMilestoneVotes(gomock.Any(), gomock.Any()).Times(1).Return(db.SumMilestoneVotesRow{ApproveWeight: "0", RejectWeight: "0"}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "AlreadyDecided",
+			moderators: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Any()).Times(1).Return(milestone, nil)
+				store.EXPECT().RejectCampaignMilestone(gomock.Any(), gomock.Any()).Times(1).Return(db.CampaignMilestones{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:       "NotModerator",
+			moderators: "admin",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().RejectCampaignMilestone(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.config.Moderators = tc.moderators
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/api/v1/campaigns/7/milestones/%d/review", milestone.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader([]byte(`{"approve":false}`)))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
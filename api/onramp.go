@@ -0,0 +1,142 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/onramp"
+	"github.com/gin-gonic/gin"
+)
+
+// maxWebhookBody bounds the on-ramp webhook bodies read
+const maxWebhookBody = 1 << 20
+
+var errOnRampDisabled = errors.New("buying crypto is not enabled")
+
+// @Summary Buy crypto
+// @Description Buy the chain's native currency with fiat money through the on-ramp provider, delivered to the user's custodial wallet. Pay at the returned checkout_url. With campaign_id the purchase, less the gas of the donation, is donated to the campaign once its delivery is mined.
+// @Accept  json
+// @Produce  json
+// @Tags OnRamp
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param   data        body   interfaces.CreateOnRampOrderRequest    true  "On-ramp order"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.OnRampOrder}	"success"
+// @Router /onramp/orders [post]
+func (server *Server) createOnRampOrder(ctx *gin.Context) {
+	var req interfaces.CreateOnRampOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+	if server.onramp == nil {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errOnRampDisabled, http.StatusNotFound))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
+		return
+	}
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	if req.CampaignID != nil {
+		campaign, err := server.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+			ChainID:         chain.ID,
+			ContractVersion: int32(chain.Version),
+			CampaignID:      *req.CampaignID,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("campaign not found"), http.StatusNotFound))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+		if !time.Now().Before(campaign.Deadline) {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("campaign has closed"), http.StatusBadRequest))
+			return
+		}
+	}
+
+	order, err := server.onramp.CreateOrder(ctx, chain, user, onramp.CreateOrderParams{
+		FiatAmount:   req.FiatAmount,
+		FiatCurrency: req.FiatCurrency,
+		CampaignID:   req.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusBadGateway, interfaces.ErrorResponse(err, http.StatusBadGateway))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewOnRampOrder(order)))
+}
+
+// @Summary Get my crypto purchases
+// @Description List the user's on-ramp orders, newest first
+// @Produce  json
+// @Tags OnRamp
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.OnRampOrder}	"success"
+// @Router /onramp/orders [get]
+func (server *Server) listOnRampOrders(ctx *gin.Context) {
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	orders, err := server.store.ListUserOnRampOrders(ctx, user.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := make([]interfaces.OnRampOrder, 0, len(orders))
+	for _, order := range orders {
+		rsp = append(rsp, interfaces.NewOnRampOrder(order))
+	}
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary On-ramp webhook
+// @Description Receive the on-ramp provider's order updates. The provider signs each webhook; a completed order credits the purchase to the user's wallet, and the purchase of an order made for a campaign is donated once its delivery is mined.
+// @Accept  json
+// @Produce  json
+// @Tags OnRamp
+// @Success		200				{object}    interfaces.DocSuccessResponse	"success"
+// @Failure		401				{object}   string	"Invalid signature"
+// @Router /onramp/webhook [post]
+func (server *Server) onRampWebhook(ctx *gin.Context) {
+	if server.onramp == nil {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errOnRampDisabled, http.StatusNotFound))
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxWebhookBody))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	order, err := server.onramp.HandleWebhook(ctx, ctx.Request.Header, body)
+	switch {
+	case err == nil:
+		ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, order.Status))
+	case errors.Is(err, onramp.ErrInvalidSignature):
+		ctx.JSON(http.StatusUnauthorized, interfaces.ErrorResponse(err, http.StatusUnauthorized))
+	case errors.Is(err, onramp.ErrInvalidWebhook):
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+	case errors.Is(err, onramp.ErrUnknownOrder):
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+	default:
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+	}
+}
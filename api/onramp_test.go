@@ -0,0 +1,174 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/onramp"
+	"github.com/demola234/defiraise/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOnRampOrder(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001"}
+
+	testCases := []struct {
+		name          string
+		body          string
+		enabled       bool
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "OK",
+			body:    `{"fiat_amount": 50, "fiat_currency": "USD", "campaign_id": 2}`,
+			enabled: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(db.IndexedCampaigns{CampaignID: 2, Deadline: time.Now().Add(time.Hour)}, nil)
+				store.EXPECT().
+					CreateOnRampOrder(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateOnRampOrderParams) (db.OnrampOrders, error) {
+						require.Equal(t, "50.00", arg.FiatAmount)
+						require.Equal(t, user.Address, arg.Address)
+						require.Equal(t, sql.NullInt64{Int64: 2, Valid: true}, arg.CampaignID)
+						return db.OnrampOrders{ID: 1, Provider: onramp.MockName, Status: onramp.StatusPending, CampaignID: arg.CampaignID}, nil
+					})
+				store.EXPECT().
+					SetOnRampOrderCheckout(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.SetOnRampOrderCheckoutParams) (db.OnrampOrders, error) {
+						return db.OnrampOrders{ID: 1, Provider: onramp.MockName, Status: onramp.StatusPending, CheckoutURL: arg.CheckoutURL}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"checkout_url":"https://checkout.mock.invalid/orders/mock-1"`)
+			},
+		},
+		{
+			name:    "CampaignClosed",
+			body:    `{"fiat_amount": 50, "fiat_currency": "USD", "campaign_id": 2}`,
+			enabled: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(db.IndexedCampaigns{CampaignID: 2, Deadline: time.Now().Add(-time.Hour)}, nil)
+				store.EXPECT().CreateOnRampOrder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:    "InvalidCurrency",
+			body:    `{"fiat_amount": 50, "fiat_currency": "XYZ"}`,
+			enabled: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateOnRampOrder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Disabled",
+			body: `{"fiat_amount": 50, "fiat_currency": "USD"}`,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateOnRampOrder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			if tc.enabled {
+				server.onramp = onramp.NewServiceWithProvider(store, server.chains, onramp.NewMockProvider("secret"), server.config)
+			}
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/onramp/orders", bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestOnRampWebhook(t *testing.T) {
+	provider := onramp.NewMockProvider("secret")
+	body, err := json.Marshal(onramp.MockWebhook{OrderID: "mock-1", Status: onramp.StatusCompleted, CryptoAmount: "20000000000000000"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		signature     string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			signature: provider.SignWebhook(body),
+			buildStubs: func(store *mockdb.MockStore) {
+				order := db.OnrampOrders{ID: 1, Provider: onramp.MockName, Status: onramp.StatusPending}
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(1).Return(order, nil)
+				order.Status = onramp.StatusCompleted
+				store.EXPECT().CompleteOnRampOrder(gomock.Any(), gomock.Any()).Times(1).Return(order, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidSignature",
+			signature: onramp.NewMockProvider("other").SignWebhook(body),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.onramp = onramp.NewServiceWithProvider(store, server.chains, provider, server.config)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/onramp/webhook", bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set(onramp.MockSignatureHeader, tc.signature)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
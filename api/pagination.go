@@ -0,0 +1,179 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/gin-gonic/gin"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// idCursor is the keyset position for tables ordered by their row ID
+type idCursor struct {
+	ID int64 `json:"id"`
+}
+
+// campaignCursor is the keyset position for campaign lists ordered by (contract version, campaign ID).
+// Campaign IDs restart on every contract version, so the ID alone does not place a campaign.
+type campaignCursor struct {
+	ID      int64 `json:"id"`
+	Version int32 `json:"version"`
+}
+
+// offsetCursor is the position for chain lists that have no stable key, such as donors
+type offsetCursor struct {
+	Offset int `json:"offset"`
+}
+
+// createdAtCursor is the keyset position for tables ordered by (created_at, id) DESC
+type createdAtCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int64     `json:"id"`
+}
+
+// encodeCursor turns the position of the last item on a page into an opaque token
+func encodeCursor(position interface{}) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor reads a token produced by encodeCursor back into position
+func decodeCursor(token string, position interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return errInvalidCursor
+	}
+
+	if err := json.Unmarshal(data, position); err != nil {
+		return errInvalidCursor
+	}
+
+	return nil
+}
+
+// pageOffset returns the [start, end) window of a list of total items and the cursor for the next window
+func pageOffset(total int, req interfaces.PageRequest) (start, end int, next string, err error) {
+	var cursor offsetCursor
+	if req.Cursor != "" {
+		if err := decodeCursor(req.Cursor, &cursor); err != nil {
+			return 0, 0, "", err
+		}
+		if cursor.Offset < 0 {
+			return 0, 0, "", errInvalidCursor
+		}
+	}
+
+	start = cursor.Offset
+	if start > total {
+		start = total
+	}
+
+	end = start + int(req.PageSize())
+	if end > total {
+		end = total
+	}
+
+	if end < total {
+		next, err = encodeCursor(offsetCursor{Offset: end})
+		if err != nil {
+			return 0, 0, "", err
+		}
+	}
+
+	return start, end, next, nil
+}
+
+// campaignFilter narrows a campaign list. Unset fields match every campaign.
+type campaignFilter struct {
+	activeOnly bool
+	category   sql.NullString
+	owner      sql.NullString
+}
+
+// respondCampaignPage writes one page of the chain's indexed campaigns that match filter, in ascending
+// (contract version, campaign ID) order and valued in the requested or preferred currency
+func (server *Server) respondCampaignPage(ctx *gin.Context, req interfaces.ListCampaignRequest, chain *defi.Chain, filter campaignFilter) {
+	var cursor campaignCursor
+	if req.Cursor != "" {
+		if err := decodeCursor(req.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	// without a contract_version, every version of the chain is listed
+	contractVersion := sql.NullInt32{Int32: int32(req.ContractVersion), Valid: req.ContractVersion != 0}
+
+	// fetch one extra row to learn whether another page exists
+	pageSize := req.PageSize()
+	campaigns, err := server.store.ListIndexedCampaigns(ctx, db.ListIndexedCampaignsParams{
+		ChainID:         chain.ID,
+		ContractVersion: contractVersion,
+		ActiveOnly:      filter.activeOnly,
+		Category:        filter.category,
+		Owner:           filter.owner,
+		CursorID:        sql.NullInt64{Int64: cursor.ID, Valid: req.Cursor != ""},
+		CursorVersion:   sql.NullInt32{Int32: cursor.Version, Valid: req.Cursor != ""},
+		Limit:           pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	total, err := server.store.CountIndexedCampaigns(ctx, db.CountIndexedCampaignsParams{
+		ChainID:         chain.ID,
+		ContractVersion: contractVersion,
+		ActiveOnly:      filter.activeOnly,
+		Category:        filter.category,
+		Owner:           filter.owner,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	page := interfaces.Page{TotalCount: total}
+
+	if len(campaigns) > int(pageSize) {
+		campaigns = campaigns[:pageSize]
+
+		last := campaigns[len(campaigns)-1]
+		page.NextCursor, err = encodeCursor(campaignCursor{ID: last.CampaignID, Version: last.ContractVersion})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	items := make([]interfaces.Campaigns, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		owner, _ := server.store.GetUserByAddress(ctx, campaign.Owner)
+		items = append(items, interfaces.NewIndexedCampaign(campaign, owner, chain.NativeSymbol))
+	}
+
+	currency, err := server.listCurrency(ctx, req.Currency)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if err := server.withFiat(ctx, items, currency); err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	page.Items = items
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
+}
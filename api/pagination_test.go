@@ -0,0 +1,72 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageOffset(t *testing.T) {
+	testCases := []struct {
+		name      string
+		total     int
+		req       interfaces.PageRequest
+		start     int
+		end       int
+		hasNext   bool
+		expectErr bool
+	}{
+		{
+			name:    "DefaultPageSize",
+			total:   50,
+			req:     interfaces.PageRequest{},
+			start:   0,
+			end:     int(interfaces.DefaultPageSize),
+			hasNext: true,
+		},
+		{
+			name:  "LastPage",
+			total: 3,
+			req:   interfaces.PageRequest{Limit: 10},
+			start: 0,
+			end:   3,
+		},
+		{
+			name:  "EmptyList",
+			total: 0,
+			req:   interfaces.PageRequest{Limit: 10},
+			start: 0,
+			end:   0,
+		},
+		{
+			name:      "InvalidCursor",
+			total:     3,
+			req:       interfaces.PageRequest{Cursor: "%%%"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, next, err := pageOffset(tc.total, tc.req)
+			if tc.expectErr {
+				require.ErrorIs(t, err, errInvalidCursor)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.start, start)
+			require.Equal(t, tc.end, end)
+			require.Equal(t, tc.hasNext, next != "")
+		})
+	}
+
+	_, _, next, err := pageOffset(5, interfaces.PageRequest{Limit: 2})
+	require.NoError(t, err)
+
+	start, end, _, err := pageOffset(5, interfaces.PageRequest{Limit: 2, Cursor: next})
+	require.NoError(t, err)
+	require.Equal(t, 2, start)
+	require.Equal(t, 4, end)
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// @Summary Get portfolio
+// @Description Get the native and ERC-20 balances of the user's custodial and linked wallets, valued in fiat
+// @Accept  json
+// @Produce  json
+// @Tags Profile
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param currency query string false "Fiat currency for values (default: the user's preferred currency)"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.PortfolioResponse}	"success"
+// @Router /portfolio [get]
+func (server *Server) getPortfolio(ctx *gin.Context) {
+	var req interfaces.CurrencyRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chain(ctx)
+	if !ok {
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	currency := strings.ToUpper(req.Currency)
+	if currency == "" {
+		currency = user.PreferredCurrency
+	}
+
+	linked, err := server.store.ListActiveWalletAddresses(ctx, user.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	addresses := []string{user.Address}
+	types := []string{interfaces.WalletCustodial}
+	seen := map[string]bool{strings.ToLower(user.Address): true}
+	for _, address := range linked {
+		if seen[strings.ToLower(address)] {
+			continue
+		}
+		seen[strings.ToLower(address)] = true
+		addresses = append(addresses, address)
+		types = append(types, interfaces.WalletLinked)
+	}
+
+	wallets, err := chain.GetPortfolio(addresses, server.tokens)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	prices := make(map[string]*float64)
+	priceOf := func(symbol string) *float64 {
+		if value, ok := prices[symbol]; ok {
+			return value
+		}
+
+		prices[symbol] = nil
+		quote, err := server.prices.GetPrice(ctx, symbol, currency)
+		if err != nil {
+			log.Warn().Err(err).Str("pair", symbol+"/"+currency).Msg("cannot value portfolio balance")
+		} else {
+			prices[symbol] = &quote.Price
+		}
+		return prices[symbol]
+	}
+
+	rsp := interfaces.PortfolioResponse{
+		ChainID:  chain.ID,
+		Currency: currency,
+		Wallets:  make([]interfaces.WalletPortfolio, len(wallets)),
+	}
+
+	for i, wallet := range wallets {
+		portfolio := interfaces.WalletPortfolio{
+			Address:  wallet.Address,
+			Type:     types[i],
+			Balances: make([]interfaces.TokenHolding, 0, len(wallet.Tokens)+1),
+		}
+
+		holdings := append([]defi.TokenBalance{{
+			Token:  defi.TokenInfo{ChainID: chain.ID, Symbol: chain.NativeSymbol, Decimals: 18},
+			Amount: wallet.Native,
+		}}, wallet.Tokens...)
+
+		for _, holding := range holdings {
+			item := newTokenHolding(holding, priceOf(holding.Token.Symbol))
+			if item.FiatValue != nil {
+				portfolio.TotalFiat += *item.FiatValue
+			}
+			portfolio.Balances = append(portfolio.Balances, item)
+		}
+
+		portfolio.TotalFiat = utils.RoundCents(portfolio.TotalFiat)
+		rsp.TotalFiat += portfolio.TotalFiat
+		rsp.Wallets[i] = portfolio
+	}
+	rsp.TotalFiat = utils.RoundCents(rsp.TotalFiat)
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// newTokenHolding formats a balance with its token's decimals and values it at price when there is one
+func newTokenHolding(balance defi.TokenBalance, price *float64) interfaces.TokenHolding {
+	amount := balance.Amount
+	if amount == nil {
+		amount = new(big.Int)
+	}
+
+	holding := interfaces.TokenHolding{
+		Symbol:   balance.Token.Symbol,
+		Address:  balance.Token.Address,
+		Decimals: balance.Token.Decimals,
+		Logo:     balance.Token.Logo,
+		Balance:  utils.FormatUnits(amount, balance.Token.Decimals),
+	}
+
+	if price != nil {
+		units, err := strconv.ParseFloat(holding.Balance, 64)
+		if err == nil {
+			value := utils.RoundCents(units * *price)
+			holding.Price = price
+			holding.FiatValue = &value
+		}
+	}
+
+	return holding
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/demola234/defiraise/defi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTokenHolding(t *testing.T) {
+	usdc := defi.TokenInfo{ChainID: defi.SepoliaChainID, Address: "0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238", Symbol: "USDC", Decimals: 6}
+	price := 0.92
+
+	holding := newTokenHolding(defi.TokenBalance{Token: usdc, Amount: big.NewInt(1234567890)}, &price)
+	require.Equal(t, "1234.56789", holding.Balance)
+	require.Equal(t, 1135.8, *holding.FiatValue)
+
+	holding = newTokenHolding(defi.TokenBalance{Token: usdc}, nil)
+	require.Equal(t, "0", holding.Balance)
+	require.Nil(t, holding.Price)
+	require.Nil(t, holding.FiatValue)
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/indexer"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/price"
+	"github.com/gin-gonic/gin"
+)
+
+// defaultPriceWindow is the history returned when no range is given
+const defaultPriceWindow = 30 * 24 * time.Hour
+
+// @Summary Get current price
+// @Description Get the median price of a token (ETH or an ERC-20 symbol such as USDC) across the configured price sources
+// @Accept  json
+// @Produce  json
+// @Tags Prices
+// @Param token query string false "Token symbol (default: ETH)"
+// @Param currency query string false "Fiat currency, e.g. USD, EUR, NGN (default: USD)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.TokenPrice}	"success"
+// @Failure		503				{object}    interfaces.DocSuccessResponse
+// @Router /currentPrice [get]
+func (server *Server) currentPrice(ctx *gin.Context) {
+	var req interfaces.CurrentPriceRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	if req.Token == "" {
+		req.Token = defi.NativeToken
+	}
+	if req.Currency == "" {
+		req.Currency = indexer.FiatCurrency
+	}
+
+	quote, err := server.prices.GetPrice(ctx, req.Token, req.Currency)
+	if err != nil {
+		if errors.Is(err, price.ErrNoPrice) {
+			ctx.JSON(http.StatusServiceUnavailable, interfaces.ErrorResponse(err, http.StatusServiceUnavailable))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.TokenPrice{
+		Token:     quote.Base,
+		Currency:  quote.Currency,
+		Price:     quote.Price,
+		Sources:   strings.Split(quote.Source, ","),
+		UpdatedAt: quote.UpdatedAt,
+	}))
+}
+
+// @Summary Get price history
+// @Description Get recorded token/fiat price samples in a time range
+// @Accept  json
+// @Produce  json
+// @Tags Prices
+// @Param token query string false "Token symbol (default: ETH)"
+// @Param currency query string false "Fiat currency (default: USD)"
+// @Param from query string false "RFC3339 start time (default: 30 days before to)"
+// @Param to query string false "RFC3339 end time (default: now)"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.PriceSample}}	"success"
+// @Router /prices/history [get]
+func (server *Server) getPriceHistory(ctx *gin.Context) {
+	var req interfaces.PriceHistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	var cursor idCursor
+	if req.Cursor != "" {
+		if err := decodeCursor(req.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	if req.Token == "" {
+		req.Token = defi.NativeToken
+	}
+	if req.Currency == "" {
+		req.Currency = indexer.FiatCurrency
+	}
+	if req.To.IsZero() {
+		req.To = time.Now()
+	}
+	if req.From.IsZero() {
+		req.From = req.To.Add(-defaultPriceWindow)
+	}
+	if !req.From.Before(req.To) {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("from must be before to"), http.StatusBadRequest))
+		return
+	}
+
+	pageSize := req.PageSize()
+	samples, err := server.store.ListPriceHistory(ctx, db.ListPriceHistoryParams{
+		Token:    req.Token,
+		Currency: req.Currency,
+		FromTime: req.From,
+		ToTime:   req.To,
+		CursorID: sql.NullInt64{Int64: cursor.ID, Valid: req.Cursor != ""},
+		Limit:    pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	total, err := server.store.CountPriceHistory(ctx, db.CountPriceHistoryParams{
+		Token:    req.Token,
+		Currency: req.Currency,
+		FromTime: req.From,
+		ToTime:   req.To,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	page := interfaces.Page{TotalCount: total}
+
+	if len(samples) > int(pageSize) {
+		samples = samples[:pageSize]
+
+		page.NextCursor, err = encodeCursor(idCursor{ID: samples[len(samples)-1].ID})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	items := make([]interfaces.PriceSample, len(samples))
+	for i, sample := range samples {
+		items[i] = interfaces.PriceSample{
+			Token:      sample.Token,
+			Currency:   sample.Currency,
+			Price:      sample.Price,
+			Source:     sample.Source,
+			RecordedAt: sample.RecordedAt,
+		}
+	}
+	page.Items = items
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
+}
@@ -0,0 +1,283 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// defaultProposalQuorum is the percentage of a campaign's donations that must vote on a proposal
+	defaultProposalQuorum = 20
+	// defaultProposalVoting is how long a proposal stays open for votes
+	defaultProposalVoting = 72 * time.Hour
+)
+
+// @Summary Open a campaign proposal
+// @Description Put a change to a campaign to a vote of its donors: extending the deadline, releasing a submitted milestone or changing its scope. Votes are weighted by each donor's on-chain donations to the campaign. When the vote ends, settle closes it and acts on the result.
+// @Accept  json
+// @Produce  json
+// @Tags Proposals
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.CreateProposalRequest    true  "Proposal"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Proposal}	"success"
+// @Router /campaigns/{id}/proposals [post]
+func (server *Server) createCampaignProposal(ctx *gin.Context) {
+	var req interfaces.CreateProposalRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	campaign, user, ok := server.ownedCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	params := db.CreateCampaignProposalParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+		Kind:            req.Kind,
+		Title:           req.Title,
+		Description:     req.Description,
+		Quorum:          req.Quorum,
+		EndsAt:          time.Now().Add(defaultProposalVoting),
+		CreatedBy:       user.Username,
+	}
+	if params.Quorum == 0 {
+		params.Quorum = defaultProposalQuorum
+	}
+	if req.VotingHours != 0 {
+		params.EndsAt = time.Now().Add(time.Duration(req.VotingHours) * time.Hour)
+	}
+
+	switch req.Kind {
+	case interfaces.ProposalExtendDeadline:
+		if req.NewDeadline == nil {
+			err := errors.New("new_deadline is required")
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+		if err := CheckDeadlineExtension(ctx, server.store, server.config, campaign, *req.NewDeadline); err != nil {
+			respondExtensionError(ctx, err)
+			return
+		}
+		params.NewDeadline = sql.NullTime{Time: *req.NewDeadline, Valid: true}
+
+		params.EndsAt, ok = deadlineVoteEnd(params.EndsAt, campaign.Deadline)
+		if !ok {
+			err := errors.New("campaign ends too soon for a donor vote on the extension")
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+			return
+		}
+
+	case interfaces.ProposalReleaseMilestone:
+		milestone, err := server.store.GetCampaignMilestone(ctx, req.MilestoneID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+		if err != nil || milestone.ChainID != campaign.ChainID || milestone.ContractVersion != campaign.ContractVersion || milestone.CampaignID != campaign.CampaignID {
+			err := errors.New("milestone_id must be a milestone of the campaign")
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+		if milestone.Status != interfaces.MilestoneSubmitted {
+			err := errors.New("milestone must be submitted before it can be put to a vote")
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+			return
+		}
+		params.MilestoneID = sql.NullInt64{Int64: milestone.ID, Valid: true}
+	}
+
+	proposal, err := server.store.CreateCampaignProposal(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := interfaces.NewProposal(proposal, db.SumProposalVotesRow{ForWeight: "0", AgainstWeight: "0"})
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Get campaign proposals
+// @Description Get a campaign's proposals, newest first, with their vote tally
+// @Accept  json
+// @Produce  json
+// @Tags Proposals
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.Proposal}	"success"
+// @Router /campaigns/{id}/proposals [get]
+func (server *Server) listCampaignProposals(ctx *gin.Context) {
+	campaign, ok := server.pathCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	proposals, err := server.store.ListCampaignProposals(ctx, db.ListCampaignProposalsParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := make([]interfaces.Proposal, len(proposals))
+	for i, proposal := range proposals {
+		votes, err := server.store.SumProposalVotes(ctx, proposal.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+		rsp[i] = interfaces.NewProposal(proposal, votes)
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Get proposal vote data
+// @Description Get the EIP-712 typed data a donor signs with eth_signTypedData_v4 to vote on a proposal
+// @Accept  json
+// @Produce  json
+// @Tags Proposals
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param proposal_id path int true "Proposal ID"
+// @Param voter query string true "Address of the donor"
+// @Param support query bool true "Vote for the proposal"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.ProposalVoteData}	"success"
+// @Router /proposals/{proposal_id}/vote-data [get]
+func (server *Server) getProposalVoteData(ctx *gin.Context) {
+	var req interfaces.ProposalVoteDataRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	proposal, chain, ok := server.pathProposal(ctx)
+	if !ok {
+		return
+	}
+
+	typedData := chain.VoteTypedData(defi.ProposalVote{
+		ProposalID: proposal.ID,
+		CampaignID: proposal.CampaignID,
+		Support:    *req.Support,
+		Voter:      req.Voter,
+	})
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.ProposalVoteData{TypedData: typedData}))
+}
+
+// @Summary Vote on a proposal
+// @Description Cast a donor's EIP-712 signed vote on an open proposal. The vote weighs the voter's on-chain donations to the campaign; voting again replaces the earlier vote.
+// @Accept  json
+// @Produce  json
+// @Tags Proposals
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param proposal_id path int true "Proposal ID"
+// @Param   data        body   interfaces.ProposalVoteRequest    true  "Signed vote"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Proposal}	"success"
+// @Router /proposals/{proposal_id}/votes [post]
+func (server *Server) voteOnProposal(ctx *gin.Context) {
+	var req interfaces.ProposalVoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	proposal, chain, ok := server.pathProposal(ctx)
+	if !ok {
+		return
+	}
+	if proposal.Status != interfaces.ProposalOpen || !time.Now().Before(proposal.EndsAt) {
+		err := errors.New("voting on this proposal has closed")
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+		return
+	}
+
+	vote := defi.ProposalVote{
+		ProposalID: proposal.ID,
+		CampaignID: proposal.CampaignID,
+		Support:    *req.Support,
+		Voter:      req.Voter,
+	}
+	if err := chain.VerifyVote(vote, req.Signature); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	contributions, _, err := chain.DonorContributions(proposal.CampaignID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	weight := contributions[strings.ToLower(req.Voter)]
+	if weight == nil || weight.Sign() <= 0 {
+		err := errors.New("only donors to the campaign can vote")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	_, err = server.store.UpsertProposalVote(ctx, db.UpsertProposalVoteParams{
+		ProposalID:   proposal.ID,
+		VoterAddress: strings.ToLower(req.Voter),
+		Support:      *req.Support,
+		Weight:       weight.String(),
+		Signature:    req.Signature,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	votes, err := server.store.SumProposalVotes(ctx, proposal.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewProposal(proposal, votes)))
+}
+
+// pathProposal loads the :proposal_id proposal and the contract version its campaign lives on
+func (server *Server) pathProposal(ctx *gin.Context) (db.CampaignProposals, *defi.Chain, bool) {
+	id, err := strconv.ParseInt(ctx.Param("proposal_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid proposal ID"), http.StatusBadRequest))
+		return db.CampaignProposals{}, nil, false
+	}
+
+	proposal, err := server.store.GetCampaignProposal(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("proposal not found"), http.StatusNotFound))
+			return db.CampaignProposals{}, nil, false
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.CampaignProposals{}, nil, false
+	}
+
+	chain, ok := server.chainByID(ctx, proposal.ChainID, int(proposal.ContractVersion))
+	if !ok {
+		return db.CampaignProposals{}, nil, false
+	}
+
+	return proposal, chain, true
+}
@@ -0,0 +1,275 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCampaignProposal(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001"}
+	deadline := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	campaign := db.IndexedCampaigns{
+		ChainID:         defi.SepoliaChainID,
+		ContractVersion: 1,
+		CampaignID:      7,
+		Owner:           user.Address,
+		Goal:            "2000000000000000000",
+		TotalFunds:      "1000000000000000000",
+		Deadline:        deadline,
+	}
+	milestone := db.CampaignMilestones{ID: 3, ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7, Status: interfaces.MilestoneSubmitted}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "ExtendDeadline",
+			body: gin.H{"kind": "extend_deadline", "title": "More time", "description": "Shipping slipped", "new_deadline": deadline.Add(72 * time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignDeadlineChanges(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().
+					CreateCampaignProposal(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateCampaignProposalParams) (db.CampaignProposals, error) {
+						require.Equal(t, interfaces.ProposalExtendDeadline, arg.Kind)
+						require.Equal(t, int32(defaultProposalQuorum), arg.Quorum)
+						require.True(t, arg.NewDeadline.Valid)
+						require.True(t, arg.NewDeadline.Time.Equal(deadline.Add(72*time.Hour)))
+						require.False(t, arg.MilestoneID.Valid)
+						require.Equal(t, user.Username, arg.CreatedBy)
+						// the vote ends before the deadline it extends
+						require.True(t, arg.EndsAt.Equal(deadline.Add(-deadlineVoteMargin)))
+						return db.CampaignProposals{ID: 1, Kind: arg.Kind, Quorum: arg.Quorum, Status: interfaces.ProposalOpen, ForWeight: "0", AgainstWeight: "0", TotalWeight: "0"}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"status":"open"`)
+			},
+		},
+		{
+			name: "EarlierDeadline",
+			body: gin.H{"kind": "extend_deadline", "title": "Less time", "description": "Oops", "new_deadline": deadline.Add(-time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().CreateCampaignProposal(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TooFar",
+			body: gin.H{"kind": "extend_deadline", "title": "Much more time", "description": "Shipping slipped", "new_deadline": deadline.Add(defaultMaxDeadlineExtension + time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignDeadlineChanges(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().CreateCampaignProposal(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Funded",
+			body: gin.H{"kind": "extend_deadline", "title": "More time", "description": "Raise more", "new_deadline": deadline.Add(72 * time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				funded := campaign
+				funded.TotalFunds = funded.Goal
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(funded, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().ListCampaignDeadlineChanges(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+				store.EXPECT().CreateCampaignProposal(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "ReleaseMilestone",
+			body: gin.H{"kind": "release_milestone", "title": "Release", "description": "Prototype shipped", "milestone_id": milestone.ID, "quorum": 50, "voting_hours": 24},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Eq(milestone.ID)).Times(1).Return(milestone, nil)
+				store.EXPECT().
+					CreateCampaignProposal(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateCampaignProposalParams) (db.CampaignProposals, error) {
+						require.Equal(t, sql.NullInt64{Int64: milestone.ID, Valid: true}, arg.MilestoneID)
+						require.Equal(t, int32(50), arg.Quorum)
+						require.WithinDuration(t, time.Now().Add(24*time.Hour), arg.EndsAt, time.Minute)
+						return db.CampaignProposals{ID: 2, Kind: arg.Kind, MilestoneID: arg.MilestoneID, ForWeight: "0", AgainstWeight: "0", TotalWeight: "0"}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"milestone_id":3`)
+			},
+		},
+		{
+			name: "MilestoneNotSubmitted",
+			body: gin.H{"kind": "release_milestone", "title": "Release", "description": "Prototype shipped", "milestone_id": milestone.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				pending := milestone
+				pending.Status = interfaces.MilestonePending
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetCampaignMilestone(gomock.Any(), gomock.Any()).Times(1).Return(pending, nil)
+				store.EXPECT().CreateCampaignProposal(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotOwner",
+			body: gin.H{"kind": "change_scope", "title": "Pivot", "description": "New plan"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.Users{Username: user.Username, Address: "0x01"}, nil)
+				store.EXPECT().CreateCampaignProposal(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidKind",
+			body: gin.H{"kind": "dissolve", "title": "Stop", "description": "Stop it"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateCampaignProposal(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/campaigns/7/proposals", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestVoteOnProposal(t *testing.T) {
+	username := utils.RandomString(6)
+	open := db.CampaignProposals{ID: 4, ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7, Status: interfaces.ProposalOpen, EndsAt: time.Now().Add(time.Hour)}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "InvalidSignature",
+			body: gin.H{"voter": "0x1000000000000000000000000000000000000001", "support": true, "signature": "0x" + utils.RandomString(130)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCampaignProposal(gomock.Any(), gomock.Eq(open.ID)).Times(1).Return(open, nil)
+				store.EXPECT().UpsertProposalVote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Ended",
+			body: gin.H{"voter": "0x1000000000000000000000000000000000000001", "support": true, "signature": "0x00"},
+			buildStubs: func(store *mockdb.MockStore) {
+				ended := open
+				ended.EndsAt = time.Now().Add(-time.Minute)
+
+				store.EXPECT().GetCampaignProposal(gomock.Any(), gomock.Any()).Times(1).Return(ended, nil)
+				store.EXPECT().UpsertProposalVote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"voter": "0x1000000000000000000000000000000000000001", "support": false, "signature": "0x00"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCampaignProposal(gomock.Any(), gomock.Any()).Times(1).Return(db.CampaignProposals{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidVoter",
+			body: gin.H{"voter": "alice", "support": true, "signature": "0x00"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCampaignProposal(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/proposals/4/votes", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/receipt"
+	"github.com/demola234/defiraise/token"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// firstStatementYear is the earliest year a statement can be requested for
+const firstStatementYear = 2015
+
+// @Summary Download donation receipt
+// @Description Download a receipt for one of the user's confirmed donations as PDF or CSV
+// @Produce  application/pdf
+// @Produce  text/csv
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Donation ID"
+// @Param format query string false "pdf or csv (default: pdf)"
+// @Success		200				{file}    file	"receipt"
+// @Router /donations/{id}/receipt [get]
+func (server *Server) getDonationReceipt(ctx *gin.Context) {
+	var req interfaces.DocumentRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	_, donation, ok := server.loadDonationReceipt(ctx)
+	if !ok {
+		return
+	}
+
+	format := documentFormat(req.Format)
+	data, err := receipt.Render(donation, format)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	sendDocument(ctx, fmt.Sprintf("defiraise-receipt-%d.%s", donation.ID, format), format, data)
+}
+
+// @Summary Email donation receipt
+// @Description Email a receipt for one of the user's confirmed donations as a PDF or CSV attachment
+// @Accept  json
+// @Produce  json
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Donation ID"
+// @Param   data        body   interfaces.DocumentRequest    false  "Format"
+// @Success		200				{object}    interfaces.DocSuccessResponse	"success"
+// @Router /donations/{id}/receipt/email [post]
+func (server *Server) emailDonationReceipt(ctx *gin.Context) {
+	var req interfaces.DocumentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	user, donation, ok := server.loadDonationReceipt(ctx)
+	if !ok {
+		return
+	}
+
+	format := documentFormat(req.Format)
+	data, err := receipt.Render(donation, format)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	email := utils.EmailInfo{
+		Name:     user.Username,
+		Details:  fmt.Sprintf("Here is the receipt for your donation to %s.", donation.CampaignTitle),
+		Subject:  fmt.Sprintf("Your DefiFundr donation receipt #%d", donation.ID),
+		Template: "receipt.html",
+		Attachments: []utils.EmailAttachment{
+			{Filename: fmt.Sprintf("defiraise-receipt-%d.%s", donation.ID, format), Data: data},
+		},
+	}
+	_, err = utils.SendEmail(user.Email, user.Username, email, "./utils")
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, "receipt sent"))
+}
+
+// @Summary Download yearly giving statement
+// @Description Download all confirmed donations made by the user in a calendar year as PDF or CSV
+// @Produce  application/pdf
+// @Produce  text/csv
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param year path int true "Year"
+// @Param format query string false "pdf or csv (default: pdf)"
+// @Success		200				{file}    file	"statement"
+// @Router /donations/statements/{year} [get]
+func (server *Server) getDonationStatement(ctx *gin.Context) {
+	var req interfaces.DocumentRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	_, statement, ok := server.loadDonationStatement(ctx)
+	if !ok {
+		return
+	}
+
+	format := documentFormat(req.Format)
+	data, err := receipt.RenderStatement(statement, format)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	sendDocument(ctx, fmt.Sprintf("defiraise-statement-%d.%s", statement.Year, format), format, data)
+}
+
+// @Summary Email yearly giving statement
+// @Description Email the user's giving statement for a calendar year as a PDF or CSV attachment
+// @Accept  json
+// @Produce  json
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param year path int true "Year"
+// @Param   data        body   interfaces.DocumentRequest    false  "Format"
+// @Success		200				{object}    interfaces.DocSuccessResponse	"success"
+// @Router /donations/statements/{year}/email [post]
+func (server *Server) emailDonationStatement(ctx *gin.Context) {
+	var req interfaces.DocumentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	user, statement, ok := server.loadDonationStatement(ctx)
+	if !ok {
+		return
+	}
+
+	format := documentFormat(req.Format)
+	data, err := receipt.RenderStatement(statement, format)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	email := utils.EmailInfo{
+		Name:     user.Username,
+		Details:  fmt.Sprintf("Here is your giving statement for %d.", statement.Year),
+		Subject:  fmt.Sprintf("Your DefiFundr giving statement for %d", statement.Year),
+		Template: "receipt.html",
+		Attachments: []utils.EmailAttachment{
+			{Filename: fmt.Sprintf("defiraise-statement-%d.%s", statement.Year, format), Data: data},
+		},
+	}
+	_, err = utils.SendEmail(user.Email, user.Username, email, "./utils")
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, "statement sent"))
+}
+
+// currentUser loads the authenticated user, writing the error response when it cannot
+func (server *Server) currentUser(ctx *gin.Context) (db.Users, bool) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload == nil {
+		err := errors.New(interfaces.ErrUserNotFound)
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+		return db.Users{}, false
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(err, http.StatusNotFound))
+			return db.Users{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.Users{}, false
+	}
+
+	return user, true
+}
+
+func (server *Server) loadDonationReceipt(ctx *gin.Context) (db.Users, receipt.Donation, bool) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid donation ID"), http.StatusBadRequest))
+		return db.Users{}, receipt.Donation{}, false
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return db.Users{}, receipt.Donation{}, false
+	}
+
+	donors, err := server.donorAddresses(ctx, user)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.Users{}, receipt.Donation{}, false
+	}
+
+	row, err := server.store.GetDonationReceipt(ctx, db.GetDonationReceiptParams{
+		ID:     id,
+		Donors: donors,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("donation not found"), http.StatusNotFound))
+			return db.Users{}, receipt.Donation{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.Users{}, receipt.Donation{}, false
+	}
+
+	return user, newReceiptDonation(row), true
+}
+
+func (server *Server) loadDonationStatement(ctx *gin.Context) (db.Users, receipt.Statement, bool) {
+	year, err := strconv.Atoi(ctx.Param("year"))
+	if err != nil || year < firstStatementYear || year > time.Now().Year() {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid year"), http.StatusBadRequest))
+		return db.Users{}, receipt.Statement{}, false
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return db.Users{}, receipt.Statement{}, false
+	}
+
+	donors, err := server.donorAddresses(ctx, user)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.Users{}, receipt.Statement{}, false
+	}
+
+	rows, err := server.store.ListDonationReceiptsInRange(ctx, db.ListDonationReceiptsInRangeParams{
+		Donors:   donors,
+		FromTime: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ToTime:   time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.Users{}, receipt.Statement{}, false
+	}
+
+	statement := receipt.Statement{
+		Username:  user.Username,
+		Year:      year,
+		Donations: make([]receipt.Donation, len(rows)),
+	}
+	for i, row := range rows {
+		statement.Donations[i] = newReceiptDonation(db.GetDonationReceiptRow(row))
+	}
+
+	return user, statement, true
+}
+
+func newReceiptDonation(row db.GetDonationReceiptRow) receipt.Donation {
+	return receipt.Donation{
+		ID:            row.ID,
+		CampaignID:    row.CampaignID,
+		CampaignTitle: row.CampaignTitle,
+		CampaignOwner: row.CampaignOwner,
+		Donor:         row.DonorAddress,
+		Amount:        utils.WeiToEther(row.Amount),
+		Token:         row.Token,
+		TxHash:        row.TxHash.String,
+		BlockNumber:   row.BlockNumber.Int64,
+		FiatAmount:    row.FiatAmount.String,
+		FiatCurrency:  row.FiatCurrency,
+		DonatedAt:     row.DonatedAt,
+	}
+}
+
+func documentFormat(format string) string {
+	if format == "" {
+		return receipt.FormatPDF
+	}
+	return format
+}
+
+func sendDocument(ctx *gin.Context, filename string, format string, data []byte) {
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Data(http.StatusOK, receipt.ContentType(format), data)
+}
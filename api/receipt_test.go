@@ -0,0 +1,87 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDonationReceipt(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xAbC0000000000000000000000000000000000003"}
+	params := db.GetDonationReceiptParams{ID: 9, Donors: []string{"0xabc0000000000000000000000000000000000003"}}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					GetDonationReceipt(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.GetDonationReceiptRow{
+						ID:            9,
+						CampaignID:    7,
+						CampaignTitle: "Clean water",
+						DonorAddress:  "0xabc0000000000000000000000000000000000003",
+						Amount:        "1000000000000000000",
+						Token:         "ETH",
+						TxHash:        sql.NullString{String: "0x1234", Valid: true},
+						BlockNumber:   sql.NullInt64{Int64: 42, Valid: true},
+						Status:        "confirmed",
+						DonatedAt:     time.Now(),
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "0x1234")
+			},
+		},
+		{
+			// a donation recovered from the chain's donor list has no transaction or block to prove it,
+			// so the query leaves it out
+			name: "RecoveredRecord",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().
+					GetDonationReceipt(gomock.Any(), gomock.Eq(params)).
+					Times(1).
+					Return(db.GetDonationReceiptRow{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v1/donations/9/receipt?format=csv", nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
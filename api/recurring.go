@@ -0,0 +1,222 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Schedule a recurring donation
+// @Description Donate a fixed amount to a campaign every week or month from the user's custodial wallet, starting at start_at (default: now), until the campaign ends, ends_at passes or max_donations have been made. Each donation is sent by the recurring donation worker, which emails the user how it went.
+// @Accept  json
+// @Produce  json
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param   data        body   interfaces.CreateRecurringDonationRequest    true  "Recurring donation"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.RecurringDonation}	"success"
+// @Router /recurring-donations [post]
+func (server *Server) createRecurringDonation(ctx *gin.Context) {
+	var req interfaces.CreateRecurringDonationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
+		return
+	}
+
+	// the contract only takes donations in the chain's native currency
+	if req.Token == "" {
+		req.Token = chain.NativeSymbol
+	}
+	if !strings.EqualFold(req.Token, chain.NativeSymbol) {
+		err := fmt.Errorf("campaigns on this chain only accept %s", chain.NativeSymbol)
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	campaign, err := server.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      req.CampaignID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("campaign not found"), http.StatusNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	startAt := time.Now()
+	if req.StartAt != nil && req.StartAt.After(startAt) {
+		startAt = *req.StartAt
+	}
+	if !startAt.Before(campaign.Deadline) {
+		err := errors.New("campaign ends before the first donation")
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	params := db.CreateRecurringDonationParams{
+		Username:        user.Username,
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      req.CampaignID,
+		Amount:          utils.EtherToWei(req.Amount),
+		Token:           chain.NativeSymbol,
+		Interval:        req.Interval,
+		NextRunAt:       startAt,
+	}
+	if req.EndsAt != nil {
+		if req.EndsAt.Before(startAt) {
+			err := errors.New("ends_at must be after the first donation")
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+		params.EndsAt = sql.NullTime{Time: *req.EndsAt, Valid: true}
+	}
+	if req.MaxDonations > 0 {
+		params.MaxDonations = sql.NullInt32{Int32: req.MaxDonations, Valid: true}
+	}
+
+	donation, err := server.store.CreateRecurringDonation(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewRecurringDonation(donation)))
+}
+
+// @Summary Get my recurring donations
+// @Description Get the user's recurring donations, newest first
+// @Accept  json
+// @Produce  json
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.RecurringDonation}	"success"
+// @Router /recurring-donations [get]
+func (server *Server) listRecurringDonations(ctx *gin.Context) {
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	donations, err := server.store.ListRecurringDonationsByUser(ctx, user.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := make([]interfaces.RecurringDonation, len(donations))
+	for i, donation := range donations {
+		rsp[i] = interfaces.NewRecurringDonation(donation)
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Pause a recurring donation
+// @Description Stop an active recurring donation from sending donations until it is resumed
+// @Accept  json
+// @Produce  json
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Recurring donation ID"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.RecurringDonation}	"success"
+// @Router /recurring-donations/{id}/pause [post]
+func (server *Server) pauseRecurringDonation(ctx *gin.Context) {
+	server.updateRecurringDonationStatus(ctx, interfaces.RecurringPaused, interfaces.RecurringActive)
+}
+
+// @Summary Resume a recurring donation
+// @Description Resume a paused recurring donation. Installments missed while it was paused are not made up; the next one is due at its scheduled time, or now if that has passed.
+// @Accept  json
+// @Produce  json
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Recurring donation ID"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.RecurringDonation}	"success"
+// @Router /recurring-donations/{id}/resume [post]
+func (server *Server) resumeRecurringDonation(ctx *gin.Context) {
+	server.updateRecurringDonationStatus(ctx, interfaces.RecurringActive, interfaces.RecurringPaused)
+}
+
+// @Summary Cancel a recurring donation
+// @Description Cancel an active or paused recurring donation for good
+// @Accept  json
+// @Produce  json
+// @Tags Donations
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Recurring donation ID"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.RecurringDonation}	"success"
+// @Router /recurring-donations/{id}/cancel [post]
+func (server *Server) cancelRecurringDonation(ctx *gin.Context) {
+	server.updateRecurringDonationStatus(ctx, interfaces.RecurringCancelled, interfaces.RecurringActive, interfaces.RecurringPaused)
+}
+
+// updateRecurringDonationStatus moves the user's :id recurring donation to status, writing a 409
+// response when it is not in one of the from statuses
+func (server *Server) updateRecurringDonationStatus(ctx *gin.Context, status string, from ...string) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid recurring donation ID"), http.StatusBadRequest))
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	donation, err := server.store.GetRecurringDonation(ctx, id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	// another user's recurring donation is reported as missing
+	if err != nil || donation.Username != user.Username {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("recurring donation not found"), http.StatusNotFound))
+		return
+	}
+
+	params := db.UpdateRecurringDonationStatusParams{
+		Status:       status,
+		ID:           donation.ID,
+		FromStatuses: from,
+	}
+	if status == interfaces.RecurringActive && donation.NextRunAt.Before(time.Now()) {
+		params.NextRunAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	donation, err = server.store.UpdateRecurringDonationStatus(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err := fmt.Errorf("recurring donation is not %s", strings.Join(from, " or "))
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewRecurringDonation(donation)))
+}
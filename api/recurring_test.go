@@ -0,0 +1,234 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRecurringDonation(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	campaign := db.IndexedCampaigns{ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7, Deadline: time.Now().Add(60 * 24 * time.Hour)}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"campaign_id": 7, "amount": 0.05, "interval": "weekly", "max_donations": 4},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Eq(db.GetIndexedCampaignParams{ChainID: defi.SepoliaChainID, ContractVersion: 1, CampaignID: 7})).Times(1).Return(campaign, nil)
+				store.EXPECT().
+					CreateRecurringDonation(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateRecurringDonationParams) (db.RecurringDonations, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, utils.EtherToWei(0.05), arg.Amount)
+						require.Equal(t, defi.NativeToken, arg.Token)
+						require.Equal(t, interfaces.RecurringWeekly, arg.Interval)
+						require.Equal(t, sql.NullInt32{Int32: 4, Valid: true}, arg.MaxDonations)
+						require.False(t, arg.EndsAt.Valid)
+						require.WithinDuration(t, time.Now(), arg.NextRunAt, time.Minute)
+						return db.RecurringDonations{ID: 1, Amount: arg.Amount, Token: arg.Token, Interval: arg.Interval, Status: interfaces.RecurringActive, NextRunAt: arg.NextRunAt}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"amount":0.05`)
+				require.Contains(t, recorder.Body.String(), `"status":"active"`)
+			},
+		},
+		{
+			name: "UnsupportedToken",
+			body: gin.H{"campaign_id": 7, "amount": 10, "token": "USDC", "interval": "monthly"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRecurringDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidInterval",
+			body: gin.H{"campaign_id": 7, "amount": 0.05, "interval": "daily"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRecurringDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "CampaignNotFound",
+			body: gin.H{"campaign_id": 8, "amount": 0.05, "interval": "weekly"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(db.IndexedCampaigns{}, sql.ErrNoRows)
+				store.EXPECT().CreateRecurringDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "StartsAfterCampaign",
+			body: gin.H{"campaign_id": 7, "amount": 0.05, "interval": "weekly", "start_at": campaign.Deadline.Add(time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().CreateRecurringDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/recurring-donations", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateRecurringDonationStatus(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	donation := db.RecurringDonations{ID: 3, Username: user.Username, Amount: "1000", Status: interfaces.RecurringActive, NextRunAt: time.Now().Add(time.Hour)}
+
+	testCases := []struct {
+		name          string
+		action        string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "Pause",
+			action: "pause",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetRecurringDonation(gomock.Any(), gomock.Eq(donation.ID)).Times(1).Return(donation, nil)
+				store.EXPECT().
+					UpdateRecurringDonationStatus(gomock.Any(), gomock.Eq(db.UpdateRecurringDonationStatusParams{
+						Status:       interfaces.RecurringPaused,
+						ID:           donation.ID,
+						FromStatuses: []string{interfaces.RecurringActive},
+					})).
+					Times(1).
+					Return(db.RecurringDonations{ID: donation.ID, Status: interfaces.RecurringPaused}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"status":"paused"`)
+				require.Contains(t, recorder.Body.String(), `"next_run_at":null`)
+			},
+		},
+		{
+			name:   "ResumeOverdue",
+			action: "resume",
+			buildStubs: func(store *mockdb.MockStore) {
+				paused := donation
+				paused.Status = interfaces.RecurringPaused
+				paused.NextRunAt = time.Now().Add(-48 * time.Hour)
+
+				store.EXPECT().GetRecurringDonation(gomock.Any(), gomock.Any()).Times(1).Return(paused, nil)
+				store.EXPECT().
+					UpdateRecurringDonationStatus(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.UpdateRecurringDonationStatusParams) (db.RecurringDonations, error) {
+						require.Equal(t, interfaces.RecurringActive, arg.Status)
+						require.Equal(t, []string{interfaces.RecurringPaused}, arg.FromStatuses)
+						require.True(t, arg.NextRunAt.Valid)
+						require.WithinDuration(t, time.Now(), arg.NextRunAt.Time, time.Minute)
+						return db.RecurringDonations{ID: paused.ID, Status: arg.Status, NextRunAt: arg.NextRunAt.Time}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "CancelFinished",
+			action: "cancel",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetRecurringDonation(gomock.Any(), gomock.Any()).Times(1).Return(donation, nil)
+				store.EXPECT().
+					UpdateRecurringDonationStatus(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RecurringDonations{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:   "NotOwner",
+			action: "cancel",
+			buildStubs: func(store *mockdb.MockStore) {
+				other := donation
+				other.Username = "someone-else"
+
+				store.EXPECT().GetRecurringDonation(gomock.Any(), gomock.Any()).Times(1).Return(other, nil)
+				store.EXPECT().UpdateRecurringDonationStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).AnyTimes().Return(user, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/api/v1/recurring-donations/%d/%s", donation.ID, tc.action)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
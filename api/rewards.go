@@ -0,0 +1,398 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+var errRewardTierSoldOut = errors.New("reward tier is sold out")
+
+// @Summary Add a reward tier
+// @Description Add a reward tier to a campaign. Donors pick a tier when donating at least its min_amount, and a tier with a quantity can only be claimed that many times. Tiers can be added until the campaign's deadline.
+// @Accept  json
+// @Produce  json
+// @Tags Rewards
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param   data        body   interfaces.CreateRewardTierRequest    true  "Reward tier, min_amount in the chain's native currency"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.RewardTier}	"success"
+// @Router /campaigns/{id}/rewards [post]
+func (server *Server) createRewardTier(ctx *gin.Context) {
+	var req interfaces.CreateRewardTierRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	campaign, _, ok := server.activeOwnedCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	params := db.CreateRewardTierParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+		Title:           req.Title,
+		Description:     req.Description,
+		MinAmount:       utils.EtherToWei(req.MinAmount),
+	}
+	if req.Quantity > 0 {
+		params.Quantity = sql.NullInt32{Int32: req.Quantity, Valid: true}
+	}
+
+	tier, err := server.store.CreateRewardTier(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewRewardTier(tier)))
+}
+
+// @Summary Get campaign reward tiers
+// @Description Get a campaign's reward tiers from the lowest minimum amount, with how many of each are left
+// @Accept  json
+// @Produce  json
+// @Tags Rewards
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=[]interfaces.RewardTier}	"success"
+// @Router /campaigns/{id}/rewards [get]
+func (server *Server) listRewardTiers(ctx *gin.Context) {
+	campaign, ok := server.pathCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	tiers, err := server.store.ListRewardTiers(ctx, db.ListRewardTiersParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := make([]interfaces.RewardTier, len(tiers))
+	for i, tier := range tiers {
+		rsp[i] = interfaces.NewRewardTier(tier)
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Get campaign reward claims
+// @Description Get the reward claims of a campaign's donors, newest first, for the owner to fulfil
+// @Accept  json
+// @Produce  json
+// @Tags Rewards
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Campaign ID"
+// @Param chain_id query int false "Chain ID (default: the default chain)"
+// @Param contract_version query int false "Contract version (default: the chain's current version)"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.RewardClaim}}	"success"
+// @Router /campaigns/{id}/rewards/claims [get]
+func (server *Server) listCampaignRewardClaims(ctx *gin.Context) {
+	var pageReq interfaces.PageRequest
+	if err := ctx.ShouldBindQuery(&pageReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	var cursor idCursor
+	if pageReq.Cursor != "" {
+		if err := decodeCursor(pageReq.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	campaign, _, ok := server.ownedCampaign(ctx)
+	if !ok {
+		return
+	}
+
+	pageSize := pageReq.PageSize()
+	claims, err := server.store.ListCampaignRewardClaims(ctx, db.ListCampaignRewardClaimsParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+		CursorID:        sql.NullInt64{Int64: cursor.ID, Valid: pageReq.Cursor != ""},
+		Limit:           pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	total, err := server.store.CountCampaignRewardClaims(ctx, db.CountCampaignRewardClaimsParams{
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	page, err := pageRewardClaims(claims, pageSize, total)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
+}
+
+// @Summary Get my reward claims
+// @Description Get the rewards the current user has claimed with their donations, newest first, with their fulfilment status
+// @Accept  json
+// @Produce  json
+// @Tags Rewards
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.RewardClaim}}	"success"
+// @Router /reward-claims [get]
+func (server *Server) listMyRewardClaims(ctx *gin.Context) {
+	var pageReq interfaces.PageRequest
+	if err := ctx.ShouldBindQuery(&pageReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	var cursor idCursor
+	if pageReq.Cursor != "" {
+		if err := decodeCursor(pageReq.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	pageSize := pageReq.PageSize()
+	claims, err := server.store.ListUserRewardClaims(ctx, db.ListUserRewardClaimsParams{
+		Username: user.Username,
+		CursorID: sql.NullInt64{Int64: cursor.ID, Valid: pageReq.Cursor != ""},
+		Limit:    pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	total, err := server.store.CountUserRewardClaims(ctx, user.Username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	page, err := pageRewardClaims(claims, pageSize, total)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
+}
+
+// @Summary Update a reward claim
+// @Description Set the fulfilment status of a reward claim on one of the current user's campaigns, with an optional note for the donor such as a tracking number. Cancelling a claim is final and gives its slot back to the tier.
+// @Accept  json
+// @Produce  json
+// @Tags Rewards
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Reward claim ID"
+// @Param   data        body   interfaces.UpdateRewardClaimRequest    true  "Fulfilment status"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.RewardClaim}	"success"
+// @Router /reward-claims/{id} [patch]
+func (server *Server) updateRewardClaim(ctx *gin.Context) {
+	var req interfaces.UpdateRewardClaimRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid reward claim ID"), http.StatusBadRequest))
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+
+	claim, err := server.store.GetRewardClaim(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("reward claim not found"), http.StatusNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	campaign, err := server.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         claim.ChainID,
+		ContractVersion: claim.ContractVersion,
+		CampaignID:      claim.CampaignID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+	if !strings.EqualFold(campaign.Owner, user.Address) {
+		err := errors.New("only the campaign owner can do this")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	// the update skips cancelled claims, so only the request that cancels a claim releases its slot
+	claim, err = server.store.UpdateRewardClaimStatus(ctx, db.UpdateRewardClaimStatusParams{
+		ID:     claim.ID,
+		Status: req.Status,
+		Note:   req.Note,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err := errors.New("reward claim is cancelled")
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(err, http.StatusConflict))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	if claim.Status == interfaces.RewardClaimCancelled {
+		server.releaseRewardTier(ctx, claim.TierID)
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewRewardClaim(claim)))
+}
+
+// pageRewardClaims cuts a list fetched with one extra claim down to a page, with the cursor of the
+// next page when there is one
+func pageRewardClaims(claims []db.RewardClaims, pageSize int32, total int64) (interfaces.Page, error) {
+	page := interfaces.Page{TotalCount: total}
+	if len(claims) > int(pageSize) {
+		claims = claims[:pageSize]
+
+		cursor, err := encodeCursor(idCursor{ID: claims[len(claims)-1].ID})
+		if err != nil {
+			return interfaces.Page{}, err
+		}
+		page.NextCursor = cursor
+	}
+
+	items := make([]interfaces.RewardClaim, len(claims))
+	for i, claim := range claims {
+		items[i] = interfaces.NewRewardClaim(claim)
+	}
+	page.Items = items
+
+	return page, nil
+}
+
+// donationRewardTier loads the reward tier picked for a donation, writing the error response unless it
+// belongs to the campaign and amount, in wei, reaches its minimum. Whether the tier has claims left is
+// only settled when it is reserved.
+func (server *Server) donationRewardTier(ctx *gin.Context, chain *defi.Chain, campaignID int64, tierID int64, amount *big.Int) (db.RewardTiers, bool) {
+	tier, err := server.store.GetRewardTier(ctx, tierID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.RewardTiers{}, false
+	}
+	if err != nil || tier.ChainID != chain.ID || tier.ContractVersion != int32(chain.Version) || tier.CampaignID != campaignID {
+		ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("reward tier not found"), http.StatusNotFound))
+		return db.RewardTiers{}, false
+	}
+
+	minimum, ok := new(big.Int).SetString(tier.MinAmount, 10)
+	if !ok || amount.Cmp(minimum) < 0 {
+		err := errors.New("amount is below the reward tier's minimum")
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return db.RewardTiers{}, false
+	}
+	if tier.Quantity.Valid && tier.Claimed >= tier.Quantity.Int32 {
+		ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(errRewardTierSoldOut, http.StatusConflict))
+		return db.RewardTiers{}, false
+	}
+
+	return tier, true
+}
+
+// reserveRewardTier takes one of the tier's claims ahead of the donation, writing a 409 response when
+// another donor took the last one first
+func (server *Server) reserveRewardTier(ctx *gin.Context, tierID int64) bool {
+	_, err := server.store.ReserveRewardTier(ctx, tierID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(errRewardTierSoldOut, http.StatusConflict))
+			return false
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return false
+	}
+
+	return true
+}
+
+// releaseRewardTier gives a reserved claim back to its tier. A failure only leaves the tier showing one
+// claim too many, so it is logged rather than returned.
+func (server *Server) releaseRewardTier(ctx *gin.Context, tierID int64) {
+	if _, err := server.store.ReleaseRewardTier(ctx, tierID); err != nil {
+		log.Error().Err(err).Int64("tier_id", tierID).Msg("cannot release reward tier")
+	}
+}
+
+// claimReward records a donor's claim on the tier reserved for a donation that was sent. The claim is
+// linked to the donation's record so the indexer cancels it if the transaction fails; without a record
+// nothing could cancel it, so the reserved slot is given back instead of making an unlinked claim.
+func (server *Server) claimReward(ctx *gin.Context, tier db.RewardTiers, user db.Users, record db.DonationRecords, recordErr error) {
+	if recordErr != nil {
+		log.Error().Err(recordErr).Int64("tier_id", tier.ID).Str("username", user.Username).Msg("cannot claim reward of unrecorded donation")
+		server.releaseRewardTier(ctx, tier.ID)
+		return
+	}
+
+	_, err := server.store.CreateRewardClaim(ctx, db.CreateRewardClaimParams{
+		TierID:           tier.ID,
+		ChainID:          record.ChainID,
+		ContractVersion:  record.ContractVersion,
+		CampaignID:       record.CampaignID,
+		Username:         user.Username,
+		DonorAddress:     record.DonorAddress,
+		Amount:           record.Amount,
+		TxHash:           record.TxHash.String,
+		DonationRecordID: sql.NullInt64{Int64: record.ID, Valid: true},
+	})
+	if err != nil {
+		log.Error().Err(err).Str("tx_hash", record.TxHash.String).Int64("tier_id", tier.ID).Msg("cannot record reward claim")
+		server.releaseRewardTier(ctx, tier.ID)
+	}
+}
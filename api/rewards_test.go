@@ -0,0 +1,390 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRewardTier(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001"}
+	campaign := db.IndexedCampaigns{
+		ChainID:         defi.SepoliaChainID,
+		ContractVersion: 1,
+		CampaignID:      7,
+		Owner:           user.Address,
+		Deadline:        time.Now().Add(time.Hour),
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"title": "Poster", "description": "A signed poster", "min_amount": 0.5, "quantity": 10},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateRewardTier(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateRewardTierParams) (db.RewardTiers, error) {
+						require.Equal(t, campaign.CampaignID, arg.CampaignID)
+						require.Equal(t, "500000000000000000", arg.MinAmount)
+						require.Equal(t, sql.NullInt32{Int32: 10, Valid: true}, arg.Quantity)
+						return db.RewardTiers{ID: 1, CampaignID: arg.CampaignID, Title: arg.Title, MinAmount: arg.MinAmount, Quantity: arg.Quantity, Claimed: 3}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"remaining":7`)
+			},
+		},
+		{
+			name: "Unlimited",
+			body: gin.H{"title": "Thanks", "description": "A thank-you note", "min_amount": 0.01},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().
+					CreateRewardTier(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateRewardTierParams) (db.RewardTiers, error) {
+						require.False(t, arg.Quantity.Valid)
+						return db.RewardTiers{ID: 2, MinAmount: arg.MinAmount}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"remaining":null`)
+			},
+		},
+		{
+			name: "Ended",
+			body: gin.H{"title": "Poster", "description": "A signed poster", "min_amount": 0.5},
+			buildStubs: func(store *mockdb.MockStore) {
+				ended := campaign
+				ended.Deadline = time.Now().Add(-time.Hour)
+
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(ended, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().CreateRewardTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotOwner",
+			body: gin.H{"title": "Poster", "description": "A signed poster", "min_amount": 0.5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.Users{Username: user.Username, Address: "0x01"}, nil)
+				store.EXPECT().CreateRewardTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "ZeroQuantity",
+			body: gin.H{"title": "Poster", "description": "A signed poster", "min_amount": 0.5, "quantity": -1},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/campaigns/7/rewards", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateRewardClaim(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001"}
+	campaign := db.IndexedCampaigns{
+		ChainID:         defi.SepoliaChainID,
+		ContractVersion: 1,
+		CampaignID:      7,
+		Owner:           user.Address,
+	}
+	claim := db.RewardClaims{
+		ID:              3,
+		TierID:          2,
+		ChainID:         campaign.ChainID,
+		ContractVersion: campaign.ContractVersion,
+		CampaignID:      campaign.CampaignID,
+		Amount:          "500000000000000000",
+		Status:          interfaces.RewardClaimPending,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Shipped",
+			body: gin.H{"status": interfaces.RewardClaimShipped, "note": "tracking 1234"},
+			buildStubs: func(store *mockdb.MockStore) {
+				shipped := claim
+				shipped.Status = interfaces.RewardClaimShipped
+				shipped.Note = "tracking 1234"
+
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().GetRewardClaim(gomock.Any(), gomock.Eq(claim.ID)).Times(1).Return(claim, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().
+					UpdateRewardClaimStatus(gomock.Any(), gomock.Eq(db.UpdateRewardClaimStatusParams{ID: claim.ID, Status: interfaces.RewardClaimShipped, Note: "tracking 1234"})).
+					Times(1).
+					Return(shipped, nil)
+				store.EXPECT().ReleaseRewardTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"status":"shipped"`)
+			},
+		},
+		{
+			name: "Cancelled",
+			body: gin.H{"status": interfaces.RewardClaimCancelled},
+			buildStubs: func(store *mockdb.MockStore) {
+				cancelled := claim
+				cancelled.Status = interfaces.RewardClaimCancelled
+
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetRewardClaim(gomock.Any(), gomock.Any()).Times(1).Return(claim, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().UpdateRewardClaimStatus(gomock.Any(), gomock.Any()).Times(1).Return(cancelled, nil)
+				store.EXPECT().ReleaseRewardTier(gomock.Any(), gomock.Eq(claim.TierID)).Times(1).Return(db.RewardTiers{ID: claim.TierID}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyCancelled",
+			body: gin.H{"status": interfaces.RewardClaimCancelled},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(user, nil)
+				store.EXPECT().GetRewardClaim(gomock.Any(), gomock.Any()).Times(1).Return(claim, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().UpdateRewardClaimStatus(gomock.Any(), gomock.Any()).Times(1).Return(db.RewardClaims{}, sql.ErrNoRows)
+				store.EXPECT().ReleaseRewardTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotOwner",
+			body: gin.H{"status": interfaces.RewardClaimFulfilled},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.Users{Username: user.Username, Address: "0x01"}, nil)
+				store.EXPECT().GetRewardClaim(gomock.Any(), gomock.Any()).Times(1).Return(claim, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().UpdateRewardClaimStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidStatus",
+			body: gin.H{"status": "lost"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetRewardClaim(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPatch, "/api/v1/reward-claims/3", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestDonationRewardTier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, store)
+
+	chain, err := server.chains.Get(defi.SepoliaChainID)
+	require.NoError(t, err)
+
+	tier := db.RewardTiers{
+		ID:              2,
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      7,
+		MinAmount:       "500000000000000000",
+		Quantity:        sql.NullInt32{Int32: 5, Valid: true},
+		Claimed:         4,
+	}
+	soldOut := tier
+	soldOut.Claimed = 5
+
+	testCases := []struct {
+		name       string
+		tier       db.RewardTiers
+		campaignID int64
+		amount     string
+		status     int
+	}{
+		{name: "OK", tier: tier, campaignID: 7, amount: "500000000000000000"},
+		{name: "BelowMinimum", tier: tier, campaignID: 7, amount: "499999999999999999", status: http.StatusBadRequest},
+		{name: "OtherCampaign", tier: tier, campaignID: 8, amount: "500000000000000000", status: http.StatusNotFound},
+		{name: "SoldOut", tier: soldOut, campaignID: 7, amount: "500000000000000000", status: http.StatusConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store.EXPECT().GetRewardTier(gomock.Any(), gomock.Eq(tier.ID)).Times(1).Return(tc.tier, nil)
+
+			recorder := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(recorder)
+
+			amount, _ := new(big.Int).SetString(tc.amount, 10)
+			got, ok := server.donationRewardTier(ctx, chain, tc.campaignID, tier.ID, amount)
+			if tc.status == 0 {
+				require.True(t, ok)
+				require.Equal(t, tc.tier, got)
+				return
+			}
+			require.False(t, ok)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestClaimReward(t *testing.T) {
+	tier := db.RewardTiers{ID: 2, ChainID: defi.SepoliaChainID, CampaignID: 7}
+	user := db.Users{Username: utils.RandomString(6)}
+	record := db.DonationRecords{
+		ID:           11,
+		ChainID:      defi.SepoliaChainID,
+		CampaignID:   7,
+		DonorAddress: "0xabc0000000000000000000000000000000000004",
+		Amount:       "500000000000000000",
+		TxHash:       sql.NullString{String: "0x1234", Valid: true},
+	}
+
+	testCases := []struct {
+		name       string
+		recordErr  error
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateRewardClaim(gomock.Any(), gomock.Eq(db.CreateRewardClaimParams{
+						TierID:           tier.ID,
+						ChainID:          record.ChainID,
+						CampaignID:       record.CampaignID,
+						Username:         user.Username,
+						DonorAddress:     record.DonorAddress,
+						Amount:           record.Amount,
+						TxHash:           record.TxHash.String,
+						DonationRecordID: sql.NullInt64{Int64: record.ID, Valid: true},
+					})).
+					Times(1).
+					Return(db.RewardClaims{ID: 1}, nil)
+				store.EXPECT().ReleaseRewardTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			// nothing could cancel a claim without a donation record if the transaction failed
+			name:      "Unrecorded",
+			recordErr: sql.ErrConnDone,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRewardClaim(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReleaseRewardTier(gomock.Any(), gomock.Eq(tier.ID)).Times(1).Return(tier, nil)
+			},
+		},
+		{
+			name: "ClaimFails",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateRewardClaim(gomock.Any(), gomock.Any()).Times(1).Return(db.RewardClaims{}, sql.ErrConnDone)
+				store.EXPECT().ReleaseRewardTier(gomock.Any(), gomock.Eq(tier.ID)).Times(1).Return(tier, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			server := newTestServer(t, store)
+
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			server.claimReward(ctx, tier, user, record, tc.recordErr)
+		})
+	}
+}
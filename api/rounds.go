@@ -0,0 +1,264 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create a funding round
+// @Description Schedule a quadratic funding round as a moderator. The donations each listed campaign receives between starts_at and ends_at decide its share of matching_amount: a campaign's share grows with the square of the sum of the square roots of its donors' contributions, so many small donors count for more than a few large ones. The round gets its own wallet, which must hold matching_amount by the time the allocations are paid. With require_verified (the default) only donors with a verified account are matched and a user's wallets count as one donor; donors who gave less than min_contribution during the round are not matched.
+// @Accept  json
+// @Produce  json
+// @Tags Funding rounds
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param   data        body   interfaces.CreateFundingRoundRequest    true  "Funding round"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.FundingRound}	"success"
+// @Router /funding-rounds [post]
+func (server *Server) createFundingRound(ctx *gin.Context) {
+	var req interfaces.CreateFundingRoundRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	if !req.EndsAt.After(req.StartsAt) || !req.EndsAt.After(time.Now()) {
+		err := errors.New("ends_at must be after starts_at and in the future")
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	chain, ok := server.chainByID(ctx, req.ChainID, req.ContractVersion)
+	if !ok {
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+	if !server.isModerator(user.Username) {
+		err := errors.New("only moderators can create funding rounds")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	campaignIDs := make([]int64, 0, len(req.CampaignIDs))
+	seen := make(map[int64]bool, len(req.CampaignIDs))
+	for _, id := range req.CampaignIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		_, err := server.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+			ChainID:         chain.ID,
+			ContractVersion: int32(chain.Version),
+			CampaignID:      id,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("campaign "+strconv.FormatInt(id, 10)+" not found"), http.StatusNotFound))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+		campaignIDs = append(campaignIDs, id)
+	}
+
+	filepath, address, err := defi.GenerateAccountKeyStone(server.config.PassPhase)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	params := db.CreateFundingRoundParams{
+		Name:            req.Name,
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignIds:     campaignIDs,
+		MatchingAmount:  utils.EtherToWei(req.MatchingAmount),
+		WalletAddress:   address,
+		FilePath:        filepath,
+		StartsAt:        req.StartsAt,
+		EndsAt:          req.EndsAt,
+		RequireVerified: req.RequireVerified == nil || *req.RequireVerified,
+		MinContribution: "0",
+		CreatedBy:       user.Username,
+	}
+	if req.MinContribution > 0 {
+		params.MinContribution = utils.EtherToWei(req.MinContribution)
+	}
+
+	round, err := server.store.CreateFundingRound(ctx, params)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewFundingRound(round)))
+}
+
+// @Summary Get funding rounds
+// @Description Get the funding rounds, newest first
+// @Accept  json
+// @Produce  json
+// @Tags Funding rounds
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.FundingRound}}	"success"
+// @Router /funding-rounds [get]
+func (server *Server) listFundingRounds(ctx *gin.Context) {
+	var pageReq interfaces.PageRequest
+	if err := ctx.ShouldBindQuery(&pageReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+		return
+	}
+
+	var cursor idCursor
+	if pageReq.Cursor != "" {
+		if err := decodeCursor(pageReq.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
+	}
+
+	pageSize := pageReq.PageSize()
+	rounds, err := server.store.ListFundingRounds(ctx, db.ListFundingRoundsParams{
+		CursorID: sql.NullInt64{Int64: cursor.ID, Valid: pageReq.Cursor != ""},
+		Limit:    pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	total, err := server.store.CountFundingRounds(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	page := interfaces.Page{TotalCount: total}
+	if len(rounds) > int(pageSize) {
+		rounds = rounds[:pageSize]
+
+		page.NextCursor, err = encodeCursor(idCursor{ID: rounds[len(rounds)-1].ID})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
+	items := make([]interfaces.FundingRound, len(rounds))
+	for i, round := range rounds {
+		items[i] = interfaces.NewFundingRound(round)
+	}
+	page.Items = items
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
+}
+
+// @Summary Get a funding round
+// @Description Get a funding round with each campaign's allocation once the round has been calculated: the unique donors that were matched and excluded, what the matched donors gave and the campaign's share of the matching amount, with its payment
+// @Accept  json
+// @Produce  json
+// @Tags Funding rounds
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Funding round ID"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.FundingRoundReport}	"success"
+// @Router /funding-rounds/{id} [get]
+func (server *Server) getFundingRound(ctx *gin.Context) {
+	round, ok := server.pathFundingRound(ctx)
+	if !ok {
+		return
+	}
+
+	allocations, err := server.store.ListFundingRoundAllocations(ctx, round.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	rsp := interfaces.FundingRoundReport{
+		FundingRound: interfaces.NewFundingRound(round),
+		Allocations:  make([]interfaces.RoundAllocation, len(allocations)),
+	}
+	for i, allocation := range allocations {
+		rsp.Allocations[i] = interfaces.NewRoundAllocation(allocation)
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, rsp))
+}
+
+// @Summary Approve a funding round's allocations
+// @Description Approve the calculated allocations of a funding round as a moderator. The next settle run pays each campaign's allocation to its owner from the round's wallet.
+// @Accept  json
+// @Produce  json
+// @Tags Funding rounds
+// @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
+// @Param id path int true "Funding round ID"
+// @Success		200				{object}    interfaces.DocSuccessResponse{data=interfaces.FundingRound}	"success"
+// @Router /funding-rounds/{id}/approve [post]
+func (server *Server) approveFundingRound(ctx *gin.Context) {
+	round, ok := server.pathFundingRound(ctx)
+	if !ok {
+		return
+	}
+
+	user, ok := server.currentUser(ctx)
+	if !ok {
+		return
+	}
+	if !server.isModerator(user.Username) {
+		err := errors.New("only moderators can approve funding rounds")
+		ctx.JSON(http.StatusForbidden, interfaces.ErrorResponse(err, http.StatusForbidden))
+		return
+	}
+
+	round, err := server.store.ApproveFundingRound(ctx, db.ApproveFundingRoundParams{
+		ID:         round.ID,
+		ApprovedBy: sql.NullString{String: user.Username, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusConflict, interfaces.ErrorResponse(errors.New("funding round has not been calculated"), http.StatusConflict))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, interfaces.NewFundingRound(round)))
+}
+
+// pathFundingRound loads the :id funding round, writing the error response when it cannot
+func (server *Server) pathFundingRound(ctx *gin.Context) (db.FundingRounds, bool) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid funding round ID"), http.StatusBadRequest))
+		return db.FundingRounds{}, false
+	}
+
+	round, err := server.store.GetFundingRound(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			ctx.JSON(http.StatusNotFound, interfaces.ErrorResponse(errors.New("funding round not found"), http.StatusNotFound))
+			return db.FundingRounds{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return db.FundingRounds{}, false
+	}
+
+	return round, true
+}
@@ -0,0 +1,195 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFundingRound(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	startsAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	endsAt := startsAt.Add(14 * 24 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		moderators    string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			body:       gin.H{"name": "Spring round", "campaign_ids": []int64{3, 5, 3}, "matching_amount": 10, "starts_at": startsAt, "ends_at": endsAt},
+			moderators: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(2).Return(db.IndexedCampaigns{}, nil)
+				store.EXPECT().
+					CreateFundingRound(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateFundingRoundParams) (db.FundingRounds, error) {
+						t.Cleanup(func() { os.Remove(arg.FilePath) })
+						require.Equal(t, []int64{3, 5}, arg.CampaignIds)
+						require.Equal(t, defi.SepoliaChainID, arg.ChainID)
+						require.Equal(t, utils.EtherToWei(10), arg.MatchingAmount)
+						require.True(t, arg.RequireVerified)
+						require.Equal(t, "0", arg.MinContribution)
+						require.Equal(t, user.Username, arg.CreatedBy)
+						require.NotEmpty(t, arg.WalletAddress)
+						return db.FundingRounds{ID: 1, CampaignIds: arg.CampaignIds, MatchingAmount: arg.MatchingAmount, MinContribution: "0", Status: interfaces.RoundScheduled}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"status":"scheduled"`)
+				require.Contains(t, recorder.Body.String(), `"matching_amount":10`)
+			},
+		},
+		{
+			name:       "NotModerator",
+			body:       gin.H{"name": "Spring round", "campaign_ids": []int64{3}, "matching_amount": 10, "starts_at": startsAt, "ends_at": endsAt},
+			moderators: "someone-else",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFundingRound(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:       "CampaignNotFound",
+			body:       gin.H{"name": "Spring round", "campaign_ids": []int64{3}, "matching_amount": 10, "starts_at": startsAt, "ends_at": endsAt},
+			moderators: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(db.IndexedCampaigns{}, sql.ErrNoRows)
+				store.EXPECT().CreateFundingRound(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:       "EndsBeforeStart",
+			body:       gin.H{"name": "Spring round", "campaign_ids": []int64{3}, "matching_amount": 10, "starts_at": endsAt, "ends_at": startsAt},
+			moderators: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFundingRound(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:       "NoCampaigns",
+			body:       gin.H{"name": "Spring round", "campaign_ids": []int64{}, "matching_amount": 10, "starts_at": startsAt, "ends_at": endsAt},
+			moderators: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFundingRound(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).AnyTimes().Return(user, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.config.Moderators = tc.moderators
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/funding-rounds", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestApproveFundingRound(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6)}
+	round := db.FundingRounds{ID: 6, MatchingAmount: "1", MinContribution: "0", Status: interfaces.RoundCalculated}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ApproveFundingRound(gomock.Any(), gomock.Eq(db.ApproveFundingRoundParams{
+						ID:         round.ID,
+						ApprovedBy: sql.NullString{String: user.Username, Valid: true},
+					})).
+					Times(1).
+					Return(db.FundingRounds{ID: round.ID, MatchingAmount: "1", MinContribution: "0", Status: interfaces.RoundApproved}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"status":"approved"`)
+			},
+		},
+		{
+			name: "NotCalculated",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ApproveFundingRound(gomock.Any(), gomock.Any()).Times(1).Return(db.FundingRounds{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetFundingRound(gomock.Any(), gomock.Eq(round.ID)).Times(1).Return(round, nil)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			server.config.Moderators = user.Username
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/api/v1/funding-rounds/%d/approve", round.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -1,13 +1,21 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 
 	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
 	"github.com/demola234/defiraise/docs"
+	"github.com/demola234/defiraise/faucet"
+	"github.com/demola234/defiraise/onramp"
+	"github.com/demola234/defiraise/price"
+	"github.com/demola234/defiraise/relayer"
 	"github.com/demola234/defiraise/token"
 	"github.com/demola234/defiraise/utils"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
@@ -16,23 +24,68 @@ type Server struct {
 	config     utils.Config
 	store      db.Store
 	tokenMaker token.Maker
-	router     *gin.Engine
+	prices     price.PriceProvider
+	tokens     *defi.TokenRegistry
+	chains     *defi.ChainRegistry
+	// relayer sponsors the gas of custodial wallets, or is nil when RELAYER_PRIVATE_KEY is not set
+	relayer *relayer.Relayer
+	// faucet sends new users an onboarding grant on test networks, or is nil when FAUCET_PRIVATE_KEY is not set
+	faucet *faucet.Faucet
+	// onramp sells crypto through the on-ramp provider, or is nil when ONRAMP_PROVIDER is not set
+	onramp *onramp.Service
+	router *gin.Engine
 }
 
-// NewServer creates a new HTTP server and setup routing
-func NewServer(config utils.Config, store db.Store) (*Server, error) {
+// NewServer creates a new HTTP server and setup routing. Contract calls are routed to the chains in chains.
+func NewServer(config utils.Config, store db.Store, chains *defi.ChainRegistry) (*Server, error) {
 
 	tokenMaker, err := token.NewTokenMaker("beb4118e1bdc8020df695ceec7e464a5")
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker %s", err.Error())
 	}
 
+	prices, err := defi.NewPriceProvider(config, chains)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create price provider %s", err.Error())
+	}
+
+	tokens, err := defi.LoadTokenRegistry(config.TokenRegistry)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load token registry %s", err.Error())
+	}
+
+	gasRelayer, err := relayer.NewRelayer(store, config)
+	if err != nil && !errors.Is(err, relayer.ErrNoRelayerKey) {
+		return nil, fmt.Errorf("cannot create relayer %s", err.Error())
+	}
+
+	onboarding, err := faucet.NewFaucet(store, chains, tokens, config, nil)
+	if err != nil && !errors.Is(err, faucet.ErrNoFaucetKey) {
+		return nil, fmt.Errorf("cannot create faucet %s", err.Error())
+	}
+
+	ramp, err := onramp.NewService(store, chains, config)
+	if err != nil && !errors.Is(err, onramp.ErrNoProvider) {
+		return nil, fmt.Errorf("cannot create on-ramp %s", err.Error())
+	}
+
 	server := &Server{
+		config:     config,
 		store:      store,
 		tokenMaker: tokenMaker,
+		prices:     prices,
+		tokens:     tokens,
+		chains:     chains,
+		relayer:    gasRelayer,
+		faucet:     onboarding,
+		onramp:     ramp,
 		router:     gin.Default(),
 	}
 
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterValidation("currency", validCurrency)
+	}
+
 	server.setUpRouter()
 	// programmatically set swagger info
 	docs.SwaggerInfo.Title = "DefiFundr API"
@@ -63,8 +116,12 @@ func (server *Server) setUpRouter() {
 	v1.POST("/user/password", server.createPassword)
 	v1.POST("/user/checkUsername", server.checkUsernameExists)
 	v1.POST("/token/renewAccess", server.renewAccessToken)
+	v1.GET("/chains", server.getChains)
+	v1.GET("/badges/:id", server.getBadgeMetadata)
+	v1.POST("/onramp/webhook", server.onRampWebhook)
 	authRoutes := v1.Group("/").Use(authMiddleWare(server.tokenMaker))
 	authRoutes.GET("/user", server.getUser)
+	authRoutes.GET("/chains/status", server.getChainStatus)
 	authRoutes.POST("/user/update", server.updateUser)
 	authRoutes.POST("/userAddress", server.getUserByAddress)
 	authRoutes.GET("/user/avatar", server.setProfileAvatar)
@@ -73,10 +130,36 @@ func (server *Server) setUpRouter() {
 	authRoutes.POST("/user/logout", server.logoutUser)
 	authRoutes.POST("/user/password/change", server.changePassword)
 	authRoutes.POST("/user/privatekey", server.getPrivateKey)
+	authRoutes.POST("/user/currency", server.setPreferredCurrency)
+	authRoutes.GET("/portfolio", server.getPortfolio)
 	authRoutes.GET("/campaigns/latestCampaigns", server.getLatestActiveCampaigns)
 	authRoutes.GET("/campaigns", server.getCampaigns)
 	authRoutes.POST("/campaigns", server.createCampaign)
 	authRoutes.GET("/campaigns/:id", server.getCampaign)
+	authRoutes.POST("/campaigns/:id/currency", server.setCampaignCurrency)
+	authRoutes.POST("/campaigns/:id/deadline", server.extendCampaignDeadline)
+	authRoutes.POST("/campaigns/:id/close", server.closeCampaignEarly)
+	authRoutes.POST("/campaigns/:id/milestones", server.createCampaignMilestones)
+	authRoutes.GET("/campaigns/:id/milestones", server.listCampaignMilestones)
+	authRoutes.POST("/campaigns/:id/milestones/:milestone_id/submit", server.submitCampaignMilestone)
+	authRoutes.POST("/campaigns/:id/milestones/:milestone_id/vote", server.voteCampaignMilestone)
+	authRoutes.POST("/campaigns/:id/milestones/:milestone_id/review", server.reviewCampaignMilestone)
+	authRoutes.POST("/campaigns/:id/proposals", server.createCampaignProposal)
+	authRoutes.GET("/campaigns/:id/proposals", server.listCampaignProposals)
+	authRoutes.POST("/campaigns/:id/rewards", server.createRewardTier)
+	authRoutes.GET("/campaigns/:id/rewards", server.listRewardTiers)
+	authRoutes.GET("/campaigns/:id/rewards/claims", server.listCampaignRewardClaims)
+	authRoutes.GET("/reward-claims", server.listMyRewardClaims)
+	authRoutes.GET("/user/badges", server.listMyBadges)
+	authRoutes.GET("/gas/budget", server.getGasBudget)
+	authRoutes.GET("/gas/sponsorships", server.getGasSponsorships)
+	authRoutes.GET("/faucet", server.listFaucetGrants)
+	authRoutes.POST("/faucet", server.claimFaucetGrant)
+	authRoutes.GET("/onramp/orders", server.listOnRampOrders)
+	authRoutes.POST("/onramp/orders", server.createOnRampOrder)
+	authRoutes.PATCH("/reward-claims/:id", server.updateRewardClaim)
+	authRoutes.GET("/proposals/:proposal_id/vote-data", server.getProposalVoteData)
+	authRoutes.POST("/proposals/:proposal_id/votes", server.voteOnProposal)
 	authRoutes.GET("/campaigns/categories/:id", server.getCampaignsByCategory)
 	authRoutes.GET("/campaigns/owner", server.getCampaignsByOwner)
 	authRoutes.GET("/campaignsTypes", server.getCampaignTypes)
@@ -84,9 +167,28 @@ func (server *Server) setUpRouter() {
 	authRoutes.POST("/campaigns/donate", server.donateToCampaign)
 	authRoutes.POST("/campaigns/withdraw", server.withdrawFromCampaign)
 	authRoutes.GET("/campaigns/myDonations", server.getMyDonations)
-	authRoutes.GET("/currentPrice", server.currentEthPrice)
+	authRoutes.GET("/donations/:id/receipt", server.getDonationReceipt)
+	authRoutes.POST("/donations/:id/receipt/email", server.emailDonationReceipt)
+	authRoutes.GET("/donations/statements/:year", server.getDonationStatement)
+	authRoutes.POST("/donations/statements/:year/email", server.emailDonationStatement)
+	authRoutes.POST("/recurring-donations", server.createRecurringDonation)
+	authRoutes.GET("/recurring-donations", server.listRecurringDonations)
+	authRoutes.POST("/recurring-donations/:id/pause", server.pauseRecurringDonation)
+	authRoutes.POST("/recurring-donations/:id/resume", server.resumeRecurringDonation)
+	authRoutes.POST("/recurring-donations/:id/cancel", server.cancelRecurringDonation)
+	authRoutes.POST("/matching-pools", server.createMatchingPool)
+	authRoutes.GET("/matching-pools", server.listMatchingPools)
+	authRoutes.GET("/matching-pools/:id", server.getMatchingPool)
+	authRoutes.POST("/matching-pools/:id/close", server.closeMatchingPool)
+	authRoutes.POST("/funding-rounds", server.createFundingRound)
+	authRoutes.GET("/funding-rounds", server.listFundingRounds)
+	authRoutes.GET("/funding-rounds/:id", server.getFundingRound)
+	authRoutes.POST("/funding-rounds/:id/approve", server.approveFundingRound)
+	authRoutes.GET("/currentPrice", server.currentPrice)
+	authRoutes.GET("/prices/history", server.getPriceHistory)
+	authRoutes.GET("/campaigns/totals/:id", server.getCampaignTotals)
 	authRoutes.GET("/campaigns/categories", server.getCategories)
-	authRoutes.GET("/campaigns/search", server.searchCampaignByName)
+	authRoutes.GET("/campaigns/search", server.searchCampaigns)
 	authRoutes.POST("/wallet-address/create", server.createWalletAddress)
 	authRoutes.GET("/wallet-address", server.getUserWallets)
 	authRoutes.GET("/wallet-address/address/:wallet_address", server.getWalletByAddress)
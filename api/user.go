@@ -160,7 +160,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	}
 
 	// get current eth balance
-	balance, err := defi.GetBalance(user.Address)
+	balance, err := server.chains.Default().GetBalance(user.Address)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
@@ -278,11 +278,12 @@ func (server *Server) verifyUser(ctx *gin.Context) {
 		},
 	}
 
-	_, err = server.store.UpdateUser(ctx, arg)
+	verified, err := server.store.UpdateUser(ctx, arg)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
+	server.onboard(verified, ctx.ClientIP())
 
 	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, "User Verified"))
 }
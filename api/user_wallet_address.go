@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
 
 	db "github.com/demola234/defiraise/db/sqlc"
 	"github.com/demola234/defiraise/interfaces"
@@ -80,26 +79,24 @@ func (server *Server) createWalletAddress(ctx *gin.Context) {
 // @Produce  json
 // @Tags User Wallet Addresses
 // @Param Authorization header string true "Insert your access token" default(Bearer <Add access token here>)
-// @Param limit query int false "Limit (default: 10)"
-// @Param offset query int false "Offset (default: 0)"
-// @Success 200 {object} interfaces.DocSuccessResponse{data=[]interfaces.WalletAddressResponse} "success"
+// @Param cursor query string false "Cursor returned by the previous page"
+// @Param limit query int false "Page size (default: 20, max: 100)"
+// @Success 200 {object} interfaces.DocSuccessResponse{data=interfaces.Page{items=[]interfaces.WalletAddressResponse}} "success"
 // @Router /wallet-address [get]
 func (server *Server) getUserWallets(ctx *gin.Context) {
 	// Extract pagination params
-	limit := ctx.DefaultQuery("limit", "10")  // Default: 10 items
-	offset := ctx.DefaultQuery("offset", "0") // Default: start from 0
-
-	// Convert limit and offset to integers
-	limitInt, err := strconv.Atoi(limit)
-	if err != nil || limitInt < 1 {
-		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid limit value"), http.StatusBadRequest))
+	var pageReq interfaces.PageRequest
+	if err := ctx.ShouldBindQuery(&pageReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
 		return
 	}
 
-	offsetInt, err := strconv.Atoi(offset)
-	if err != nil || offsetInt < 0 {
-		ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(errors.New("invalid offset value"), http.StatusBadRequest))
-		return
+	var cursor createdAtCursor
+	if pageReq.Cursor != "" {
+		if err := decodeCursor(pageReq.Cursor, &cursor); err != nil {
+			ctx.JSON(http.StatusBadRequest, interfaces.ErrorResponse(err, http.StatusBadRequest))
+			return
+		}
 	}
 
 	// Extract authenticated user
@@ -120,19 +117,38 @@ func (server *Server) getUserWallets(ctx *gin.Context) {
 		return
 	}
 
-	var getUserWalletsParams = db.GetUserWalletsParams{
-		UserID: user.Username,
-		Limit:  int32(limitInt),
-		Offset: int32(offsetInt),
+	// Fetch one extra wallet to learn whether another page exists
+	pageSize := pageReq.PageSize()
+	wallets, err := server.store.GetUserWallets(ctx, db.GetUserWalletsParams{
+		UserID:          user.Username,
+		CursorCreatedAt: sql.NullTime{Time: cursor.CreatedAt, Valid: pageReq.Cursor != ""},
+		CursorID:        sql.NullInt64{Int64: cursor.ID, Valid: pageReq.Cursor != ""},
+		Limit:           pageSize + 1,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+		return
 	}
 
-	// Fetch paginated wallets
-	wallets, err := server.store.GetUserWallets(ctx, getUserWalletsParams)
+	total, err := server.store.CountUserWallets(ctx, user.Username)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
 		return
 	}
 
+	page := interfaces.Page{TotalCount: total}
+
+	if len(wallets) > int(pageSize) {
+		wallets = wallets[:pageSize]
+
+		last := wallets[len(wallets)-1]
+		page.NextCursor, err = encodeCursor(createdAtCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, interfaces.ErrorResponse(err, http.StatusInternalServerError))
+			return
+		}
+	}
+
 	// Transform wallets into response format
 	walletResponses := make([]interfaces.WalletAddressResponse, len(wallets))
 	for i, wallet := range wallets {
@@ -146,7 +162,9 @@ func (server *Server) getUserWallets(ctx *gin.Context) {
 		}
 	}
 
-	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, walletResponses))
+	page.Items = walletResponses
+
+	ctx.JSON(http.StatusOK, interfaces.Response(http.StatusOK, page))
 }
 
 
@@ -0,0 +1,14 @@
+package api
+
+import (
+	"github.com/demola234/defiraise/price"
+	"github.com/go-playground/validator/v10"
+)
+
+// validCurrency accepts the fiat currencies amounts can be displayed in
+var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if currency, ok := fieldLevel.Field().Interface().(string); ok {
+		return price.IsFiatCurrency(currency)
+	}
+	return false
+}
@@ -0,0 +1,343 @@
+package badges
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultInterval is used when BADGE_INTERVAL is not configured
+	defaultInterval = time.Minute
+	// batchSize is the number of donations, or stale mints, read at a time
+	batchSize = 50
+	// confirmations is how far behind the chain head BadgeMinted events are read, so a shallow reorg
+	// cannot undo a confirmed mint
+	confirmations = 5
+	// maxBlockRange bounds the blocks read in one log query, which RPC providers limit
+	maxBlockRange = 2000
+	// staleAfter is how long a mint may go unconfirmed before it is checked against the contract and,
+	// if the badge was never minted, sent again
+	staleAfter = 30 * time.Minute
+)
+
+// ErrNoMinterKey is returned by NewMinter when BADGE_MINTER_KEY is not set
+var ErrNoMinterKey = errors.New("BADGE_MINTER_KEY is not set")
+
+// badgeContract is the part of a chain the minter sends badges through
+type badgeContract interface {
+	BadgeMinted(ctx context.Context, to string, tokenID int64) (bool, error)
+	MintBadge(ctx context.Context, to string, tokenID int64, key *ecdsa.PrivateKey) (string, error)
+}
+
+// Minter mints a supporter badge for every confirmed donation on the chains with a badge contract:
+// one for the campaign and, when the donation claimed a reward tier, one for the tier. Each address
+// gets each badge once.
+type Minter struct {
+	store    db.Store
+	chains   *defi.ChainRegistry
+	key      *ecdsa.PrivateKey
+	interval time.Duration
+}
+
+// NewMinter creates a minter that signs with BADGE_MINTER_KEY, the minter of every badge contract
+func NewMinter(store db.Store, chains *defi.ChainRegistry, configs utils.Config) (*Minter, error) {
+	if configs.BadgeMinterKey == "" {
+		return nil, ErrNoMinterKey
+	}
+	key, err := crypto.HexToECDSA(configs.BadgeMinterKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BADGE_MINTER_KEY: %w", err)
+	}
+
+	minter := &Minter{
+		store:    store,
+		chains:   chains,
+		key:      key,
+		interval: configs.BadgeInterval,
+	}
+	if minter.interval <= 0 {
+		minter.interval = defaultInterval
+	}
+
+	return minter, nil
+}
+
+// Start mints badges straight away and then on every interval until ctx is cancelled
+func (minter *Minter) Start(ctx context.Context) {
+	ticker := time.NewTicker(minter.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := minter.RunOnce(ctx); err != nil {
+			log.Error().Err(err).Msg("cannot mint badges")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce confirms the mints recorded by each badge contract, resends the ones that never made it
+// and mints the badges of new confirmed donations. A chain that fails is logged and picked up again
+// on the next run.
+func (minter *Minter) RunOnce(ctx context.Context) error {
+	for _, chain := range minter.chains.All() {
+		if !chain.HasBadges() {
+			continue
+		}
+
+		logger := log.With().Int64("chain_id", chain.ID).Str("badge_contract", chain.BadgeContract).Logger()
+		if err := minter.confirmMints(ctx, chain); err != nil {
+			logger.Error().Err(err).Msg("cannot confirm badge mints")
+		}
+		if err := minter.retryStale(ctx, logger, chain); err != nil {
+			logger.Error().Err(err).Msg("cannot retry badge mints")
+		}
+		if err := minter.mintChain(ctx, logger, chain.ID, chain); err != nil {
+			logger.Error().Err(err).Msg("cannot mint badges")
+		}
+	}
+	return nil
+}
+
+// confirmMints reads the BadgeMinted events since the last run and marks the matching mints confirmed.
+// The first run only records where to start reading.
+func (minter *Minter) confirmMints(ctx context.Context, chain *defi.Chain) error {
+	head, err := chain.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < confirmations {
+		return nil
+	}
+	head -= confirmations
+
+	contract := strings.ToLower(chain.BadgeContract)
+	last, err := minter.store.GetBadgeSyncBlock(ctx, db.GetBadgeSyncBlockParams{ChainID: chain.ID, ContractAddress: contract})
+	if errors.Is(err, sql.ErrNoRows) {
+		return minter.store.SetBadgeSyncBlock(ctx, db.SetBadgeSyncBlockParams{ChainID: chain.ID, ContractAddress: contract, BlockNumber: int64(head)})
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, blocks := range blockRanges(uint64(last)+1, head, maxBlockRange) {
+		mints, err := chain.BadgeMints(ctx, blocks[0], blocks[1])
+		if err != nil {
+			return err
+		}
+
+		for _, mint := range mints {
+			_, err := minter.store.ConfirmBadgeMint(ctx, db.ConfirmBadgeMintParams{
+				BadgeID:     mint.TokenID,
+				Recipient:   strings.ToLower(mint.To),
+				TxHash:      sql.NullString{String: mint.TxHash, Valid: true},
+				BlockNumber: sql.NullInt64{Int64: int64(mint.BlockNumber), Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err = minter.store.SetBadgeSyncBlock(ctx, db.SetBadgeSyncBlockParams{ChainID: chain.ID, ContractAddress: contract, BlockNumber: int64(blocks[1])})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retryStale settles mints that have gone unconfirmed for too long: a badge the contract has minted is
+// confirmed, and any other mint is dropped so the donation is picked up and minted again
+func (minter *Minter) retryStale(ctx context.Context, logger zerolog.Logger, chain *defi.Chain) error {
+	stale, err := minter.store.ListStaleBadgeMints(ctx, db.ListStaleBadgeMintsParams{
+		ChainID: chain.ID,
+		Before:  time.Now().Add(-staleAfter),
+		Limit:   batchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, mint := range stale {
+		minted, err := chain.BadgeMinted(ctx, mint.Recipient, mint.BadgeID)
+		if err != nil {
+			return err
+		}
+
+		if minted {
+			_, err = minter.store.ConfirmBadgeMint(ctx, db.ConfirmBadgeMintParams{
+				BadgeID:   mint.BadgeID,
+				Recipient: mint.Recipient,
+				TxHash:    mint.TxHash,
+			})
+		} else {
+			logger.Warn().Int64("badge_id", mint.BadgeID).Str("recipient", mint.Recipient).Str("tx_hash", mint.TxHash.String).Msg("badge mint never landed, sending it again")
+			err = minter.store.DeleteBadgeMint(ctx, mint.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mintChain mints the missing badges of the confirmed donations on a chain. It stops at the first
+// badge that cannot be sent, which is retried on the next run.
+func (minter *Minter) mintChain(ctx context.Context, logger zerolog.Logger, chainID int64, contract badgeContract) error {
+	for {
+		donations, err := minter.store.ListBadgeableDonations(ctx, db.ListBadgeableDonationsParams{
+			ChainID: chainID,
+			Limit:   batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, donation := range donations {
+			tiers := []int64{0}
+			if donation.TierID != 0 {
+				tiers = append(tiers, donation.TierID)
+			}
+
+			for _, tierID := range tiers {
+				badge, err := minter.ensureBadge(ctx, chainID, donation, tierID)
+				if err != nil {
+					return err
+				}
+				if err := minter.mint(ctx, logger, contract, badge, donation); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(donations) < batchSize {
+			return nil
+		}
+	}
+}
+
+// ensureBadge returns the badge of a donation's campaign, or of one of its reward tiers, creating it
+// from the campaign and tier on first use
+func (minter *Minter) ensureBadge(ctx context.Context, chainID int64, donation db.ListBadgeableDonationsRow, tierID int64) (db.Badges, error) {
+	campaign, err := minter.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         chainID,
+		ContractVersion: donation.ContractVersion,
+		CampaignID:      donation.CampaignID,
+	})
+	if err != nil {
+		return db.Badges{}, err
+	}
+
+	var tier *db.RewardTiers
+	if tierID != 0 {
+		found, err := minter.store.GetRewardTier(ctx, tierID)
+		if err != nil {
+			return db.Badges{}, err
+		}
+		tier = &found
+	}
+
+	name, description := badgeText(campaign, tier)
+	return minter.store.EnsureBadge(ctx, db.EnsureBadgeParams{
+		ChainID:         chainID,
+		ContractVersion: donation.ContractVersion,
+		CampaignID:      donation.CampaignID,
+		TierID:          tierID,
+		Name:            name,
+		Description:     description,
+		Image:           campaign.Image,
+	})
+}
+
+// mint claims a badge for the donor and sends it. The claim is dropped if sending fails, so the
+// donation comes up again on the next run.
+func (minter *Minter) mint(ctx context.Context, logger zerolog.Logger, contract badgeContract, badge db.Badges, donation db.ListBadgeableDonationsRow) error {
+	recipient := strings.ToLower(donation.DonorAddress)
+	logger = logger.With().Int64("badge_id", badge.ID).Str("recipient", recipient).Int64("donation_record_id", donation.ID).Logger()
+
+	claim, err := minter.store.ClaimBadgeMint(ctx, db.ClaimBadgeMintParams{
+		BadgeID:          badge.ID,
+		Recipient:        recipient,
+		DonationRecordID: donation.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// the donor already has the badge from another donation, or another minter is sending it
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the contract refuses a second mint, so a badge minted before its claim was lost is only confirmed
+	minted, err := contract.BadgeMinted(ctx, recipient, badge.ID)
+	if err != nil {
+		return minter.dropClaim(ctx, claim, err)
+	}
+	if minted {
+		_, err := minter.store.ConfirmBadgeMint(ctx, db.ConfirmBadgeMintParams{BadgeID: badge.ID, Recipient: recipient})
+		return err
+	}
+
+	hash, err := contract.MintBadge(ctx, recipient, badge.ID, minter.key)
+	if err != nil {
+		return minter.dropClaim(ctx, claim, err)
+	}
+
+	if _, err := minter.store.MarkBadgeMintSent(ctx, db.MarkBadgeMintSentParams{
+		ID:     claim.ID,
+		TxHash: sql.NullString{String: hash, Valid: true},
+	}); err != nil {
+		// the mint is out, so leave the claim for the BadgeMinted event to confirm
+		logger.Error().Err(err).Str("tx_hash", hash).Msg("cannot record badge mint")
+		return nil
+	}
+
+	logger.Info().Str("tx_hash", hash).Msg("badge minted")
+	return nil
+}
+
+func (minter *Minter) dropClaim(ctx context.Context, claim db.BadgeMints, cause error) error {
+	if err := minter.store.DeleteBadgeMint(ctx, claim.ID); err != nil {
+		return fmt.Errorf("%v, and cannot drop the badge claim: %w", cause, err)
+	}
+	return cause
+}
+
+// badgeText names and describes the badge of a campaign, or of one of its reward tiers
+func badgeText(campaign db.IndexedCampaigns, tier *db.RewardTiers) (string, string) {
+	if tier == nil {
+		return fmt.Sprintf("%s supporter", campaign.Title),
+			fmt.Sprintf("Awarded to the donors of %s.", campaign.Title)
+	}
+	return fmt.Sprintf("%s: %s", campaign.Title, tier.Title),
+		fmt.Sprintf("Awarded to the donors of %s who claimed the %s reward.", campaign.Title, tier.Title)
+}
+
+// blockRanges splits the blocks from..to, inclusive, into ranges of at most size blocks
+func blockRanges(from uint64, to uint64, size uint64) [][2]uint64 {
+	var ranges [][2]uint64
+	for start := from; start <= to; start += size {
+		end := start + size - 1
+		if end > to {
+			end = to
+		}
+		ranges = append(ranges, [2]uint64{start, end})
+	}
+	return ranges
+}
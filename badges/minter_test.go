@@ -0,0 +1,142 @@
+package badges
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"testing"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeContract struct {
+	minted  bool
+	sendErr error
+	sent    []int64
+}
+
+func (contract *fakeContract) BadgeMinted(ctx context.Context, to string, tokenID int64) (bool, error) {
+	return contract.minted, nil
+}
+
+func (contract *fakeContract) MintBadge(ctx context.Context, to string, tokenID int64, key *ecdsa.PrivateKey) (string, error) {
+	if contract.sendErr != nil {
+		return "", contract.sendErr
+	}
+	contract.sent = append(contract.sent, tokenID)
+	return "0xhash", nil
+}
+
+func TestBlockRanges(t *testing.T) {
+	require.Equal(t, [][2]uint64{{10, 19}, {20, 29}, {30, 32}}, blockRanges(10, 32, 10))
+	require.Equal(t, [][2]uint64{{5, 5}}, blockRanges(5, 5, 10))
+	require.Empty(t, blockRanges(6, 5, 10))
+}
+
+func TestBadgeText(t *testing.T) {
+	campaign := db.IndexedCampaigns{Title: "Clean Water"}
+
+	name, description := badgeText(campaign, nil)
+	require.Equal(t, "Clean Water supporter", name)
+	require.Contains(t, description, "Clean Water")
+
+	name, description = badgeText(campaign, &db.RewardTiers{Title: "Poster"})
+	require.Equal(t, "Clean Water: Poster", name)
+	require.Contains(t, description, "Poster")
+}
+
+func TestMint(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	badge := db.Badges{ID: 3}
+	donation := db.ListBadgeableDonationsRow{ID: 11, DonorAddress: "0xABC0000000000000000000000000000000000001"}
+	recipient := "0xabc0000000000000000000000000000000000001"
+	claim := db.BadgeMints{ID: 21, BadgeID: badge.ID, Recipient: recipient, DonationRecordID: donation.ID}
+
+	testCases := []struct {
+		name       string
+		contract   *fakeContract
+		buildStubs func(store *mockdb.MockStore)
+		check      func(t *testing.T, contract *fakeContract, err error)
+	}{
+		{
+			name:     "OK",
+			contract: &fakeContract{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ClaimBadgeMint(gomock.Any(), gomock.Eq(db.ClaimBadgeMintParams{BadgeID: badge.ID, Recipient: recipient, DonationRecordID: donation.ID})).
+					Times(1).
+					Return(claim, nil)
+				store.EXPECT().
+					MarkBadgeMintSent(gomock.Any(), gomock.Eq(db.MarkBadgeMintSentParams{ID: claim.ID, TxHash: sql.NullString{String: "0xhash", Valid: true}})).
+					Times(1).
+					Return(claim, nil)
+			},
+			check: func(t *testing.T, contract *fakeContract, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []int64{badge.ID}, contract.sent)
+			},
+		},
+		{
+			name:     "AlreadyClaimed",
+			contract: &fakeContract{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimBadgeMint(gomock.Any(), gomock.Any()).Times(1).Return(db.BadgeMints{}, sql.ErrNoRows)
+				store.EXPECT().MarkBadgeMintSent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, contract *fakeContract, err error) {
+				require.NoError(t, err)
+				require.Empty(t, contract.sent)
+			},
+		},
+		{
+			name:     "AlreadyMinted",
+			contract: &fakeContract{minted: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimBadgeMint(gomock.Any(), gomock.Any()).Times(1).Return(claim, nil)
+				store.EXPECT().
+					ConfirmBadgeMint(gomock.Any(), gomock.Eq(db.ConfirmBadgeMintParams{BadgeID: badge.ID, Recipient: recipient})).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().MarkBadgeMintSent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, contract *fakeContract, err error) {
+				require.NoError(t, err)
+				require.Empty(t, contract.sent)
+			},
+		},
+		{
+			name:     "SendError",
+			contract: &fakeContract{sendErr: errors.New("insufficient funds")},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimBadgeMint(gomock.Any(), gomock.Any()).Times(1).Return(claim, nil)
+				store.EXPECT().DeleteBadgeMint(gomock.Any(), gomock.Eq(claim.ID)).Times(1).Return(nil)
+				store.EXPECT().MarkBadgeMintSent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, contract *fakeContract, err error) {
+				require.EqualError(t, err, "insufficient funds")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			minter := &Minter{store: store, key: key}
+			err := minter.mint(context.Background(), zerolog.Nop(), tc.contract, badge, donation)
+			tc.check(t, tc.contract, err)
+		})
+	}
+}
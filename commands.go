@@ -0,0 +1,529 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/demola234/defiraise/api"
+	"github.com/demola234/defiraise/badges"
+	"github.com/demola234/defiraise/db/migrate"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/indexer"
+	"github.com/demola234/defiraise/matching"
+	"github.com/demola234/defiraise/onramp"
+	"github.com/demola234/defiraise/recurring"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, configs utils.Config, args []string) error
+}
+
+var commands = []command{
+	{name: "serve", summary: "Serve the HTTP API", run: runServe},
+	{name: "index", summary: "Run the campaign, donation and price indexer", run: runIndex},
+	{name: "deploy", summary: "Deploy the campaign contract and record its address", run: runDeploy},
+	{name: "verify-deployment", summary: "Check the campaign contract is live on each chain", run: runVerifyDeployment},
+	{name: "check-abi", summary: "Compare the contract binding with the deployed contracts and build/", run: runCheckABI},
+	{name: "migrate-db", summary: "Apply or revert database migrations", run: runMigrateDB},
+	{name: "recurring", summary: "Run the recurring donation worker", run: runRecurring},
+	{name: "match", summary: "Run the sponsor matching pool matcher", run: runMatch},
+	{name: "badges", summary: "Run the supporter badge minter", run: runBadges},
+	{name: "settle", summary: "Close ended proposals, pay out or refund campaigns past their deadline and release approved milestones", run: runSettle},
+}
+
+func findCommand(name string) (command, bool) {
+	if name == "" {
+		return command{name: "defiraise", run: runAll}, true
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func openStore(configs utils.Config) (db.Store, error) {
+	conn, err := sql.Open(configs.DBDriver, configs.DBSource)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.NewStore(conn), nil
+}
+
+// loadChains loads the configured chains, adds the contract versions recorded by deploy and checks
+// the deployed contracts implement the binding, as ABI_CHECK selects
+func loadChains(ctx context.Context, configs utils.Config, store db.Store) (*defi.ChainRegistry, error) {
+	chains, err := loadDeployedChains(ctx, configs, store)
+	if err != nil {
+		return nil, err
+	}
+	if err := chains.RequireContracts(); err != nil {
+		return nil, err
+	}
+
+	return chains, defi.CheckABIs(ctx, configs.ABICheck, chains)
+}
+
+func loadDeployedChains(ctx context.Context, configs utils.Config, store db.Store) (*defi.ChainRegistry, error) {
+	chains, err := defi.LoadChainRegistry(configs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load chains: %w", err)
+	}
+
+	deployments, err := store.ListContractDeployments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list contract deployments: %w", err)
+	}
+
+	for _, deployment := range deployments {
+		err := chains.AddContract(deployment.ChainID, defi.ContractVersion{
+			Version: int(deployment.Version),
+			Address: deployment.Address,
+			Proxy:   deployment.Proxy,
+		})
+		if errors.Is(err, defi.ErrUnknownChain) {
+			log.Warn().Int64("chain_id", deployment.ChainID).Msg("contract deployed to a chain that is not configured")
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return chains, nil
+}
+
+func runAll(ctx context.Context, configs utils.Config, args []string) error {
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+	go chains.StartHealthChecks(ctx)
+
+	go func() {
+		if err := startIndexer(ctx, configs, store, chains); err != nil {
+			log.Fatal().Err(err).Msg("indexer failed")
+		}
+	}()
+	go recurring.NewWorker(store, chains, configs, nil).Start(ctx)
+	go matching.NewMatcher(store, chains, configs).Start(ctx)
+	if minter, err := badges.NewMinter(store, chains, configs); err != nil {
+		log.Warn().Err(err).Msg("not minting supporter badges")
+	} else {
+		go minter.Start(ctx)
+	}
+
+	return startServer(ctx, configs, store, chains)
+}
+
+func runServe(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	address := flags.String("addr", configs.HTTPServerAddress, "address to listen on")
+	flags.Parse(args)
+	configs.HTTPServerAddress = *address
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+	go chains.StartHealthChecks(ctx)
+
+	return startServer(ctx, configs, store, chains)
+}
+
+func runIndex(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("index", flag.ExitOnError)
+	flags.Parse(args)
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+	go chains.StartHealthChecks(ctx)
+
+	return startIndexer(ctx, configs, store, chains)
+}
+
+func runRecurring(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("recurring", flag.ExitOnError)
+	once := flags.Bool("once", false, "run the due donations once and exit")
+	flags.Parse(args)
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+
+	worker := recurring.NewWorker(store, chains, configs, nil)
+	if *once {
+		return worker.RunDue(ctx)
+	}
+
+	go chains.StartHealthChecks(ctx)
+	worker.Start(ctx)
+	return nil
+}
+
+func runMatch(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("match", flag.ExitOnError)
+	once := flags.Bool("once", false, "match the pending donations once and exit")
+	flags.Parse(args)
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+
+	matcher := matching.NewMatcher(store, chains, configs)
+	if *once {
+		return matcher.RunOnce(ctx)
+	}
+
+	go chains.StartHealthChecks(ctx)
+	matcher.Start(ctx)
+	return nil
+}
+
+func runBadges(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("badges", flag.ExitOnError)
+	once := flags.Bool("once", false, "mint the pending badges once and exit")
+	flags.Parse(args)
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+
+	minter, err := badges.NewMinter(store, chains, configs)
+	if err != nil {
+		return err
+	}
+	if *once {
+		return minter.RunOnce(ctx)
+	}
+
+	go chains.StartHealthChecks(ctx)
+	minter.Start(ctx)
+	return nil
+}
+
+func startServer(ctx context.Context, configs utils.Config, store db.Store, chains *defi.ChainRegistry) error {
+	server, err := api.NewServer(configs, store, chains)
+	if err != nil {
+		return fmt.Errorf("cannot create server: %w", err)
+	}
+
+	// the purchases whose webhooks the server receives are donated once they are delivered
+	if ramp, err := onramp.NewService(store, chains, configs); err == nil {
+		go ramp.Start(ctx)
+	}
+
+	return server.Start(configs.HTTPServerAddress)
+}
+
+func startIndexer(ctx context.Context, configs utils.Config, store db.Store, chains *defi.ChainRegistry) error {
+	prices, err := defi.NewPriceProvider(configs, chains)
+	if err != nil {
+		return fmt.Errorf("cannot create price provider: %w", err)
+	}
+
+	campaignIndexer := indexer.NewIndexer(store, chains, configs.IndexerInterval, configs.PriceInterval, prices)
+	go campaignIndexer.StartPriceRecorder(ctx)
+	campaignIndexer.Start(ctx)
+	return nil
+}
+
+func runDeploy(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("deploy", flag.ExitOnError)
+	chainID := flags.Int64("chain", 0, "chain to deploy to (default: DEFAULT_CHAIN_ID)")
+	proxy := flags.String("proxy", "", "record this upgradeable proxy as the next contract version instead of deploying")
+	flags.Parse(args)
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	// the chain may not have a contract yet, so contracts are not required
+	chains, err := loadDeployedChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+	chain, err := chains.Get(*chainID)
+	if err != nil {
+		return err
+	}
+
+	contract := defi.ContractVersion{Version: chain.CurrentVersion() + 1, Address: *proxy, Proxy: *proxy != ""}
+	deployment := &defi.Deployment{ChainID: chain.ID, Address: *proxy}
+	if contract.Proxy {
+		if err := chains.AddContract(chain.ID, contract); err != nil {
+			return err
+		}
+		if err := chain.VerifyDeployment(ctx); err != nil {
+			return err
+		}
+	} else {
+		key, err := crypto.HexToECDSA(configs.DeployKey)
+		if err != nil {
+			return fmt.Errorf("invalid DEPLOY_PRIVATE_KEY: %w", err)
+		}
+
+		log.Info().Int64("chain_id", chain.ID).Str("chain", chain.Name).Int("version", contract.Version).Msg("deploying campaign contract")
+		deployment, err = chain.Deploy(ctx, key)
+		if err != nil {
+			return err
+		}
+		contract.Address = deployment.Address
+	}
+
+	_, err = store.CreateContractDeployment(ctx, db.CreateContractDeploymentParams{
+		ChainID:     chain.ID,
+		Version:     int32(contract.Version),
+		Address:     contract.Address,
+		Proxy:       contract.Proxy,
+		TxHash:      deployment.TxHash,
+		BlockNumber: int64(deployment.BlockNumber),
+		Deployer:    deployment.Deployer,
+	})
+	if err != nil {
+		return fmt.Errorf("contract %s not recorded: %w", contract.Address, err)
+	}
+
+	log.Info().Int64("chain_id", chain.ID).Int("version", contract.Version).Str("address", contract.Address).
+		Bool("proxy", contract.Proxy).Str("tx_hash", deployment.TxHash).Msg("campaign contract recorded")
+	return nil
+}
+
+func runVerifyDeployment(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("verify-deployment", flag.ExitOnError)
+	chainID := flags.Int64("chain", 0, "chain to verify, at every contract version (default: every chain)")
+	flags.Parse(args)
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+
+	targets := chains.All()
+	if *chainID != 0 {
+		chain, err := chains.Get(*chainID)
+		if err != nil {
+			return err
+		}
+		targets = []*defi.Chain{chain}
+	}
+
+	failed, total := 0, 0
+	for _, chain := range targets {
+		for _, version := range chain.Versions() {
+			total++
+			logger := log.With().Int64("chain_id", version.ID).Int("version", version.Version).Str("address", version.ContractAddress).Logger()
+			if err := version.VerifyDeployment(ctx); err != nil {
+				logger.Error().Err(err).Msg("deployment not verified")
+				failed++
+				continue
+			}
+			logger.Info().Msg("deployment verified")
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d contracts failed verification", failed, total)
+	}
+	return nil
+}
+
+func runCheckABI(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("check-abi", flag.ExitOnError)
+	chainID := flags.Int64("chain", 0, "chain to check, at every contract version (default: every chain)")
+	abiFile := flags.String("abi", "build/CrowdFunding.abi", "compiled ABI to compare with the binding, empty to skip")
+	flags.Parse(args)
+
+	bound, err := defi.BoundABI()
+	if err != nil {
+		return err
+	}
+
+	// the compiled ABI drifting from the binding is reported, but only deployed contracts fail the check
+	if *abiFile != "" {
+		data, err := os.ReadFile(*abiFile)
+		if err != nil {
+			return err
+		}
+		built, err := abi.JSON(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("cannot read %s: %w", *abiFile, err)
+		}
+
+		onlyBound, onlyBuilt := defi.CompareABI(*bound, built)
+		if len(onlyBound) > 0 || len(onlyBuilt) > 0 {
+			log.Warn().Str("abi", *abiFile).Strs("only_binding", onlyBound).Strs("only_abi", onlyBuilt).Msg("binding differs from the compiled ABI, regenerate gen/")
+		} else {
+			log.Info().Str("abi", *abiFile).Msg("binding matches the compiled ABI")
+		}
+	}
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadDeployedChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+	if err := chains.RequireContracts(); err != nil {
+		return err
+	}
+
+	targets := chains.All()
+	if *chainID != 0 {
+		chain, err := chains.Get(*chainID)
+		if err != nil {
+			return err
+		}
+		targets = []*defi.Chain{chain}
+	}
+
+	failed, total := 0, 0
+	for _, chain := range targets {
+		for _, version := range chain.Versions() {
+			total++
+			logger := log.With().Int64("chain_id", version.ID).Int("version", version.Version).Str("address", version.ContractAddress).Logger()
+
+			drift, err := version.CheckABI(ctx)
+			if err != nil {
+				logger.Error().Err(err).Msg("contract not checked")
+				failed++
+				continue
+			}
+			if len(drift.Missing) > 0 {
+				logger.Error().Str("code", drift.Code).Strs("missing", drift.Missing).Msg("contract is missing methods of the binding")
+				failed++
+				continue
+			}
+			logger.Info().Str("code", drift.Code).Msg("contract implements the binding")
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d contracts failed the ABI check", failed, total)
+	}
+	return nil
+}
+
+func runMigrateDB(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("migrate-db", flag.ExitOnError)
+	dir := flags.String("dir", "db/migration", "directory of migration files")
+	down := flags.Int("down", 0, "revert this many migrations instead of applying new ones")
+	flags.Parse(args)
+
+	conn, err := sql.Open(configs.DBDriver, configs.DBSource)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if *down > 0 {
+		reverted, err := migrate.Down(ctx, conn, *dir, *down)
+		for _, migration := range reverted {
+			log.Info().Uint64("version", migration.Version).Str("name", migration.Name).Msg("migration reverted")
+		}
+		return err
+	}
+
+	applied, err := migrate.Up(ctx, conn, *dir)
+	for _, migration := range applied {
+		log.Info().Uint64("version", migration.Version).Str("name", migration.Name).Msg("migration applied")
+	}
+	if err == nil && len(applied) == 0 {
+		log.Info().Msg("database is up to date")
+	}
+	return err
+}
+
+func runSettle(ctx context.Context, configs utils.Config, args []string) error {
+	flags := flag.NewFlagSet("settle", flag.ExitOnError)
+	chainID := flags.Int64("chain", 0, "chain to settle (default: every chain)")
+	dryRun := flags.Bool("dry-run", false, "log the settlements without sending transactions")
+	flags.Parse(args)
+
+	store, err := openStore(configs)
+	if err != nil {
+		return err
+	}
+
+	chains, err := loadChains(ctx, configs, store)
+	if err != nil {
+		return err
+	}
+
+	targets := chains.All()
+	if *chainID != 0 {
+		chain, err := chains.Get(*chainID)
+		if err != nil {
+			return err
+		}
+		targets = []*defi.Chain{chain}
+	}
+
+	for _, chain := range targets {
+		for _, version := range chain.Versions() {
+			if err := settle(ctx, configs, store, version, *dryRun); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: SEE LICENSE IN LICENSE
+pragma solidity ^0.8.0;
+
+interface IERC1155Receiver {
+    function onERC1155Received(
+        address operator,
+        address from,
+        uint256 id,
+        uint256 value,
+        bytes calldata data
+    ) external returns (bytes4);
+
+    function onERC1155BatchReceived(
+        address operator,
+        address from,
+        uint256[] calldata ids,
+        uint256[] calldata values,
+        bytes calldata data
+    ) external returns (bytes4);
+}
+
+// SupporterBadges is an ERC-1155 collection of donor badges, one token ID per campaign or reward tier.
+// Only the minter, the platform's badge wallet, mints, and an address gets each badge at most once.
+contract SupporterBadges {
+    address public minter;
+    string private baseURI;
+
+    mapping(uint256 => mapping(address => uint256)) private balances;
+    mapping(address => mapping(address => bool)) private operatorApprovals;
+    mapping(uint256 => mapping(address => bool)) public minted;
+
+    event TransferSingle(
+        address indexed operator,
+        address indexed from,
+        address indexed to,
+        uint256 id,
+        uint256 value
+    );
+    event TransferBatch(
+        address indexed operator,
+        address indexed from,
+        address indexed to,
+        uint256[] ids,
+        uint256[] values
+    );
+    event ApprovalForAll(
+        address indexed account,
+        address indexed operator,
+        bool approved
+    );
+    event URI(string value, uint256 indexed id);
+    event BadgeMinted(address indexed to, uint256 indexed id);
+
+    modifier onlyMinter() {
+        require(msg.sender == minter, "Not minter");
+        _;
+    }
+
+    constructor(string memory _baseURI) {
+        minter = msg.sender;
+        baseURI = _baseURI;
+    }
+
+    function setMinter(address _minter) public onlyMinter {
+        require(_minter != address(0), "Invalid minter");
+        minter = _minter;
+    }
+
+    function setBaseURI(string memory _baseURI) public onlyMinter {
+        baseURI = _baseURI;
+    }
+
+    // uri points at the API's metadata for the badge, the base URI followed by the decimal token ID
+    function uri(uint256 _id) public view returns (string memory) {
+        return string(abi.encodePacked(baseURI, toString(_id)));
+    }
+
+    function mint(address _to, uint256 _id) public onlyMinter {
+        require(_to != address(0), "Invalid recipient");
+        require(!minted[_id][_to], "Badge already minted");
+
+        minted[_id][_to] = true;
+        balances[_id][_to] += 1;
+
+        emit TransferSingle(msg.sender, address(0), _to, _id, 1);
+        emit BadgeMinted(_to, _id);
+
+        checkReceived(msg.sender, address(0), _to, _id, 1, "");
+    }
+
+    function balanceOf(address _account, uint256 _id)
+        public
+        view
+        returns (uint256)
+    {
+        require(_account != address(0), "Invalid account");
+        return balances[_id][_account];
+    }
+
+    function balanceOfBatch(address[] memory _accounts, uint256[] memory _ids)
+        public
+        view
+        returns (uint256[] memory)
+    {
+        require(_accounts.length == _ids.length, "Length mismatch");
+
+        uint256[] memory result = new uint256[](_accounts.length);
+        for (uint256 i = 0; i < _accounts.length; i++) {
+            result[i] = balanceOf(_accounts[i], _ids[i]);
+        }
+        return result;
+    }
+
+    function setApprovalForAll(address _operator, bool _approved) public {
+        require(_operator != msg.sender, "Cannot approve self");
+        operatorApprovals[msg.sender][_operator] = _approved;
+        emit ApprovalForAll(msg.sender, _operator, _approved);
+    }
+
+    function isApprovedForAll(address _account, address _operator)
+        public
+        view
+        returns (bool)
+    {
+        return operatorApprovals[_account][_operator];
+    }
+
+    function safeTransferFrom(
+        address _from,
+        address _to,
+        uint256 _id,
+        uint256 _value,
+        bytes memory _data
+    ) public {
+        require(
+            _from == msg.sender || operatorApprovals[_from][msg.sender],
+            "Not owner nor approved"
+        );
+        require(_to != address(0), "Invalid recipient");
+        require(balances[_id][_from] >= _value, "Insufficient balance");
+
+        balances[_id][_from] -= _value;
+        balances[_id][_to] += _value;
+
+        emit TransferSingle(msg.sender, _from, _to, _id, _value);
+
+        checkReceived(msg.sender, _from, _to, _id, _value, _data);
+    }
+
+    function safeBatchTransferFrom(
+        address _from,
+        address _to,
+        uint256[] memory _ids,
+        uint256[] memory _values,
+        bytes memory _data
+    ) public {
+        require(
+            _from == msg.sender || operatorApprovals[_from][msg.sender],
+            "Not owner nor approved"
+        );
+        require(_to != address(0), "Invalid recipient");
+        require(_ids.length == _values.length, "Length mismatch");
+
+        for (uint256 i = 0; i < _ids.length; i++) {
+            require(
+                balances[_ids[i]][_from] >= _values[i],
+                "Insufficient balance"
+            );
+            balances[_ids[i]][_from] -= _values[i];
+            balances[_ids[i]][_to] += _values[i];
+        }
+
+        emit TransferBatch(msg.sender, _from, _to, _ids, _values);
+
+        if (_to.code.length > 0) {
+            require(
+                IERC1155Receiver(_to).onERC1155BatchReceived(
+                    msg.sender,
+                    _from,
+                    _ids,
+                    _values,
+                    _data
+                ) == IERC1155Receiver.onERC1155BatchReceived.selector,
+                "Transfer rejected"
+            );
+        }
+    }
+
+    function supportsInterface(bytes4 _interfaceId) public pure returns (bool) {
+        return
+            _interfaceId == 0x01ffc9a7 || // ERC-165
+            _interfaceId == 0xd9b67a26 || // ERC-1155
+            _interfaceId == 0x0e89341c; // ERC-1155 metadata URI
+    }
+
+    function checkReceived(
+        address _operator,
+        address _from,
+        address _to,
+        uint256 _id,
+        uint256 _value,
+        bytes memory _data
+    ) private {
+        if (_to.code.length > 0) {
+            require(
+                IERC1155Receiver(_to).onERC1155Received(
+                    _operator,
+                    _from,
+                    _id,
+                    _value,
+                    _data
+                ) == IERC1155Receiver.onERC1155Received.selector,
+                "Transfer rejected"
+            );
+        }
+    }
+
+    function toString(uint256 _value) private pure returns (string memory) {
+        if (_value == 0) {
+            return "0";
+        }
+
+        uint256 digits;
+        for (uint256 temp = _value; temp != 0; temp /= 10) {
+            digits++;
+        }
+
+        bytes memory buffer = new bytes(digits);
+        while (_value != 0) {
+            digits--;
+            buffer[digits] = bytes1(uint8(48 + (_value % 10)));
+            _value /= 10;
+        }
+        return string(buffer);
+    }
+}
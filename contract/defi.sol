@@ -87,6 +87,24 @@ contract CrowdFunding {
         campaign.totalFundsPerToken[_token] += _amount;
     }
 
+    function extendDeadline(
+        uint256 _campaignId,
+        uint256 _newDeadline
+    ) external onlyOwner(_campaignId) campaignActive(_campaignId) {
+        require(
+            _newDeadline > campaigns[_campaignId].deadline,
+            "Deadline can only move later"
+        );
+
+        campaigns[_campaignId].deadline = _newDeadline;
+    }
+
+    function closeCampaign(
+        uint256 _campaignId
+    ) external onlyOwner(_campaignId) campaignActive(_campaignId) {
+        campaigns[_campaignId].deadline = block.timestamp - 1;
+    }
+
     function isTokenSupported(
         uint256 _campaignId,
         address _token
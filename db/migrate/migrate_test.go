@@ -0,0 +1,39 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load("../migration")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	for i, migration := range migrations {
+		require.Equal(t, uint64(i+1), migration.Version)
+		require.NotEmpty(t, migration.Up)
+		require.NotEmpty(t, migration.Down)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "000001_schema.down.sql"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), nil, 0o600))
+
+	_, err := Load(dir)
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "000001_schema.up.sql"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "000002_users.up.sql"), nil, 0o600))
+
+	migrations, err := Load(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	require.Equal(t, "users", migrations[1].Name)
+	require.Empty(t, migrations[1].Down)
+}
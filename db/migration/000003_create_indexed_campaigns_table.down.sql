@@ -0,0 +1,2 @@
+-- Drop the indexed campaigns table
+DROP TABLE IF EXISTS indexed_campaigns;
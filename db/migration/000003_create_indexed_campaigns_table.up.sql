@@ -0,0 +1,29 @@
+-- Trigram matching lets partial titles ("educ") find campaigns
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Off-chain copy of the contract's campaigns, kept in sync by the indexer
+CREATE TABLE indexed_campaigns (
+    campaign_id BIGINT PRIMARY KEY,
+    owner VARCHAR NOT NULL,
+    title VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    category VARCHAR NOT NULL,
+    image VARCHAR NOT NULL,
+    goal NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    total_funds NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    total_contributors BIGINT NOT NULL DEFAULT 0,
+    deadline TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX indexed_campaigns_search_idx ON indexed_campaigns
+USING GIN (to_tsvector('english', title || ' ' || description || ' ' || category));
+
+CREATE INDEX indexed_campaigns_title_trgm_idx ON indexed_campaigns USING GIN (title gin_trgm_ops);
+
+CREATE INDEX indexed_campaigns_deadline_idx ON indexed_campaigns (deadline, campaign_id);
+
+CREATE INDEX indexed_campaigns_total_funds_idx ON indexed_campaigns (total_funds, campaign_id);
+
+CREATE INDEX indexed_campaigns_owner_idx ON indexed_campaigns (owner);
@@ -0,0 +1,2 @@
+-- Drop the donation records table
+DROP TABLE IF EXISTS donation_records;
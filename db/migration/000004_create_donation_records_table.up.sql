@@ -0,0 +1,21 @@
+-- Every donation made to the contract. Rows are written when the API sends a
+-- donation (with its tx hash) and reconciled by the indexer against the
+-- contract's per-campaign donor list (donor_index is the position in that list).
+CREATE TABLE donation_records (
+    id BIGSERIAL PRIMARY KEY,
+    campaign_id BIGINT NOT NULL,
+    donor_address VARCHAR NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    token VARCHAR NOT NULL DEFAULT 'ETH',
+    tx_hash VARCHAR UNIQUE,
+    block_number BIGINT,
+    donor_index INT,
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'failed')),
+    donated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (campaign_id, donor_index)
+);
+
+CREATE INDEX donation_records_donor_idx ON donation_records (lower(donor_address), id);
+
+CREATE INDEX donation_records_pending_idx ON donation_records (status) WHERE status = 'pending';
@@ -0,0 +1,3 @@
+-- Drop the fiat columns from donation records
+ALTER TABLE donation_records DROP COLUMN IF EXISTS fiat_currency;
+ALTER TABLE donation_records DROP COLUMN IF EXISTS fiat_amount;
@@ -0,0 +1,3 @@
+-- Fiat value of a donation at the time it was made, used on receipts and statements
+ALTER TABLE donation_records ADD COLUMN fiat_amount NUMERIC(30, 2);
+ALTER TABLE donation_records ADD COLUMN fiat_currency VARCHAR NOT NULL DEFAULT 'USD';
@@ -0,0 +1,2 @@
+-- Drop the price history table
+DROP TABLE IF EXISTS price_history;
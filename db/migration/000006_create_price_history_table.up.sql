@@ -0,0 +1,11 @@
+-- Periodic token/fiat price samples, used to value donations at their block time
+CREATE TABLE price_history (
+    id BIGSERIAL PRIMARY KEY,
+    token VARCHAR NOT NULL,
+    currency VARCHAR NOT NULL,
+    price NUMERIC(30, 8) NOT NULL,
+    source VARCHAR NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX price_history_lookup_idx ON price_history (token, currency, recorded_at);
@@ -0,0 +1,4 @@
+-- Drop the display currencies
+DROP TABLE IF EXISTS campaign_currencies;
+
+ALTER TABLE users DROP COLUMN IF EXISTS preferred_currency;
@@ -0,0 +1,9 @@
+-- Fiat currency a user sees amounts in unless a request asks for another
+ALTER TABLE users ADD COLUMN preferred_currency VARCHAR NOT NULL DEFAULT 'USD';
+
+-- Fiat currency a campaign's goal is displayed in, chosen by its owner
+CREATE TABLE campaign_currencies (
+    campaign_id BIGINT PRIMARY KEY,
+    currency VARCHAR NOT NULL DEFAULT 'USD',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
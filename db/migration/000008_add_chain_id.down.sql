@@ -0,0 +1,15 @@
+-- Drop the chain tags, keeping only the default chain's rows
+DELETE FROM campaign_currencies WHERE chain_id <> 11155111;
+ALTER TABLE campaign_currencies DROP CONSTRAINT campaign_currencies_pkey;
+ALTER TABLE campaign_currencies DROP COLUMN IF EXISTS chain_id;
+ALTER TABLE campaign_currencies ADD PRIMARY KEY (campaign_id);
+
+DELETE FROM donation_records WHERE chain_id <> 11155111;
+ALTER TABLE donation_records DROP CONSTRAINT donation_records_chain_campaign_donor_index_key;
+ALTER TABLE donation_records DROP COLUMN IF EXISTS chain_id;
+ALTER TABLE donation_records ADD CONSTRAINT donation_records_campaign_id_donor_index_key UNIQUE (campaign_id, donor_index);
+
+DELETE FROM indexed_campaigns WHERE chain_id <> 11155111;
+ALTER TABLE indexed_campaigns DROP CONSTRAINT indexed_campaigns_pkey;
+ALTER TABLE indexed_campaigns DROP COLUMN IF EXISTS chain_id;
+ALTER TABLE indexed_campaigns ADD PRIMARY KEY (campaign_id);
@@ -0,0 +1,13 @@
+-- Campaigns and donations are tagged with the chain their contract lives on.
+-- Rows written before multi-chain support belong to Sepolia.
+ALTER TABLE indexed_campaigns ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 11155111;
+ALTER TABLE indexed_campaigns DROP CONSTRAINT indexed_campaigns_pkey;
+ALTER TABLE indexed_campaigns ADD PRIMARY KEY (chain_id, campaign_id);
+
+ALTER TABLE donation_records ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 11155111;
+ALTER TABLE donation_records DROP CONSTRAINT donation_records_campaign_id_donor_index_key;
+ALTER TABLE donation_records ADD CONSTRAINT donation_records_chain_campaign_donor_index_key UNIQUE (chain_id, campaign_id, donor_index);
+
+ALTER TABLE campaign_currencies ADD COLUMN chain_id BIGINT NOT NULL DEFAULT 11155111;
+ALTER TABLE campaign_currencies DROP CONSTRAINT campaign_currencies_pkey;
+ALTER TABLE campaign_currencies ADD PRIMARY KEY (chain_id, campaign_id);
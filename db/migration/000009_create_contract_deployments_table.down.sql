@@ -0,0 +1,2 @@
+-- Drop the contract deployments table
+DROP TABLE IF EXISTS contract_deployments;
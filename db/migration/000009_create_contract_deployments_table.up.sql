@@ -0,0 +1,12 @@
+-- Campaign contracts deployed with `defiraise deploy`; the latest row per chain is the live contract
+CREATE TABLE contract_deployments (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id BIGINT NOT NULL,
+    address VARCHAR NOT NULL,
+    tx_hash VARCHAR NOT NULL,
+    block_number BIGINT NOT NULL,
+    deployer VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX contract_deployments_chain_idx ON contract_deployments (chain_id, id DESC);
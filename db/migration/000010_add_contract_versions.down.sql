@@ -0,0 +1,19 @@
+-- Drop the contract versions, keeping only the first contract's rows
+DELETE FROM campaign_currencies WHERE contract_version <> 1;
+ALTER TABLE campaign_currencies DROP CONSTRAINT campaign_currencies_pkey;
+ALTER TABLE campaign_currencies DROP COLUMN IF EXISTS contract_version;
+ALTER TABLE campaign_currencies ADD PRIMARY KEY (chain_id, campaign_id);
+
+DELETE FROM donation_records WHERE contract_version <> 1;
+ALTER TABLE donation_records DROP CONSTRAINT donation_records_contract_campaign_donor_index_key;
+ALTER TABLE donation_records DROP COLUMN IF EXISTS contract_version;
+ALTER TABLE donation_records ADD CONSTRAINT donation_records_chain_campaign_donor_index_key UNIQUE (chain_id, campaign_id, donor_index);
+
+DELETE FROM indexed_campaigns WHERE contract_version <> 1;
+ALTER TABLE indexed_campaigns DROP CONSTRAINT indexed_campaigns_pkey;
+ALTER TABLE indexed_campaigns DROP COLUMN IF EXISTS contract_version;
+ALTER TABLE indexed_campaigns ADD PRIMARY KEY (chain_id, campaign_id);
+
+ALTER TABLE contract_deployments DROP CONSTRAINT contract_deployments_chain_version_key;
+ALTER TABLE contract_deployments DROP COLUMN IF EXISTS proxy;
+ALTER TABLE contract_deployments DROP COLUMN IF EXISTS version;
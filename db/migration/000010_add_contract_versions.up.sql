@@ -0,0 +1,21 @@
+-- Each chain can have several campaign contracts. Versions count up per chain from the configured
+-- contract, version 1, so deployments recorded before versioning follow it in order.
+ALTER TABLE contract_deployments ADD COLUMN version INT NOT NULL DEFAULT 1;
+ALTER TABLE contract_deployments ADD COLUMN proxy BOOLEAN NOT NULL DEFAULT false;
+UPDATE contract_deployments d SET version = v.version
+FROM (SELECT id, row_number() OVER (PARTITION BY chain_id ORDER BY id) + 1 AS version FROM contract_deployments) v
+WHERE d.id = v.id;
+ALTER TABLE contract_deployments ADD CONSTRAINT contract_deployments_chain_version_key UNIQUE (chain_id, version);
+
+-- Campaign IDs are only unique within a contract, so campaigns and donations are tagged with its version
+ALTER TABLE indexed_campaigns ADD COLUMN contract_version INT NOT NULL DEFAULT 1;
+ALTER TABLE indexed_campaigns DROP CONSTRAINT indexed_campaigns_pkey;
+ALTER TABLE indexed_campaigns ADD PRIMARY KEY (chain_id, contract_version, campaign_id);
+
+ALTER TABLE donation_records ADD COLUMN contract_version INT NOT NULL DEFAULT 1;
+ALTER TABLE donation_records DROP CONSTRAINT donation_records_chain_campaign_donor_index_key;
+ALTER TABLE donation_records ADD CONSTRAINT donation_records_contract_campaign_donor_index_key UNIQUE (chain_id, contract_version, campaign_id, donor_index);
+
+ALTER TABLE campaign_currencies ADD COLUMN contract_version INT NOT NULL DEFAULT 1;
+ALTER TABLE campaign_currencies DROP CONSTRAINT campaign_currencies_pkey;
+ALTER TABLE campaign_currencies ADD PRIMARY KEY (chain_id, contract_version, campaign_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS campaign_escrows;
+DROP TABLE IF EXISTS milestone_votes;
+DROP TABLE IF EXISTS campaign_milestones;
@@ -0,0 +1,48 @@
+-- Milestones split a campaign's funds into stages. The funds of a campaign with milestones are paid
+-- into the platform escrow when it ends funded, and each milestone is released to the owner from the
+-- escrow once the owner submits evidence and donors or a moderator approve it.
+CREATE TABLE campaign_milestones (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    title VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL CHECK (amount > 0),
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'submitted', 'approved', 'rejected', 'released')),
+    evidence VARCHAR NOT NULL DEFAULT '',
+    evidence_url VARCHAR NOT NULL DEFAULT '',
+    submitted_at TIMESTAMPTZ,
+    approved_by VARCHAR NOT NULL DEFAULT '',
+    approved_at TIMESTAMPTZ,
+    release_tx_hash VARCHAR,
+    released_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (chain_id, contract_version, campaign_id, position)
+);
+
+CREATE INDEX campaign_milestones_approved_idx ON campaign_milestones (chain_id, contract_version) WHERE status = 'approved';
+
+-- Donor votes on a submitted milestone, weighted by the voter's donations to the campaign
+CREATE TABLE milestone_votes (
+    milestone_id BIGINT NOT NULL REFERENCES campaign_milestones (id) ON DELETE CASCADE,
+    voter_address VARCHAR NOT NULL,
+    approve BOOLEAN NOT NULL,
+    weight NUMERIC(78, 0) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (milestone_id, voter_address)
+);
+
+-- Funds of a milestone campaign held by the platform escrow: paid out of the contract to the owner's
+-- wallet, then deposited from there into the escrow
+CREATE TABLE campaign_escrows (
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    payout_tx_hash VARCHAR NOT NULL,
+    deposit_tx_hash VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (chain_id, contract_version, campaign_id)
+);
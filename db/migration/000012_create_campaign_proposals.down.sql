@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS proposal_votes;
+DROP TABLE IF EXISTS campaign_proposals;
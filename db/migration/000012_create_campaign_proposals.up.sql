@@ -0,0 +1,38 @@
+-- Proposals put a change to a campaign to a vote of its donors, weighted by their on-chain donations.
+-- A proposal passes when the votes reach its quorum, a percentage of the campaign's donations, by its
+-- deadline and more weight is for it than against it.
+CREATE TABLE campaign_proposals (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    kind VARCHAR NOT NULL CHECK (kind IN ('extend_deadline', 'release_milestone', 'change_scope')),
+    title VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    milestone_id BIGINT REFERENCES campaign_milestones (id) ON DELETE CASCADE,
+    new_deadline TIMESTAMPTZ,
+    quorum INT NOT NULL CHECK (quorum BETWEEN 1 AND 100),
+    ends_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'passed', 'failed', 'executed')),
+    for_weight NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    against_weight NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    total_weight NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    created_by VARCHAR NOT NULL,
+    closed_at TIMESTAMPTZ,
+    executed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX campaign_proposals_campaign_idx ON campaign_proposals (chain_id, contract_version, campaign_id);
+CREATE INDEX campaign_proposals_open_idx ON campaign_proposals (chain_id, contract_version, ends_at) WHERE status = 'open';
+
+-- EIP-712 signed donor votes; a donor's later vote replaces their earlier one
+CREATE TABLE proposal_votes (
+    proposal_id BIGINT NOT NULL REFERENCES campaign_proposals (id) ON DELETE CASCADE,
+    voter_address VARCHAR NOT NULL,
+    support BOOLEAN NOT NULL,
+    weight NUMERIC(78, 0) NOT NULL,
+    signature VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (proposal_id, voter_address)
+);
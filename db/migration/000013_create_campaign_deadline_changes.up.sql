@@ -0,0 +1,17 @@
+-- Deadline extensions and early closes sent to the contract on behalf of campaign owners. The first
+-- extension's previous deadline is the campaign's original deadline, which bounds later extensions.
+CREATE TABLE campaign_deadline_changes (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    kind VARCHAR NOT NULL CHECK (kind IN ('extend', 'close')),
+    previous_deadline TIMESTAMPTZ NOT NULL,
+    deadline TIMESTAMPTZ NOT NULL,
+    tx_hash VARCHAR NOT NULL,
+    proposal_id BIGINT REFERENCES campaign_proposals (id) ON DELETE SET NULL,
+    created_by VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX campaign_deadline_changes_campaign_idx ON campaign_deadline_changes (chain_id, contract_version, campaign_id, created_at);
@@ -0,0 +1,27 @@
+-- Scheduled donations a user makes to a campaign every interval from their custodial wallet, until the
+-- campaign ends, ends_at passes or max_donations have been made. failed_attempts counts the failed
+-- attempts at the current installment and resets once it is donated or skipped.
+CREATE TABLE recurring_donations (
+    id BIGSERIAL PRIMARY KEY,
+    username VARCHAR NOT NULL,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL CHECK (amount > 0),
+    token VARCHAR NOT NULL,
+    interval VARCHAR NOT NULL CHECK (interval IN ('weekly', 'monthly')),
+    ends_at TIMESTAMPTZ,
+    max_donations INT CHECK (max_donations > 0),
+    status VARCHAR NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'paused', 'cancelled', 'completed')),
+    next_run_at TIMESTAMPTZ NOT NULL,
+    donation_count INT NOT NULL DEFAULT 0,
+    failed_attempts INT NOT NULL DEFAULT 0,
+    last_tx_hash VARCHAR,
+    last_error VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (username) REFERENCES users (username) ON DELETE CASCADE
+);
+
+CREATE INDEX recurring_donations_user_idx ON recurring_donations (username, id);
+CREATE INDEX recurring_donations_due_idx ON recurring_donations (next_run_at) WHERE status = 'active';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS matching_pool_matches;
+DROP TABLE IF EXISTS matching_pools;
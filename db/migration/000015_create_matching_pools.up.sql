@@ -0,0 +1,42 @@
+-- Sponsor pools that match eligible confirmed donations from their own wallet. A pool matches
+-- ratio_percent of each donation, at most max_match per donation, until matched_total reaches cap.
+-- An empty campaign_ids and categories makes every campaign on the contract version eligible.
+CREATE TABLE matching_pools (
+    id BIGSERIAL PRIMARY KEY,
+    sponsor VARCHAR NOT NULL,
+    name VARCHAR NOT NULL,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    wallet_address VARCHAR UNIQUE NOT NULL,
+    file_path VARCHAR NOT NULL,
+    ratio_percent INT NOT NULL CHECK (ratio_percent BETWEEN 1 AND 1000),
+    cap NUMERIC(78, 0) NOT NULL CHECK (cap > 0),
+    max_match NUMERIC(78, 0),
+    min_donation NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    campaign_ids BIGINT[] NOT NULL DEFAULT '{}',
+    categories VARCHAR[] NOT NULL DEFAULT '{}',
+    ends_at TIMESTAMPTZ,
+    matched_total NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    status VARCHAR NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'exhausted', 'closed')),
+    refund_tx_hash VARCHAR,
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (sponsor) REFERENCES users (username) ON DELETE CASCADE
+);
+
+CREATE INDEX matching_pools_active_idx ON matching_pools (chain_id, contract_version) WHERE status = 'active';
+
+-- One row per donation a pool has considered: claimed as pending before the matching donation is sent,
+-- so concurrent matchers never match a donation twice, then sent, or skipped with a reason
+CREATE TABLE matching_pool_matches (
+    id BIGSERIAL PRIMARY KEY,
+    pool_id BIGINT NOT NULL REFERENCES matching_pools (id) ON DELETE CASCADE,
+    donation_record_id BIGINT NOT NULL REFERENCES donation_records (id) ON DELETE CASCADE,
+    campaign_id BIGINT NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'skipped')),
+    tx_hash VARCHAR,
+    reason VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (pool_id, donation_record_id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS funding_round_allocations;
+DROP TABLE IF EXISTS funding_round_contributions;
+DROP TABLE IF EXISTS funding_round_baselines;
+DROP TABLE IF EXISTS funding_rounds;
@@ -0,0 +1,63 @@
+-- Quadratic funding rounds. The donations a campaign receives between starts_at and ends_at decide its
+-- share of matching_amount, which is paid from the round's own wallet once a moderator approves the
+-- computed allocations. Contributions are read from the contract's donor list: a baseline is recorded
+-- when the round opens and subtracted when it is calculated.
+CREATE TABLE funding_rounds (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_ids BIGINT[] NOT NULL,
+    matching_amount NUMERIC(78, 0) NOT NULL CHECK (matching_amount > 0),
+    wallet_address VARCHAR UNIQUE NOT NULL,
+    file_path VARCHAR NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL CHECK (ends_at > starts_at),
+    require_verified BOOLEAN NOT NULL DEFAULT true,
+    min_contribution NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    status VARCHAR NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'open', 'calculated', 'approved', 'paid')),
+    created_by VARCHAR NOT NULL,
+    approved_by VARCHAR,
+    opened_at TIMESTAMPTZ,
+    calculated_at TIMESTAMPTZ,
+    approved_at TIMESTAMPTZ,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (created_by) REFERENCES users (username)
+);
+
+CREATE INDEX funding_rounds_status_idx ON funding_rounds (chain_id, contract_version, status);
+
+-- Each donor's on-chain total for a campaign when the round opened
+CREATE TABLE funding_round_baselines (
+    round_id BIGINT NOT NULL REFERENCES funding_rounds (id) ON DELETE CASCADE,
+    campaign_id BIGINT NOT NULL,
+    donor_address VARCHAR NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    PRIMARY KEY (round_id, campaign_id, donor_address)
+);
+
+-- What each unique donor gave a campaign during the round. donor is the username of a registered donor,
+-- whose wallets are counted together, or the lower-cased address of anyone else.
+CREATE TABLE funding_round_contributions (
+    round_id BIGINT NOT NULL REFERENCES funding_rounds (id) ON DELETE CASCADE,
+    campaign_id BIGINT NOT NULL,
+    donor VARCHAR NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    eligible BOOLEAN NOT NULL,
+    PRIMARY KEY (round_id, campaign_id, donor)
+);
+
+CREATE TABLE funding_round_allocations (
+    round_id BIGINT NOT NULL REFERENCES funding_rounds (id) ON DELETE CASCADE,
+    campaign_id BIGINT NOT NULL,
+    contributors INT NOT NULL,
+    excluded_contributors INT NOT NULL,
+    contributed NUMERIC(78, 0) NOT NULL,
+    match_amount NUMERIC(78, 0) NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sending', 'sent')),
+    tx_hash VARCHAR,
+    last_error VARCHAR,
+    paid_at TIMESTAMPTZ,
+    PRIMARY KEY (round_id, campaign_id)
+);
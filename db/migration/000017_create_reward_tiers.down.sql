@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reward_claims;
+DROP TABLE IF EXISTS reward_tiers;
@@ -0,0 +1,38 @@
+-- Kickstarter-style reward tiers. A donor picks a tier when donating at least its min_amount; claimed
+-- counts the tier's live claims and is reserved with a conditional update before the donation is sent,
+-- so concurrent donations cannot oversell a tier limited by quantity.
+CREATE TABLE reward_tiers (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    title VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    min_amount NUMERIC(78, 0) NOT NULL CHECK (min_amount > 0),
+    quantity INT CHECK (quantity > 0),
+    claimed INT NOT NULL DEFAULT 0 CHECK (claimed >= 0 AND (quantity IS NULL OR claimed <= quantity)),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX reward_tiers_campaign_idx ON reward_tiers (chain_id, contract_version, campaign_id);
+
+-- A donor's claim on a tier, tracked by the campaign owner until the reward is fulfilled. Cancelling a
+-- claim gives its slot back to the tier.
+CREATE TABLE reward_claims (
+    id BIGSERIAL PRIMARY KEY,
+    tier_id BIGINT NOT NULL REFERENCES reward_tiers (id),
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    username VARCHAR NOT NULL REFERENCES users (username),
+    donor_address VARCHAR NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL,
+    tx_hash VARCHAR UNIQUE NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'shipped', 'fulfilled', 'cancelled')),
+    note VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX reward_claims_campaign_idx ON reward_claims (chain_id, contract_version, campaign_id, id);
+CREATE INDEX reward_claims_username_idx ON reward_claims (username, id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS badge_sync;
+DROP TABLE IF EXISTS badge_mints;
+DROP TABLE IF EXISTS badges;
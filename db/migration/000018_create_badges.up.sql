@@ -0,0 +1,40 @@
+-- Supporter badges minted on each chain's badge contract. A badge's id is its ERC-1155 token ID, and
+-- there is one badge per campaign (tier_id 0) and one per reward tier.
+CREATE TABLE badges (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    campaign_id BIGINT NOT NULL,
+    tier_id BIGINT NOT NULL DEFAULT 0,
+    name VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    image VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (chain_id, contract_version, campaign_id, tier_id)
+);
+
+-- A badge minted, or being minted, to a donor's lower-cased address for a confirmed donation. Each
+-- address gets a badge once, as the contract enforces.
+CREATE TABLE badge_mints (
+    id BIGSERIAL PRIMARY KEY,
+    badge_id BIGINT NOT NULL REFERENCES badges (id),
+    recipient VARCHAR NOT NULL,
+    donation_record_id BIGINT NOT NULL REFERENCES donation_records (id),
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'confirmed')),
+    tx_hash VARCHAR,
+    block_number BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    minted_at TIMESTAMPTZ,
+    UNIQUE (badge_id, recipient)
+);
+
+CREATE INDEX badge_mints_recipient_idx ON badge_mints (recipient);
+CREATE INDEX badge_mints_unconfirmed_idx ON badge_mints (created_at) WHERE status <> 'confirmed';
+
+-- The last block of each badge contract whose BadgeMinted events have been read
+CREATE TABLE badge_sync (
+    chain_id BIGINT NOT NULL,
+    contract_address VARCHAR NOT NULL,
+    block_number BIGINT NOT NULL,
+    PRIMARY KEY (chain_id, contract_address)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS gas_sponsorships;
+DROP TABLE IF EXISTS gas_budgets;
@@ -0,0 +1,29 @@
+-- Each user's gas sponsorship budget on a chain. spent and grants count the top-ups since period_start
+-- and start over with the first top-up after the period has run out.
+CREATE TABLE gas_budgets (
+    username VARCHAR NOT NULL REFERENCES users (username) ON DELETE CASCADE,
+    chain_id BIGINT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL DEFAULT now(),
+    spent NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    grants INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (username, chain_id)
+);
+
+-- The ledger of gas the relayer wallet has sent to custodial wallets: one top-up per approved action,
+-- created as pending before it is sent, then sent, confirmed once mined, or failed
+CREATE TABLE gas_sponsorships (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id BIGINT NOT NULL,
+    username VARCHAR NOT NULL REFERENCES users (username) ON DELETE CASCADE,
+    address VARCHAR NOT NULL,
+    action VARCHAR NOT NULL,
+    gas_price NUMERIC(78, 0) NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL CHECK (amount > 0),
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'confirmed', 'failed')),
+    tx_hash VARCHAR,
+    action_tx_hash VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX gas_sponsorships_chain_idx ON gas_sponsorships (chain_id, created_at);
+CREATE INDEX gas_sponsorships_username_idx ON gas_sponsorships (username, created_at);
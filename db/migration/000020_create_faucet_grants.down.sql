@@ -0,0 +1 @@
+DROP TABLE IF EXISTS faucet_grants;
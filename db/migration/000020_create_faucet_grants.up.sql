@@ -0,0 +1,18 @@
+-- Onboarding grants sent from the faucet treasury to new users' custodial wallets on test networks.
+-- asset is 'native' or the lower-cased address of an ERC-20 token. A user gets each asset once per
+-- chain; a grant that failed to send can be claimed again.
+CREATE TABLE faucet_grants (
+    id BIGSERIAL PRIMARY KEY,
+    username VARCHAR NOT NULL REFERENCES users (username) ON DELETE CASCADE,
+    chain_id BIGINT NOT NULL,
+    asset VARCHAR NOT NULL,
+    address VARCHAR NOT NULL,
+    ip VARCHAR NOT NULL,
+    amount NUMERIC(78, 0) NOT NULL CHECK (amount > 0),
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    tx_hash VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (username, chain_id, asset)
+);
+
+CREATE INDEX faucet_grants_ip_idx ON faucet_grants (ip, created_at);
@@ -0,0 +1,28 @@
+-- Fiat purchases of crypto through an on-ramp provider, delivered to the buyer's custodial wallet.
+-- provider_order_id and checkout_url are set once the provider has created the order. When
+-- campaign_id is set the purchased funds are donated to that campaign once the purchase completes,
+-- and donation_status follows the donation.
+CREATE TABLE onramp_orders (
+    id BIGSERIAL PRIMARY KEY,
+    provider VARCHAR NOT NULL,
+    provider_order_id VARCHAR,
+    username VARCHAR NOT NULL REFERENCES users (username) ON DELETE CASCADE,
+    chain_id BIGINT NOT NULL,
+    contract_version INT NOT NULL,
+    address VARCHAR NOT NULL,
+    asset VARCHAR NOT NULL,
+    fiat_amount NUMERIC(30, 2) NOT NULL CHECK (fiat_amount > 0),
+    fiat_currency VARCHAR NOT NULL,
+    campaign_id BIGINT,
+    checkout_url VARCHAR NOT NULL DEFAULT '',
+    status VARCHAR NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'completed', 'failed')),
+    crypto_amount NUMERIC(78, 0),
+    tx_hash VARCHAR,
+    donation_status VARCHAR CHECK (donation_status IN ('pending', 'sent', 'failed')),
+    donation_tx_hash VARCHAR,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at TIMESTAMPTZ,
+    UNIQUE (provider, provider_order_id)
+);
+
+CREATE INDEX onramp_orders_username_idx ON onramp_orders (username, id);
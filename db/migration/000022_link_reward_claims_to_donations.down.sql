@@ -0,0 +1,2 @@
+-- Drop the donation record of reward claims
+ALTER TABLE reward_claims DROP COLUMN IF EXISTS donation_record_id;
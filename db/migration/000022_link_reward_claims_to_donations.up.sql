@@ -0,0 +1,5 @@
+-- The donation record a reward claim was made with, so the claim is cancelled and its slot given back
+-- to the tier when the donation's transaction fails
+ALTER TABLE reward_claims ADD COLUMN donation_record_id BIGINT REFERENCES donation_records (id);
+
+CREATE INDEX reward_claims_donation_record_idx ON reward_claims (donation_record_id);
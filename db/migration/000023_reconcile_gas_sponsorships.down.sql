@@ -0,0 +1,7 @@
+-- Drop the reconciled gas of top-ups
+UPDATE gas_sponsorships SET status = 'confirmed' WHERE status = 'reconciled';
+
+ALTER TABLE gas_sponsorships DROP CONSTRAINT gas_sponsorships_status_check;
+ALTER TABLE gas_sponsorships ADD CONSTRAINT gas_sponsorships_status_check CHECK (status IN ('pending', 'sent', 'confirmed', 'failed'));
+
+ALTER TABLE gas_sponsorships DROP COLUMN IF EXISTS spent;
@@ -0,0 +1,6 @@
+-- The part of a top-up the sponsored action's gas used, set once the action is mined. The rest stays in
+-- the user's wallet, where it covers the next action, and is given back to the user's budget.
+ALTER TABLE gas_sponsorships ADD COLUMN spent NUMERIC(78, 0);
+
+ALTER TABLE gas_sponsorships DROP CONSTRAINT gas_sponsorships_status_check;
+ALTER TABLE gas_sponsorships ADD CONSTRAINT gas_sponsorships_status_check CHECK (status IN ('pending', 'sent', 'confirmed', 'reconciled', 'failed'));
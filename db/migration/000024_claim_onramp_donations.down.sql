@@ -0,0 +1,5 @@
+-- Give up donations that were claimed but never recorded as sent
+UPDATE onramp_orders SET donation_status = 'failed' WHERE donation_status = 'sending';
+
+ALTER TABLE onramp_orders DROP CONSTRAINT onramp_orders_donation_status_check;
+ALTER TABLE onramp_orders ADD CONSTRAINT onramp_orders_donation_status_check CHECK (donation_status IN ('pending', 'sent', 'failed'));
@@ -0,0 +1,3 @@
+-- A completed order's donation is claimed as 'sending' before it is sent, so it is never sent twice
+ALTER TABLE onramp_orders DROP CONSTRAINT onramp_orders_donation_status_check;
+ALTER TABLE onramp_orders ADD CONSTRAINT onramp_orders_donation_status_check CHECK (donation_status IN ('pending', 'sending', 'sent', 'failed'));
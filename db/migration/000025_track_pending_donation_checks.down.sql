@@ -0,0 +1,4 @@
+-- Drop when pending donations were last checked
+DROP INDEX IF EXISTS donation_records_pending_idx;
+
+ALTER TABLE donation_records DROP COLUMN IF EXISTS checked_at;
@@ -0,0 +1,5 @@
+-- When the indexer last looked for the receipt of a pending donation. Pending donations are checked
+-- least recently checked first, so a batch of receipts that are never found cannot hold up the rest.
+ALTER TABLE donation_records ADD COLUMN checked_at TIMESTAMPTZ;
+
+CREATE INDEX donation_records_pending_idx ON donation_records (checked_at NULLS FIRST, id) WHERE status = 'pending';
@@ -0,0 +1,2 @@
+-- Drop the unpriceable mark of donations
+ALTER TABLE donation_records DROP COLUMN IF EXISTS unpriceable;
@@ -0,0 +1,3 @@
+-- Set on confirmed donations that no price sample was recorded near, so the valuation batch skips
+-- them instead of looking them up again on every run
+ALTER TABLE donation_records ADD COLUMN unpriceable BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Drop when sponsored actions were last checked
+ALTER TABLE gas_sponsorships DROP COLUMN IF EXISTS checked_at;
@@ -0,0 +1,3 @@
+-- When the receipt of a sponsored action was last looked for. Actions are reconciled least recently
+-- checked first, so a batch of actions that are never mined cannot hold up the rest.
+ALTER TABLE gas_sponsorships ADD COLUMN checked_at TIMESTAMPTZ;
@@ -0,0 +1,8 @@
+-- Drop the claimed executions of proposals
+UPDATE campaign_proposals SET status = 'executed', executed_at = now() WHERE status = 'executing' AND execution_tx_hash IS NOT NULL;
+UPDATE campaign_proposals SET status = 'passed' WHERE status = 'executing';
+
+ALTER TABLE campaign_proposals DROP CONSTRAINT campaign_proposals_status_check;
+ALTER TABLE campaign_proposals ADD CONSTRAINT campaign_proposals_status_check CHECK (status IN ('open', 'passed', 'failed', 'executed'));
+
+ALTER TABLE campaign_proposals DROP COLUMN IF EXISTS execution_tx_hash;
@@ -0,0 +1,6 @@
+-- A passed proposal whose execution sends a transaction is claimed as 'executing' before the
+-- transaction is sent, and execution_tx_hash records it once sent, so it is never sent twice
+ALTER TABLE campaign_proposals ADD COLUMN execution_tx_hash VARCHAR;
+
+ALTER TABLE campaign_proposals DROP CONSTRAINT campaign_proposals_status_check;
+ALTER TABLE campaign_proposals ADD CONSTRAINT campaign_proposals_status_check CHECK (status IN ('open', 'passed', 'executing', 'failed', 'executed'));
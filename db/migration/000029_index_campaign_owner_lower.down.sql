@@ -0,0 +1,2 @@
+-- Drop the case-insensitive owner index
+DROP INDEX IF EXISTS indexed_campaigns_owner_lower_idx;
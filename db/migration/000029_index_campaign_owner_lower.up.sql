@@ -0,0 +1,2 @@
+-- Campaign lists match owners case-insensitively, since wallets and the chain disagree on checksum casing
+CREATE INDEX indexed_campaigns_owner_lower_idx ON indexed_campaigns (chain_id, lower(owner));
@@ -7,6 +7,7 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/demola234/defiraise/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,51 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// ApproveCampaignMilestone mocks base method.
+func (m *MockStore) ApproveCampaignMilestone(arg0 context.Context, arg1 db.ApproveCampaignMilestoneParams) (db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveCampaignMilestone", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveCampaignMilestone indicates an expected call of ApproveCampaignMilestone.
+func (mr *MockStoreMockRecorder) ApproveCampaignMilestone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveCampaignMilestone", reflect.TypeOf((*MockStore)(nil).ApproveCampaignMilestone), arg0, arg1)
+}
+
+// ApproveFundingRound mocks base method.
+func (m *MockStore) ApproveFundingRound(arg0 context.Context, arg1 db.ApproveFundingRoundParams) (db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFundingRound", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveFundingRound indicates an expected call of ApproveFundingRound.
+func (mr *MockStoreMockRecorder) ApproveFundingRound(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFundingRound", reflect.TypeOf((*MockStore)(nil).ApproveFundingRound), arg0, arg1)
+}
+
+// CancelDonationRewardClaim mocks base method.
+func (m *MockStore) CancelDonationRewardClaim(arg0 context.Context, arg1 db.CancelDonationRewardClaimParams) (db.RewardClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDonationRewardClaim", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDonationRewardClaim indicates an expected call of CancelDonationRewardClaim.
+func (mr *MockStoreMockRecorder) CancelDonationRewardClaim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDonationRewardClaim", reflect.TypeOf((*MockStore)(nil).CancelDonationRewardClaim), arg0, arg1)
+}
+
 // ChangePassword mocks base method.
 func (m *MockStore) ChangePassword(arg0 context.Context, arg1 db.ChangePasswordParams) (db.Users, error) {
 	m.ctrl.T.Helper()
@@ -66,167 +112,2769 @@ func (mr *MockStoreMockRecorder) CheckUsernameExists(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUsernameExists", reflect.TypeOf((*MockStore)(nil).CheckUsernameExists), arg0, arg1)
 }
 
-// CreateCampaignType mocks base method.
-func (m *MockStore) CreateCampaignType(arg0 context.Context, arg1 db.CreateCampaignTypeParams) (db.Campaigns, error) {
+// CheckWalletExists mocks base method.
+func (m *MockStore) CheckWalletExists(arg0 context.Context, arg1 db.CheckWalletExistsParams) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCampaignType", arg0, arg1)
-	ret0, _ := ret[0].(db.Campaigns)
+	ret := m.ctrl.Call(m, "CheckWalletExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateCampaignType indicates an expected call of CreateCampaignType.
-func (mr *MockStoreMockRecorder) CreateCampaignType(arg0, arg1 interface{}) *gomock.Call {
+// CheckWalletExists indicates an expected call of CheckWalletExists.
+func (mr *MockStoreMockRecorder) CheckWalletExists(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaignType", reflect.TypeOf((*MockStore)(nil).CreateCampaignType), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckWalletExists", reflect.TypeOf((*MockStore)(nil).CheckWalletExists), arg0, arg1)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.UserSession, error) {
+// ClaimBadgeMint mocks base method.
+func (m *MockStore) ClaimBadgeMint(arg0 context.Context, arg1 db.ClaimBadgeMintParams) (db.BadgeMints, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(db.UserSession)
+	ret := m.ctrl.Call(m, "ClaimBadgeMint", arg0, arg1)
+	ret0, _ := ret[0].(db.BadgeMints)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+// ClaimBadgeMint indicates an expected call of ClaimBadgeMint.
+func (mr *MockStoreMockRecorder) ClaimBadgeMint(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimBadgeMint", reflect.TypeOf((*MockStore)(nil).ClaimBadgeMint), arg0, arg1)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.Users, error) {
+// ClaimCampaignProposalExecution mocks base method.
+func (m *MockStore) ClaimCampaignProposalExecution(arg0 context.Context, arg1 int64) (db.CampaignProposals, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "ClaimCampaignProposalExecution", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignProposals)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+// ClaimCampaignProposalExecution indicates an expected call of ClaimCampaignProposalExecution.
+func (mr *MockStoreMockRecorder) ClaimCampaignProposalExecution(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimCampaignProposalExecution", reflect.TypeOf((*MockStore)(nil).ClaimCampaignProposalExecution), arg0, arg1)
 }
 
-// DeleteSession mocks base method.
-func (m *MockStore) DeleteSession(arg0 context.Context, arg1 uuid.UUID) (db.UserSession, error) {
+// ClaimDueRecurringDonations mocks base method.
+func (m *MockStore) ClaimDueRecurringDonations(arg0 context.Context, arg1 db.ClaimDueRecurringDonationsParams) ([]db.RecurringDonations, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
-	ret0, _ := ret[0].(db.UserSession)
+	ret := m.ctrl.Call(m, "ClaimDueRecurringDonations", arg0, arg1)
+	ret0, _ := ret[0].([]db.RecurringDonations)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteSession indicates an expected call of DeleteSession.
-func (mr *MockStoreMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+// ClaimDueRecurringDonations indicates an expected call of ClaimDueRecurringDonations.
+func (mr *MockStoreMockRecorder) ClaimDueRecurringDonations(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockStore)(nil).DeleteSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueRecurringDonations", reflect.TypeOf((*MockStore)(nil).ClaimDueRecurringDonations), arg0, arg1)
 }
 
-// DeleteUser mocks base method.
-func (m *MockStore) DeleteUser(arg0 context.Context, arg1 string) (db.Users, error) {
+// ClaimFaucetGrant mocks base method.
+func (m *MockStore) ClaimFaucetGrant(arg0 context.Context, arg1 db.ClaimFaucetGrantParams) (db.FaucetGrants, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "ClaimFaucetGrant", arg0, arg1)
+	ret0, _ := ret[0].(db.FaucetGrants)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+// ClaimFaucetGrant indicates an expected call of ClaimFaucetGrant.
+func (mr *MockStoreMockRecorder) ClaimFaucetGrant(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimFaucetGrant", reflect.TypeOf((*MockStore)(nil).ClaimFaucetGrant), arg0, arg1)
 }
 
-// GetAllActiveDonations mocks base method.
-func (m *MockStore) GetAllActiveDonations(arg0 context.Context) ([]db.Donations, error) {
+// ClaimFaucetGrantTx mocks base method.
+func (m *MockStore) ClaimFaucetGrantTx(arg0 context.Context, arg1 db.ClaimFaucetGrantTxParams) (db.FaucetGrants, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllActiveDonations", arg0)
-	ret0, _ := ret[0].([]db.Donations)
+	ret := m.ctrl.Call(m, "ClaimFaucetGrantTx", arg0, arg1)
+	ret0, _ := ret[0].(db.FaucetGrants)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllActiveDonations indicates an expected call of GetAllActiveDonations.
-func (mr *MockStoreMockRecorder) GetAllActiveDonations(arg0 interface{}) *gomock.Call {
+// ClaimFaucetGrantTx indicates an expected call of ClaimFaucetGrantTx.
+func (mr *MockStoreMockRecorder) ClaimFaucetGrantTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllActiveDonations", reflect.TypeOf((*MockStore)(nil).GetAllActiveDonations), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimFaucetGrantTx", reflect.TypeOf((*MockStore)(nil).ClaimFaucetGrantTx), arg0, arg1)
 }
 
-// GetAllCampaignType mocks base method.
-func (m *MockStore) GetAllCampaignType(arg0 context.Context) ([]db.Campaigns, error) {
+// ClaimFundingRoundAllocation mocks base method.
+func (m *MockStore) ClaimFundingRoundAllocation(arg0 context.Context, arg1 db.ClaimFundingRoundAllocationParams) (db.FundingRoundAllocations, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllCampaignType", arg0)
-	ret0, _ := ret[0].([]db.Campaigns)
+	ret := m.ctrl.Call(m, "ClaimFundingRoundAllocation", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRoundAllocations)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllCampaignType indicates an expected call of GetAllCampaignType.
-func (mr *MockStoreMockRecorder) GetAllCampaignType(arg0 interface{}) *gomock.Call {
+// ClaimFundingRoundAllocation indicates an expected call of ClaimFundingRoundAllocation.
+func (mr *MockStoreMockRecorder) ClaimFundingRoundAllocation(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCampaignType", reflect.TypeOf((*MockStore)(nil).GetAllCampaignType), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimFundingRoundAllocation", reflect.TypeOf((*MockStore)(nil).ClaimFundingRoundAllocation), arg0, arg1)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.UserSession, error) {
+// ClaimMatchingPoolMatch mocks base method.
+func (m *MockStore) ClaimMatchingPoolMatch(arg0 context.Context, arg1 db.ClaimMatchingPoolMatchParams) (db.MatchingPoolMatches, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(db.UserSession)
+	ret := m.ctrl.Call(m, "ClaimMatchingPoolMatch", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPoolMatches)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+// ClaimMatchingPoolMatch indicates an expected call of ClaimMatchingPoolMatch.
+func (mr *MockStoreMockRecorder) ClaimMatchingPoolMatch(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimMatchingPoolMatch", reflect.TypeOf((*MockStore)(nil).ClaimMatchingPoolMatch), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.Users, error) {
+// ClaimOnRampDonation mocks base method.
+func (m *MockStore) ClaimOnRampDonation(arg0 context.Context, arg1 int64) (db.OnrampOrders, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "ClaimOnRampDonation", arg0, arg1)
+	ret0, _ := ret[0].(db.OnrampOrders)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// ClaimOnRampDonation indicates an expected call of ClaimOnRampDonation.
+func (mr *MockStoreMockRecorder) ClaimOnRampDonation(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOnRampDonation", reflect.TypeOf((*MockStore)(nil).ClaimOnRampDonation), arg0, arg1)
 }
 
-// GetUserByAddress mocks base method.
-func (m *MockStore) GetUserByAddress(arg0 context.Context, arg1 string) (db.Users, error) {
+// CloseCampaignProposal mocks base method.
+func (m *MockStore) CloseCampaignProposal(arg0 context.Context, arg1 db.CloseCampaignProposalParams) (db.CampaignProposals, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByAddress", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "CloseCampaignProposal", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignProposals)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByAddress indicates an expected call of GetUserByAddress.
-func (mr *MockStoreMockRecorder) GetUserByAddress(arg0, arg1 interface{}) *gomock.Call {
+// CloseCampaignProposal indicates an expected call of CloseCampaignProposal.
+func (mr *MockStoreMockRecorder) CloseCampaignProposal(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByAddress", reflect.TypeOf((*MockStore)(nil).GetUserByAddress), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseCampaignProposal", reflect.TypeOf((*MockStore)(nil).CloseCampaignProposal), arg0, arg1)
 }
 
-// UpdateUser mocks base method.
-func (m *MockStore) UpdateUser(arg0 context.Context, arg1 db.UpdateUserParams) (db.Users, error) {
+// CloseMatchingPool mocks base method.
+func (m *MockStore) CloseMatchingPool(arg0 context.Context, arg1 int64) (db.MatchingPools, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1)
-	ret0, _ := ret[0].(db.Users)
+	ret := m.ctrl.Call(m, "CloseMatchingPool", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPools)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockStoreMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call {
+// CloseMatchingPool indicates an expected call of CloseMatchingPool.
+func (mr *MockStoreMockRecorder) CloseMatchingPool(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseMatchingPool", reflect.TypeOf((*MockStore)(nil).CloseMatchingPool), arg0, arg1)
+}
+
+// CompleteOnRampOrder mocks base method.
+func (m *MockStore) CompleteOnRampOrder(arg0 context.Context, arg1 db.CompleteOnRampOrderParams) (db.OnrampOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOnRampOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.OnrampOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteOnRampOrder indicates an expected call of CompleteOnRampOrder.
+func (mr *MockStoreMockRecorder) CompleteOnRampOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOnRampOrder", reflect.TypeOf((*MockStore)(nil).CompleteOnRampOrder), arg0, arg1)
+}
+
+// ConfirmBadgeMint mocks base method.
+func (m *MockStore) ConfirmBadgeMint(arg0 context.Context, arg1 db.ConfirmBadgeMintParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmBadgeMint", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmBadgeMint indicates an expected call of ConfirmBadgeMint.
+func (mr *MockStoreMockRecorder) ConfirmBadgeMint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmBadgeMint", reflect.TypeOf((*MockStore)(nil).ConfirmBadgeMint), arg0, arg1)
+}
+
+// CountActiveDonations mocks base method.
+func (m *MockStore) CountActiveDonations(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveDonations", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveDonations indicates an expected call of CountActiveDonations.
+func (mr *MockStoreMockRecorder) CountActiveDonations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveDonations", reflect.TypeOf((*MockStore)(nil).CountActiveDonations), arg0)
+}
+
+// CountCampaignRewardClaims mocks base method.
+func (m *MockStore) CountCampaignRewardClaims(arg0 context.Context, arg1 db.CountCampaignRewardClaimsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCampaignRewardClaims", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCampaignRewardClaims indicates an expected call of CountCampaignRewardClaims.
+func (mr *MockStoreMockRecorder) CountCampaignRewardClaims(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCampaignRewardClaims", reflect.TypeOf((*MockStore)(nil).CountCampaignRewardClaims), arg0, arg1)
+}
+
+// CountFaucetIPUsers mocks base method.
+func (m *MockStore) CountFaucetIPUsers(arg0 context.Context, arg1 db.CountFaucetIPUsersParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFaucetIPUsers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFaucetIPUsers indicates an expected call of CountFaucetIPUsers.
+func (mr *MockStoreMockRecorder) CountFaucetIPUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFaucetIPUsers", reflect.TypeOf((*MockStore)(nil).CountFaucetIPUsers), arg0, arg1)
+}
+
+// CountFundingRounds mocks base method.
+func (m *MockStore) CountFundingRounds(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFundingRounds", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFundingRounds indicates an expected call of CountFundingRounds.
+func (mr *MockStoreMockRecorder) CountFundingRounds(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFundingRounds", reflect.TypeOf((*MockStore)(nil).CountFundingRounds), arg0)
+}
+
+// CountIndexedCampaigns mocks base method.
+func (m *MockStore) CountIndexedCampaigns(arg0 context.Context, arg1 db.CountIndexedCampaignsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountIndexedCampaigns", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountIndexedCampaigns indicates an expected call of CountIndexedCampaigns.
+func (mr *MockStoreMockRecorder) CountIndexedCampaigns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountIndexedCampaigns", reflect.TypeOf((*MockStore)(nil).CountIndexedCampaigns), arg0, arg1)
+}
+
+// CountMatchingPools mocks base method.
+func (m *MockStore) CountMatchingPools(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMatchingPools", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMatchingPools indicates an expected call of CountMatchingPools.
+func (mr *MockStoreMockRecorder) CountMatchingPools(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMatchingPools", reflect.TypeOf((*MockStore)(nil).CountMatchingPools), arg0)
+}
+
+// CountOwnerUnescrowedCampaigns mocks base method.
+func (m *MockStore) CountOwnerUnescrowedCampaigns(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOwnerUnescrowedCampaigns", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOwnerUnescrowedCampaigns indicates an expected call of CountOwnerUnescrowedCampaigns.
+func (mr *MockStoreMockRecorder) CountOwnerUnescrowedCampaigns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOwnerUnescrowedCampaigns", reflect.TypeOf((*MockStore)(nil).CountOwnerUnescrowedCampaigns), arg0, arg1)
+}
+
+// CountPendingCampaignDonationRecords mocks base method.
+func (m *MockStore) CountPendingCampaignDonationRecords(arg0 context.Context, arg1 db.CountPendingCampaignDonationRecordsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPendingCampaignDonationRecords", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPendingCampaignDonationRecords indicates an expected call of CountPendingCampaignDonationRecords.
+func (mr *MockStoreMockRecorder) CountPendingCampaignDonationRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPendingCampaignDonationRecords", reflect.TypeOf((*MockStore)(nil).CountPendingCampaignDonationRecords), arg0, arg1)
+}
+
+// CountPriceHistory mocks base method.
+func (m *MockStore) CountPriceHistory(arg0 context.Context, arg1 db.CountPriceHistoryParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPriceHistory", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPriceHistory indicates an expected call of CountPriceHistory.
+func (mr *MockStoreMockRecorder) CountPriceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPriceHistory", reflect.TypeOf((*MockStore)(nil).CountPriceHistory), arg0, arg1)
+}
+
+// CountSearchCampaigns mocks base method.
+func (m *MockStore) CountSearchCampaigns(arg0 context.Context, arg1 db.CountSearchCampaignsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchCampaigns", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchCampaigns indicates an expected call of CountSearchCampaigns.
+func (mr *MockStoreMockRecorder) CountSearchCampaigns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchCampaigns", reflect.TypeOf((*MockStore)(nil).CountSearchCampaigns), arg0, arg1)
+}
+
+// CountUnpaidFundingRoundAllocations mocks base method.
+func (m *MockStore) CountUnpaidFundingRoundAllocations(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnpaidFundingRoundAllocations", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnpaidFundingRoundAllocations indicates an expected call of CountUnpaidFundingRoundAllocations.
+func (mr *MockStoreMockRecorder) CountUnpaidFundingRoundAllocations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnpaidFundingRoundAllocations", reflect.TypeOf((*MockStore)(nil).CountUnpaidFundingRoundAllocations), arg0, arg1)
+}
+
+// CountUserRewardClaims mocks base method.
+func (m *MockStore) CountUserRewardClaims(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserRewardClaims", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserRewardClaims indicates an expected call of CountUserRewardClaims.
+func (mr *MockStoreMockRecorder) CountUserRewardClaims(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserRewardClaims", reflect.TypeOf((*MockStore)(nil).CountUserRewardClaims), arg0, arg1)
+}
+
+// CountUserWallets mocks base method.
+func (m *MockStore) CountUserWallets(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserWallets", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserWallets indicates an expected call of CountUserWallets.
+func (mr *MockStoreMockRecorder) CountUserWallets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserWallets", reflect.TypeOf((*MockStore)(nil).CountUserWallets), arg0, arg1)
+}
+
+// CreateCampaignDeadlineChange mocks base method.
+func (m *MockStore) CreateCampaignDeadlineChange(arg0 context.Context, arg1 db.CreateCampaignDeadlineChangeParams) (db.CampaignDeadlineChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCampaignDeadlineChange", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignDeadlineChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCampaignDeadlineChange indicates an expected call of CreateCampaignDeadlineChange.
+func (mr *MockStoreMockRecorder) CreateCampaignDeadlineChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaignDeadlineChange", reflect.TypeOf((*MockStore)(nil).CreateCampaignDeadlineChange), arg0, arg1)
+}
+
+// CreateCampaignEscrow mocks base method.
+func (m *MockStore) CreateCampaignEscrow(arg0 context.Context, arg1 db.CreateCampaignEscrowParams) (db.CampaignEscrows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCampaignEscrow", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignEscrows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCampaignEscrow indicates an expected call of CreateCampaignEscrow.
+func (mr *MockStoreMockRecorder) CreateCampaignEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaignEscrow", reflect.TypeOf((*MockStore)(nil).CreateCampaignEscrow), arg0, arg1)
+}
+
+// CreateCampaignMilestones mocks base method.
+func (m *MockStore) CreateCampaignMilestones(arg0 context.Context, arg1 db.CreateCampaignMilestonesParams) ([]db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCampaignMilestones", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCampaignMilestones indicates an expected call of CreateCampaignMilestones.
+func (mr *MockStoreMockRecorder) CreateCampaignMilestones(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaignMilestones", reflect.TypeOf((*MockStore)(nil).CreateCampaignMilestones), arg0, arg1)
+}
+
+// CreateCampaignProposal mocks base method.
+func (m *MockStore) CreateCampaignProposal(arg0 context.Context, arg1 db.CreateCampaignProposalParams) (db.CampaignProposals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCampaignProposal", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignProposals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCampaignProposal indicates an expected call of CreateCampaignProposal.
+func (mr *MockStoreMockRecorder) CreateCampaignProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaignProposal", reflect.TypeOf((*MockStore)(nil).CreateCampaignProposal), arg0, arg1)
+}
+
+// CreateCampaignType mocks base method.
+func (m *MockStore) CreateCampaignType(arg0 context.Context, arg1 db.CreateCampaignTypeParams) (db.Campaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCampaignType", arg0, arg1)
+	ret0, _ := ret[0].(db.Campaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCampaignType indicates an expected call of CreateCampaignType.
+func (mr *MockStoreMockRecorder) CreateCampaignType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaignType", reflect.TypeOf((*MockStore)(nil).CreateCampaignType), arg0, arg1)
+}
+
+// CreateChainDonationRecord mocks base method.
+func (m *MockStore) CreateChainDonationRecord(arg0 context.Context, arg1 db.CreateChainDonationRecordParams) (db.DonationRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChainDonationRecord", arg0, arg1)
+	ret0, _ := ret[0].(db.DonationRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChainDonationRecord indicates an expected call of CreateChainDonationRecord.
+func (mr *MockStoreMockRecorder) CreateChainDonationRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChainDonationRecord", reflect.TypeOf((*MockStore)(nil).CreateChainDonationRecord), arg0, arg1)
+}
+
+// CreateContractDeployment mocks base method.
+func (m *MockStore) CreateContractDeployment(arg0 context.Context, arg1 db.CreateContractDeploymentParams) (db.ContractDeployments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContractDeployment", arg0, arg1)
+	ret0, _ := ret[0].(db.ContractDeployments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContractDeployment indicates an expected call of CreateContractDeployment.
+func (mr *MockStoreMockRecorder) CreateContractDeployment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContractDeployment", reflect.TypeOf((*MockStore)(nil).CreateContractDeployment), arg0, arg1)
+}
+
+// CreateDonationRecord mocks base method.
+func (m *MockStore) CreateDonationRecord(arg0 context.Context, arg1 db.CreateDonationRecordParams) (db.DonationRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDonationRecord", arg0, arg1)
+	ret0, _ := ret[0].(db.DonationRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDonationRecord indicates an expected call of CreateDonationRecord.
+func (mr *MockStoreMockRecorder) CreateDonationRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDonationRecord", reflect.TypeOf((*MockStore)(nil).CreateDonationRecord), arg0, arg1)
+}
+
+// CreateFundingRound mocks base method.
+func (m *MockStore) CreateFundingRound(arg0 context.Context, arg1 db.CreateFundingRoundParams) (db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFundingRound", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFundingRound indicates an expected call of CreateFundingRound.
+func (mr *MockStoreMockRecorder) CreateFundingRound(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFundingRound", reflect.TypeOf((*MockStore)(nil).CreateFundingRound), arg0, arg1)
+}
+
+// CreateFundingRoundBaseline mocks base method.
+func (m *MockStore) CreateFundingRoundBaseline(arg0 context.Context, arg1 db.CreateFundingRoundBaselineParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFundingRoundBaseline", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFundingRoundBaseline indicates an expected call of CreateFundingRoundBaseline.
+func (mr *MockStoreMockRecorder) CreateFundingRoundBaseline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFundingRoundBaseline", reflect.TypeOf((*MockStore)(nil).CreateFundingRoundBaseline), arg0, arg1)
+}
+
+// CreateGasSponsorship mocks base method.
+func (m *MockStore) CreateGasSponsorship(arg0 context.Context, arg1 db.CreateGasSponsorshipParams) (db.GasSponsorships, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGasSponsorship", arg0, arg1)
+	ret0, _ := ret[0].(db.GasSponsorships)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGasSponsorship indicates an expected call of CreateGasSponsorship.
+func (mr *MockStoreMockRecorder) CreateGasSponsorship(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGasSponsorship", reflect.TypeOf((*MockStore)(nil).CreateGasSponsorship), arg0, arg1)
+}
+
+// CreateMatchingPool mocks base method.
+func (m *MockStore) CreateMatchingPool(arg0 context.Context, arg1 db.CreateMatchingPoolParams) (db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMatchingPool", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMatchingPool indicates an expected call of CreateMatchingPool.
+func (mr *MockStoreMockRecorder) CreateMatchingPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMatchingPool", reflect.TypeOf((*MockStore)(nil).CreateMatchingPool), arg0, arg1)
+}
+
+// CreateOnRampOrder mocks base method.
+func (m *MockStore) CreateOnRampOrder(arg0 context.Context, arg1 db.CreateOnRampOrderParams) (db.OnrampOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOnRampOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.OnrampOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOnRampOrder indicates an expected call of CreateOnRampOrder.
+func (mr *MockStoreMockRecorder) CreateOnRampOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOnRampOrder", reflect.TypeOf((*MockStore)(nil).CreateOnRampOrder), arg0, arg1)
+}
+
+// CreatePriceSample mocks base method.
+func (m *MockStore) CreatePriceSample(arg0 context.Context, arg1 db.CreatePriceSampleParams) (db.PriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceSample", arg0, arg1)
+	ret0, _ := ret[0].(db.PriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePriceSample indicates an expected call of CreatePriceSample.
+func (mr *MockStoreMockRecorder) CreatePriceSample(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceSample", reflect.TypeOf((*MockStore)(nil).CreatePriceSample), arg0, arg1)
+}
+
+// CreateRecurringDonation mocks base method.
+func (m *MockStore) CreateRecurringDonation(arg0 context.Context, arg1 db.CreateRecurringDonationParams) (db.RecurringDonations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurringDonation", arg0, arg1)
+	ret0, _ := ret[0].(db.RecurringDonations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecurringDonation indicates an expected call of CreateRecurringDonation.
+func (mr *MockStoreMockRecorder) CreateRecurringDonation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurringDonation", reflect.TypeOf((*MockStore)(nil).CreateRecurringDonation), arg0, arg1)
+}
+
+// CreateRewardClaim mocks base method.
+func (m *MockStore) CreateRewardClaim(arg0 context.Context, arg1 db.CreateRewardClaimParams) (db.RewardClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRewardClaim", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRewardClaim indicates an expected call of CreateRewardClaim.
+func (mr *MockStoreMockRecorder) CreateRewardClaim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRewardClaim", reflect.TypeOf((*MockStore)(nil).CreateRewardClaim), arg0, arg1)
+}
+
+// CreateRewardTier mocks base method.
+func (m *MockStore) CreateRewardTier(arg0 context.Context, arg1 db.CreateRewardTierParams) (db.RewardTiers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRewardTier", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardTiers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRewardTier indicates an expected call of CreateRewardTier.
+func (mr *MockStoreMockRecorder) CreateRewardTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRewardTier", reflect.TypeOf((*MockStore)(nil).CreateRewardTier), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// CreateUserWallet mocks base method.
+func (m *MockStore) CreateUserWallet(arg0 context.Context, arg1 db.CreateUserWalletParams) (db.UserWalletAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserWallet", arg0, arg1)
+	ret0, _ := ret[0].(db.UserWalletAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserWallet indicates an expected call of CreateUserWallet.
+func (mr *MockStoreMockRecorder) CreateUserWallet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWallet", reflect.TypeOf((*MockStore)(nil).CreateUserWallet), arg0, arg1)
+}
+
+// DeleteBadgeMint mocks base method.
+func (m *MockStore) DeleteBadgeMint(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBadgeMint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBadgeMint indicates an expected call of DeleteBadgeMint.
+func (mr *MockStoreMockRecorder) DeleteBadgeMint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBadgeMint", reflect.TypeOf((*MockStore)(nil).DeleteBadgeMint), arg0, arg1)
+}
+
+// DeleteCampaignEscrow mocks base method.
+func (m *MockStore) DeleteCampaignEscrow(arg0 context.Context, arg1 db.DeleteCampaignEscrowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCampaignEscrow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCampaignEscrow indicates an expected call of DeleteCampaignEscrow.
+func (mr *MockStoreMockRecorder) DeleteCampaignEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCampaignEscrow", reflect.TypeOf((*MockStore)(nil).DeleteCampaignEscrow), arg0, arg1)
+}
+
+// DeleteMatchingPoolMatch mocks base method.
+func (m *MockStore) DeleteMatchingPoolMatch(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMatchingPoolMatch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMatchingPoolMatch indicates an expected call of DeleteMatchingPoolMatch.
+func (mr *MockStoreMockRecorder) DeleteMatchingPoolMatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMatchingPoolMatch", reflect.TypeOf((*MockStore)(nil).DeleteMatchingPoolMatch), arg0, arg1)
+}
+
+// DeleteMilestoneVotes mocks base method.
+func (m *MockStore) DeleteMilestoneVotes(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMilestoneVotes", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMilestoneVotes indicates an expected call of DeleteMilestoneVotes.
+func (mr *MockStoreMockRecorder) DeleteMilestoneVotes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMilestoneVotes", reflect.TypeOf((*MockStore)(nil).DeleteMilestoneVotes), arg0, arg1)
+}
+
+// DeleteSession mocks base method.
+func (m *MockStore) DeleteSession(arg0 context.Context, arg1 uuid.UUID) (db.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", arg0, arg1)
+	ret0, _ := ret[0].(db.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *MockStoreMockRecorder) DeleteSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockStore)(nil).DeleteSession), arg0, arg1)
+}
+
+// DeleteUser mocks base method.
+func (m *MockStore) DeleteUser(arg0 context.Context, arg1 string) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+}
+
+// EnsureBadge mocks base method.
+func (m *MockStore) EnsureBadge(arg0 context.Context, arg1 db.EnsureBadgeParams) (db.Badges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureBadge", arg0, arg1)
+	ret0, _ := ret[0].(db.Badges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureBadge indicates an expected call of EnsureBadge.
+func (mr *MockStoreMockRecorder) EnsureBadge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureBadge", reflect.TypeOf((*MockStore)(nil).EnsureBadge), arg0, arg1)
+}
+
+// ExecuteCampaignProposal mocks base method.
+func (m *MockStore) ExecuteCampaignProposal(arg0 context.Context, arg1 int64) (db.CampaignProposals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCampaignProposal", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignProposals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCampaignProposal indicates an expected call of ExecuteCampaignProposal.
+func (mr *MockStoreMockRecorder) ExecuteCampaignProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCampaignProposal", reflect.TypeOf((*MockStore)(nil).ExecuteCampaignProposal), arg0, arg1)
+}
+
+// ExhaustMatchingPool mocks base method.
+func (m *MockStore) ExhaustMatchingPool(arg0 context.Context, arg1 int64) (db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExhaustMatchingPool", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExhaustMatchingPool indicates an expected call of ExhaustMatchingPool.
+func (mr *MockStoreMockRecorder) ExhaustMatchingPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExhaustMatchingPool", reflect.TypeOf((*MockStore)(nil).ExhaustMatchingPool), arg0, arg1)
+}
+
+// FailCampaignProposal mocks base method.
+func (m *MockStore) FailCampaignProposal(arg0 context.Context, arg1 int64) (db.CampaignProposals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailCampaignProposal", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignProposals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailCampaignProposal indicates an expected call of FailCampaignProposal.
+func (mr *MockStoreMockRecorder) FailCampaignProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailCampaignProposal", reflect.TypeOf((*MockStore)(nil).FailCampaignProposal), arg0, arg1)
+}
+
+// FailFaucetGrant mocks base method.
+func (m *MockStore) FailFaucetGrant(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailFaucetGrant", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailFaucetGrant indicates an expected call of FailFaucetGrant.
+func (mr *MockStoreMockRecorder) FailFaucetGrant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailFaucetGrant", reflect.TypeOf((*MockStore)(nil).FailFaucetGrant), arg0, arg1)
+}
+
+// FailOnRampOrder mocks base method.
+func (m *MockStore) FailOnRampOrder(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailOnRampOrder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailOnRampOrder indicates an expected call of FailOnRampOrder.
+func (mr *MockStoreMockRecorder) FailOnRampOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailOnRampOrder", reflect.TypeOf((*MockStore)(nil).FailOnRampOrder), arg0, arg1)
+}
+
+// GetAllActiveDonations mocks base method.
+func (m *MockStore) GetAllActiveDonations(arg0 context.Context, arg1 db.GetAllActiveDonationsParams) ([]db.Donations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllActiveDonations", arg0, arg1)
+	ret0, _ := ret[0].([]db.Donations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllActiveDonations indicates an expected call of GetAllActiveDonations.
+func (mr *MockStoreMockRecorder) GetAllActiveDonations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllActiveDonations", reflect.TypeOf((*MockStore)(nil).GetAllActiveDonations), arg0, arg1)
+}
+
+// GetAllCampaignType mocks base method.
+func (m *MockStore) GetAllCampaignType(arg0 context.Context) ([]db.Campaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllCampaignType", arg0)
+	ret0, _ := ret[0].([]db.Campaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllCampaignType indicates an expected call of GetAllCampaignType.
+func (mr *MockStoreMockRecorder) GetAllCampaignType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCampaignType", reflect.TypeOf((*MockStore)(nil).GetAllCampaignType), arg0)
+}
+
+// GetBadge mocks base method.
+func (m *MockStore) GetBadge(arg0 context.Context, arg1 int64) (db.Badges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBadge", arg0, arg1)
+	ret0, _ := ret[0].(db.Badges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBadge indicates an expected call of GetBadge.
+func (mr *MockStoreMockRecorder) GetBadge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBadge", reflect.TypeOf((*MockStore)(nil).GetBadge), arg0, arg1)
+}
+
+// GetBadgeSyncBlock mocks base method.
+func (m *MockStore) GetBadgeSyncBlock(arg0 context.Context, arg1 db.GetBadgeSyncBlockParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBadgeSyncBlock", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBadgeSyncBlock indicates an expected call of GetBadgeSyncBlock.
+func (mr *MockStoreMockRecorder) GetBadgeSyncBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBadgeSyncBlock", reflect.TypeOf((*MockStore)(nil).GetBadgeSyncBlock), arg0, arg1)
+}
+
+// GetCampaignCurrency mocks base method.
+func (m *MockStore) GetCampaignCurrency(arg0 context.Context, arg1 db.GetCampaignCurrencyParams) (db.CampaignCurrencies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignCurrency", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignCurrencies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignCurrency indicates an expected call of GetCampaignCurrency.
+func (mr *MockStoreMockRecorder) GetCampaignCurrency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignCurrency", reflect.TypeOf((*MockStore)(nil).GetCampaignCurrency), arg0, arg1)
+}
+
+// GetCampaignEscrow mocks base method.
+func (m *MockStore) GetCampaignEscrow(arg0 context.Context, arg1 db.GetCampaignEscrowParams) (db.CampaignEscrows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignEscrow", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignEscrows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignEscrow indicates an expected call of GetCampaignEscrow.
+func (mr *MockStoreMockRecorder) GetCampaignEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignEscrow", reflect.TypeOf((*MockStore)(nil).GetCampaignEscrow), arg0, arg1)
+}
+
+// GetCampaignMilestone mocks base method.
+func (m *MockStore) GetCampaignMilestone(arg0 context.Context, arg1 int64) (db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignMilestone", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignMilestone indicates an expected call of GetCampaignMilestone.
+func (mr *MockStoreMockRecorder) GetCampaignMilestone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignMilestone", reflect.TypeOf((*MockStore)(nil).GetCampaignMilestone), arg0, arg1)
+}
+
+// GetCampaignProposal mocks base method.
+func (m *MockStore) GetCampaignProposal(arg0 context.Context, arg1 int64) (db.CampaignProposals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignProposal", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignProposals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignProposal indicates an expected call of GetCampaignProposal.
+func (mr *MockStoreMockRecorder) GetCampaignProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignProposal", reflect.TypeOf((*MockStore)(nil).GetCampaignProposal), arg0, arg1)
+}
+
+// GetDonationReceipt mocks base method.
+func (m *MockStore) GetDonationReceipt(arg0 context.Context, arg1 db.GetDonationReceiptParams) (db.GetDonationReceiptRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDonationReceipt", arg0, arg1)
+	ret0, _ := ret[0].(db.GetDonationReceiptRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDonationReceipt indicates an expected call of GetDonationReceipt.
+func (mr *MockStoreMockRecorder) GetDonationReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDonationReceipt", reflect.TypeOf((*MockStore)(nil).GetDonationReceipt), arg0, arg1)
+}
+
+// GetDonationRecordByIndex mocks base method.
+func (m *MockStore) GetDonationRecordByIndex(arg0 context.Context, arg1 db.GetDonationRecordByIndexParams) (db.DonationRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDonationRecordByIndex", arg0, arg1)
+	ret0, _ := ret[0].(db.DonationRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDonationRecordByIndex indicates an expected call of GetDonationRecordByIndex.
+func (mr *MockStoreMockRecorder) GetDonationRecordByIndex(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDonationRecordByIndex", reflect.TypeOf((*MockStore)(nil).GetDonationRecordByIndex), arg0, arg1)
+}
+
+// GetDonorIdentity mocks base method.
+func (m *MockStore) GetDonorIdentity(arg0 context.Context, arg1 string) (db.GetDonorIdentityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDonorIdentity", arg0, arg1)
+	ret0, _ := ret[0].(db.GetDonorIdentityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDonorIdentity indicates an expected call of GetDonorIdentity.
+func (mr *MockStoreMockRecorder) GetDonorIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDonorIdentity", reflect.TypeOf((*MockStore)(nil).GetDonorIdentity), arg0, arg1)
+}
+
+// GetFundingRound mocks base method.
+func (m *MockStore) GetFundingRound(arg0 context.Context, arg1 int64) (db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFundingRound", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFundingRound indicates an expected call of GetFundingRound.
+func (mr *MockStoreMockRecorder) GetFundingRound(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFundingRound", reflect.TypeOf((*MockStore)(nil).GetFundingRound), arg0, arg1)
+}
+
+// GetGasBudget mocks base method.
+func (m *MockStore) GetGasBudget(arg0 context.Context, arg1 db.GetGasBudgetParams) (db.GasBudgets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGasBudget", arg0, arg1)
+	ret0, _ := ret[0].(db.GasBudgets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGasBudget indicates an expected call of GetGasBudget.
+func (mr *MockStoreMockRecorder) GetGasBudget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGasBudget", reflect.TypeOf((*MockStore)(nil).GetGasBudget), arg0, arg1)
+}
+
+// GetIndexedCampaign mocks base method.
+func (m *MockStore) GetIndexedCampaign(arg0 context.Context, arg1 db.GetIndexedCampaignParams) (db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIndexedCampaign", arg0, arg1)
+	ret0, _ := ret[0].(db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIndexedCampaign indicates an expected call of GetIndexedCampaign.
+func (mr *MockStoreMockRecorder) GetIndexedCampaign(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIndexedCampaign", reflect.TypeOf((*MockStore)(nil).GetIndexedCampaign), arg0, arg1)
+}
+
+// GetLatestContractDeployment mocks base method.
+func (m *MockStore) GetLatestContractDeployment(arg0 context.Context, arg1 int64) (db.ContractDeployments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestContractDeployment", arg0, arg1)
+	ret0, _ := ret[0].(db.ContractDeployments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestContractDeployment indicates an expected call of GetLatestContractDeployment.
+func (mr *MockStoreMockRecorder) GetLatestContractDeployment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestContractDeployment", reflect.TypeOf((*MockStore)(nil).GetLatestContractDeployment), arg0, arg1)
+}
+
+// GetMatchingPool mocks base method.
+func (m *MockStore) GetMatchingPool(arg0 context.Context, arg1 int64) (db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchingPool", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatchingPool indicates an expected call of GetMatchingPool.
+func (mr *MockStoreMockRecorder) GetMatchingPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchingPool", reflect.TypeOf((*MockStore)(nil).GetMatchingPool), arg0, arg1)
+}
+
+// GetOnRampOrderByProviderID mocks base method.
+func (m *MockStore) GetOnRampOrderByProviderID(arg0 context.Context, arg1 db.GetOnRampOrderByProviderIDParams) (db.OnrampOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnRampOrderByProviderID", arg0, arg1)
+	ret0, _ := ret[0].(db.OnrampOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnRampOrderByProviderID indicates an expected call of GetOnRampOrderByProviderID.
+func (mr *MockStoreMockRecorder) GetOnRampOrderByProviderID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnRampOrderByProviderID", reflect.TypeOf((*MockStore)(nil).GetOnRampOrderByProviderID), arg0, arg1)
+}
+
+// GetPriceAfter mocks base method.
+func (m *MockStore) GetPriceAfter(arg0 context.Context, arg1 db.GetPriceAfterParams) (db.PriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceAfter", arg0, arg1)
+	ret0, _ := ret[0].(db.PriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceAfter indicates an expected call of GetPriceAfter.
+func (mr *MockStoreMockRecorder) GetPriceAfter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceAfter", reflect.TypeOf((*MockStore)(nil).GetPriceAfter), arg0, arg1)
+}
+
+// GetPriceAtOrBefore mocks base method.
+func (m *MockStore) GetPriceAtOrBefore(arg0 context.Context, arg1 db.GetPriceAtOrBeforeParams) (db.PriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceAtOrBefore", arg0, arg1)
+	ret0, _ := ret[0].(db.PriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceAtOrBefore indicates an expected call of GetPriceAtOrBefore.
+func (mr *MockStoreMockRecorder) GetPriceAtOrBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceAtOrBefore", reflect.TypeOf((*MockStore)(nil).GetPriceAtOrBefore), arg0, arg1)
+}
+
+// GetRecurringDonation mocks base method.
+func (m *MockStore) GetRecurringDonation(arg0 context.Context, arg1 int64) (db.RecurringDonations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurringDonation", arg0, arg1)
+	ret0, _ := ret[0].(db.RecurringDonations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurringDonation indicates an expected call of GetRecurringDonation.
+func (mr *MockStoreMockRecorder) GetRecurringDonation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurringDonation", reflect.TypeOf((*MockStore)(nil).GetRecurringDonation), arg0, arg1)
+}
+
+// GetRewardClaim mocks base method.
+func (m *MockStore) GetRewardClaim(arg0 context.Context, arg1 int64) (db.RewardClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardClaim", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardClaim indicates an expected call of GetRewardClaim.
+func (mr *MockStoreMockRecorder) GetRewardClaim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardClaim", reflect.TypeOf((*MockStore)(nil).GetRewardClaim), arg0, arg1)
+}
+
+// GetRewardTier mocks base method.
+func (m *MockStore) GetRewardTier(arg0 context.Context, arg1 int64) (db.RewardTiers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardTier", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardTiers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardTier indicates an expected call of GetRewardTier.
+func (mr *MockStoreMockRecorder) GetRewardTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardTier", reflect.TypeOf((*MockStore)(nil).GetRewardTier), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// GetUserByAddress mocks base method.
+func (m *MockStore) GetUserByAddress(arg0 context.Context, arg1 string) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByAddress", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByAddress indicates an expected call of GetUserByAddress.
+func (mr *MockStoreMockRecorder) GetUserByAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByAddress", reflect.TypeOf((*MockStore)(nil).GetUserByAddress), arg0, arg1)
+}
+
+// GetUserWallets mocks base method.
+func (m *MockStore) GetUserWallets(arg0 context.Context, arg1 db.GetUserWalletsParams) ([]db.UserWalletAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWallets", arg0, arg1)
+	ret0, _ := ret[0].([]db.UserWalletAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWallets indicates an expected call of GetUserWallets.
+func (mr *MockStoreMockRecorder) GetUserWallets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWallets", reflect.TypeOf((*MockStore)(nil).GetUserWallets), arg0, arg1)
+}
+
+// GetWalletByAddress mocks base method.
+func (m *MockStore) GetWalletByAddress(arg0 context.Context, arg1 db.GetWalletByAddressParams) (db.UserWalletAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletByAddress", arg0, arg1)
+	ret0, _ := ret[0].(db.UserWalletAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletByAddress indicates an expected call of GetWalletByAddress.
+func (mr *MockStoreMockRecorder) GetWalletByAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletByAddress", reflect.TypeOf((*MockStore)(nil).GetWalletByAddress), arg0, arg1)
+}
+
+// GetWalletById mocks base method.
+func (m *MockStore) GetWalletById(arg0 context.Context, arg1 db.GetWalletByIdParams) (db.UserWalletAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletById", arg0, arg1)
+	ret0, _ := ret[0].(db.UserWalletAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletById indicates an expected call of GetWalletById.
+func (mr *MockStoreMockRecorder) GetWalletById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletById", reflect.TypeOf((*MockStore)(nil).GetWalletById), arg0, arg1)
+}
+
+// HardDeleteUserWallet mocks base method.
+func (m *MockStore) HardDeleteUserWallet(arg0 context.Context, arg1 db.HardDeleteUserWalletParams) (db.UserWalletAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDeleteUserWallet", arg0, arg1)
+	ret0, _ := ret[0].(db.UserWalletAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HardDeleteUserWallet indicates an expected call of HardDeleteUserWallet.
+func (mr *MockStoreMockRecorder) HardDeleteUserWallet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDeleteUserWallet", reflect.TypeOf((*MockStore)(nil).HardDeleteUserWallet), arg0, arg1)
+}
+
+// LinkDonationRecordIndex mocks base method.
+func (m *MockStore) LinkDonationRecordIndex(arg0 context.Context, arg1 db.LinkDonationRecordIndexParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkDonationRecordIndex", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkDonationRecordIndex indicates an expected call of LinkDonationRecordIndex.
+func (mr *MockStoreMockRecorder) LinkDonationRecordIndex(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkDonationRecordIndex", reflect.TypeOf((*MockStore)(nil).LinkDonationRecordIndex), arg0, arg1)
+}
+
+// ListActiveMatchingPools mocks base method.
+func (m *MockStore) ListActiveMatchingPools(arg0 context.Context) ([]db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveMatchingPools", arg0)
+	ret0, _ := ret[0].([]db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveMatchingPools indicates an expected call of ListActiveMatchingPools.
+func (mr *MockStoreMockRecorder) ListActiveMatchingPools(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveMatchingPools", reflect.TypeOf((*MockStore)(nil).ListActiveMatchingPools), arg0)
+}
+
+// ListActiveWalletAddresses mocks base method.
+func (m *MockStore) ListActiveWalletAddresses(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveWalletAddresses", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveWalletAddresses indicates an expected call of ListActiveWalletAddresses.
+func (mr *MockStoreMockRecorder) ListActiveWalletAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveWalletAddresses", reflect.TypeOf((*MockStore)(nil).ListActiveWalletAddresses), arg0, arg1)
+}
+
+// ListApprovedCampaignMilestones mocks base method.
+func (m *MockStore) ListApprovedCampaignMilestones(arg0 context.Context, arg1 db.ListApprovedCampaignMilestonesParams) ([]db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovedCampaignMilestones", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovedCampaignMilestones indicates an expected call of ListApprovedCampaignMilestones.
+func (mr *MockStoreMockRecorder) ListApprovedCampaignMilestones(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovedCampaignMilestones", reflect.TypeOf((*MockStore)(nil).ListApprovedCampaignMilestones), arg0, arg1)
+}
+
+// ListBadgeableDonations mocks base method.
+func (m *MockStore) ListBadgeableDonations(arg0 context.Context, arg1 db.ListBadgeableDonationsParams) ([]db.ListBadgeableDonationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBadgeableDonations", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListBadgeableDonationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBadgeableDonations indicates an expected call of ListBadgeableDonations.
+func (mr *MockStoreMockRecorder) ListBadgeableDonations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBadgeableDonations", reflect.TypeOf((*MockStore)(nil).ListBadgeableDonations), arg0, arg1)
+}
+
+// ListCampaignCurrencies mocks base method.
+func (m *MockStore) ListCampaignCurrencies(arg0 context.Context, arg1 db.ListCampaignCurrenciesParams) ([]db.CampaignCurrencies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCampaignCurrencies", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignCurrencies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCampaignCurrencies indicates an expected call of ListCampaignCurrencies.
+func (mr *MockStoreMockRecorder) ListCampaignCurrencies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCampaignCurrencies", reflect.TypeOf((*MockStore)(nil).ListCampaignCurrencies), arg0, arg1)
+}
+
+// ListCampaignDeadlineChanges mocks base method.
+func (m *MockStore) ListCampaignDeadlineChanges(arg0 context.Context, arg1 db.ListCampaignDeadlineChangesParams) ([]db.CampaignDeadlineChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCampaignDeadlineChanges", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignDeadlineChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCampaignDeadlineChanges indicates an expected call of ListCampaignDeadlineChanges.
+func (mr *MockStoreMockRecorder) ListCampaignDeadlineChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCampaignDeadlineChanges", reflect.TypeOf((*MockStore)(nil).ListCampaignDeadlineChanges), arg0, arg1)
+}
+
+// ListCampaignMilestones mocks base method.
+func (m *MockStore) ListCampaignMilestones(arg0 context.Context, arg1 db.ListCampaignMilestonesParams) ([]db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCampaignMilestones", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCampaignMilestones indicates an expected call of ListCampaignMilestones.
+func (mr *MockStoreMockRecorder) ListCampaignMilestones(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCampaignMilestones", reflect.TypeOf((*MockStore)(nil).ListCampaignMilestones), arg0, arg1)
+}
+
+// ListCampaignProposals mocks base method.
+func (m *MockStore) ListCampaignProposals(arg0 context.Context, arg1 db.ListCampaignProposalsParams) ([]db.CampaignProposals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCampaignProposals", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignProposals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCampaignProposals indicates an expected call of ListCampaignProposals.
+func (mr *MockStoreMockRecorder) ListCampaignProposals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCampaignProposals", reflect.TypeOf((*MockStore)(nil).ListCampaignProposals), arg0, arg1)
+}
+
+// ListCampaignRewardClaims mocks base method.
+func (m *MockStore) ListCampaignRewardClaims(arg0 context.Context, arg1 db.ListCampaignRewardClaimsParams) ([]db.RewardClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCampaignRewardClaims", arg0, arg1)
+	ret0, _ := ret[0].([]db.RewardClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCampaignRewardClaims indicates an expected call of ListCampaignRewardClaims.
+func (mr *MockStoreMockRecorder) ListCampaignRewardClaims(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCampaignRewardClaims", reflect.TypeOf((*MockStore)(nil).ListCampaignRewardClaims), arg0, arg1)
+}
+
+// ListContractDeployments mocks base method.
+func (m *MockStore) ListContractDeployments(arg0 context.Context) ([]db.ContractDeployments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContractDeployments", arg0)
+	ret0, _ := ret[0].([]db.ContractDeployments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContractDeployments indicates an expected call of ListContractDeployments.
+func (mr *MockStoreMockRecorder) ListContractDeployments(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContractDeployments", reflect.TypeOf((*MockStore)(nil).ListContractDeployments), arg0)
+}
+
+// ListDonationReceiptsInRange mocks base method.
+func (m *MockStore) ListDonationReceiptsInRange(arg0 context.Context, arg1 db.ListDonationReceiptsInRangeParams) ([]db.ListDonationReceiptsInRangeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDonationReceiptsInRange", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListDonationReceiptsInRangeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDonationReceiptsInRange indicates an expected call of ListDonationReceiptsInRange.
+func (mr *MockStoreMockRecorder) ListDonationReceiptsInRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDonationReceiptsInRange", reflect.TypeOf((*MockStore)(nil).ListDonationReceiptsInRange), arg0, arg1)
+}
+
+// ListDonationRecordsByDonors mocks base method.
+func (m *MockStore) ListDonationRecordsByDonors(arg0 context.Context, arg1 db.ListDonationRecordsByDonorsParams) ([]db.ListDonationRecordsByDonorsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDonationRecordsByDonors", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListDonationRecordsByDonorsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDonationRecordsByDonors indicates an expected call of ListDonationRecordsByDonors.
+func (mr *MockStoreMockRecorder) ListDonationRecordsByDonors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDonationRecordsByDonors", reflect.TypeOf((*MockStore)(nil).ListDonationRecordsByDonors), arg0, arg1)
+}
+
+// ListEndedCampaignProposals mocks base method.
+func (m *MockStore) ListEndedCampaignProposals(arg0 context.Context, arg1 db.ListEndedCampaignProposalsParams) ([]db.CampaignProposals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndedCampaignProposals", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignProposals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndedCampaignProposals indicates an expected call of ListEndedCampaignProposals.
+func (mr *MockStoreMockRecorder) ListEndedCampaignProposals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndedCampaignProposals", reflect.TypeOf((*MockStore)(nil).ListEndedCampaignProposals), arg0, arg1)
+}
+
+// ListEndedCampaigns mocks base method.
+func (m *MockStore) ListEndedCampaigns(arg0 context.Context, arg1 db.ListEndedCampaignsParams) ([]db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndedCampaigns", arg0, arg1)
+	ret0, _ := ret[0].([]db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndedCampaigns indicates an expected call of ListEndedCampaigns.
+func (mr *MockStoreMockRecorder) ListEndedCampaigns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndedCampaigns", reflect.TypeOf((*MockStore)(nil).ListEndedCampaigns), arg0, arg1)
+}
+
+// ListFundingRoundAllocations mocks base method.
+func (m *MockStore) ListFundingRoundAllocations(arg0 context.Context, arg1 int64) ([]db.FundingRoundAllocations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFundingRoundAllocations", arg0, arg1)
+	ret0, _ := ret[0].([]db.FundingRoundAllocations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFundingRoundAllocations indicates an expected call of ListFundingRoundAllocations.
+func (mr *MockStoreMockRecorder) ListFundingRoundAllocations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFundingRoundAllocations", reflect.TypeOf((*MockStore)(nil).ListFundingRoundAllocations), arg0, arg1)
+}
+
+// ListFundingRoundBaselines mocks base method.
+func (m *MockStore) ListFundingRoundBaselines(arg0 context.Context, arg1 db.ListFundingRoundBaselinesParams) ([]db.FundingRoundBaselines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFundingRoundBaselines", arg0, arg1)
+	ret0, _ := ret[0].([]db.FundingRoundBaselines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFundingRoundBaselines indicates an expected call of ListFundingRoundBaselines.
+func (mr *MockStoreMockRecorder) ListFundingRoundBaselines(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFundingRoundBaselines", reflect.TypeOf((*MockStore)(nil).ListFundingRoundBaselines), arg0, arg1)
+}
+
+// ListFundingRoundContributions mocks base method.
+func (m *MockStore) ListFundingRoundContributions(arg0 context.Context, arg1 int64) ([]db.FundingRoundContributions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFundingRoundContributions", arg0, arg1)
+	ret0, _ := ret[0].([]db.FundingRoundContributions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFundingRoundContributions indicates an expected call of ListFundingRoundContributions.
+func (mr *MockStoreMockRecorder) ListFundingRoundContributions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFundingRoundContributions", reflect.TypeOf((*MockStore)(nil).ListFundingRoundContributions), arg0, arg1)
+}
+
+// ListFundingRounds mocks base method.
+func (m *MockStore) ListFundingRounds(arg0 context.Context, arg1 db.ListFundingRoundsParams) ([]db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFundingRounds", arg0, arg1)
+	ret0, _ := ret[0].([]db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFundingRounds indicates an expected call of ListFundingRounds.
+func (mr *MockStoreMockRecorder) ListFundingRounds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFundingRounds", reflect.TypeOf((*MockStore)(nil).ListFundingRounds), arg0, arg1)
+}
+
+// ListFundingRoundsByStatus mocks base method.
+func (m *MockStore) ListFundingRoundsByStatus(arg0 context.Context, arg1 db.ListFundingRoundsByStatusParams) ([]db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFundingRoundsByStatus", arg0, arg1)
+	ret0, _ := ret[0].([]db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFundingRoundsByStatus indicates an expected call of ListFundingRoundsByStatus.
+func (mr *MockStoreMockRecorder) ListFundingRoundsByStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFundingRoundsByStatus", reflect.TypeOf((*MockStore)(nil).ListFundingRoundsByStatus), arg0, arg1)
+}
+
+// ListIndexedCampaigns mocks base method.
+func (m *MockStore) ListIndexedCampaigns(arg0 context.Context, arg1 db.ListIndexedCampaignsParams) ([]db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIndexedCampaigns", arg0, arg1)
+	ret0, _ := ret[0].([]db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIndexedCampaigns indicates an expected call of ListIndexedCampaigns.
+func (mr *MockStoreMockRecorder) ListIndexedCampaigns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIndexedCampaigns", reflect.TypeOf((*MockStore)(nil).ListIndexedCampaigns), arg0, arg1)
+}
+
+// ListMatchableDonations mocks base method.
+func (m *MockStore) ListMatchableDonations(arg0 context.Context, arg1 db.ListMatchableDonationsParams) ([]db.DonationRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMatchableDonations", arg0, arg1)
+	ret0, _ := ret[0].([]db.DonationRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMatchableDonations indicates an expected call of ListMatchableDonations.
+func (mr *MockStoreMockRecorder) ListMatchableDonations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatchableDonations", reflect.TypeOf((*MockStore)(nil).ListMatchableDonations), arg0, arg1)
+}
+
+// ListMatchingPoolMatches mocks base method.
+func (m *MockStore) ListMatchingPoolMatches(arg0 context.Context, arg1 db.ListMatchingPoolMatchesParams) ([]db.MatchingPoolMatches, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMatchingPoolMatches", arg0, arg1)
+	ret0, _ := ret[0].([]db.MatchingPoolMatches)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMatchingPoolMatches indicates an expected call of ListMatchingPoolMatches.
+func (mr *MockStoreMockRecorder) ListMatchingPoolMatches(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatchingPoolMatches", reflect.TypeOf((*MockStore)(nil).ListMatchingPoolMatches), arg0, arg1)
+}
+
+// ListMatchingPools mocks base method.
+func (m *MockStore) ListMatchingPools(arg0 context.Context, arg1 db.ListMatchingPoolsParams) ([]db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMatchingPools", arg0, arg1)
+	ret0, _ := ret[0].([]db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMatchingPools indicates an expected call of ListMatchingPools.
+func (mr *MockStoreMockRecorder) ListMatchingPools(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMatchingPools", reflect.TypeOf((*MockStore)(nil).ListMatchingPools), arg0, arg1)
+}
+
+// ListPassedCampaignProposals mocks base method.
+func (m *MockStore) ListPassedCampaignProposals(arg0 context.Context, arg1 db.ListPassedCampaignProposalsParams) ([]db.CampaignProposals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPassedCampaignProposals", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignProposals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPassedCampaignProposals indicates an expected call of ListPassedCampaignProposals.
+func (mr *MockStoreMockRecorder) ListPassedCampaignProposals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPassedCampaignProposals", reflect.TypeOf((*MockStore)(nil).ListPassedCampaignProposals), arg0, arg1)
+}
+
+// ListPendingDonationRecords mocks base method.
+func (m *MockStore) ListPendingDonationRecords(arg0 context.Context, arg1 int32) ([]db.DonationRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingDonationRecords", arg0, arg1)
+	ret0, _ := ret[0].([]db.DonationRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingDonationRecords indicates an expected call of ListPendingDonationRecords.
+func (mr *MockStoreMockRecorder) ListPendingDonationRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingDonationRecords", reflect.TypeOf((*MockStore)(nil).ListPendingDonationRecords), arg0, arg1)
+}
+
+// ListPendingOnRampDonations mocks base method.
+func (m *MockStore) ListPendingOnRampDonations(arg0 context.Context, arg1 db.ListPendingOnRampDonationsParams) ([]db.OnrampOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOnRampDonations", arg0, arg1)
+	ret0, _ := ret[0].([]db.OnrampOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOnRampDonations indicates an expected call of ListPendingOnRampDonations.
+func (mr *MockStoreMockRecorder) ListPendingOnRampDonations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOnRampDonations", reflect.TypeOf((*MockStore)(nil).ListPendingOnRampDonations), arg0, arg1)
+}
+
+// ListPriceHistory mocks base method.
+func (m *MockStore) ListPriceHistory(arg0 context.Context, arg1 db.ListPriceHistoryParams) ([]db.PriceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPriceHistory", arg0, arg1)
+	ret0, _ := ret[0].([]db.PriceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPriceHistory indicates an expected call of ListPriceHistory.
+func (mr *MockStoreMockRecorder) ListPriceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPriceHistory", reflect.TypeOf((*MockStore)(nil).ListPriceHistory), arg0, arg1)
+}
+
+// ListRecurringDonationsByUser mocks base method.
+func (m *MockStore) ListRecurringDonationsByUser(arg0 context.Context, arg1 string) ([]db.RecurringDonations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecurringDonationsByUser", arg0, arg1)
+	ret0, _ := ret[0].([]db.RecurringDonations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecurringDonationsByUser indicates an expected call of ListRecurringDonationsByUser.
+func (mr *MockStoreMockRecorder) ListRecurringDonationsByUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecurringDonationsByUser", reflect.TypeOf((*MockStore)(nil).ListRecurringDonationsByUser), arg0, arg1)
+}
+
+// ListRewardTiers mocks base method.
+func (m *MockStore) ListRewardTiers(arg0 context.Context, arg1 db.ListRewardTiersParams) ([]db.RewardTiers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRewardTiers", arg0, arg1)
+	ret0, _ := ret[0].([]db.RewardTiers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRewardTiers indicates an expected call of ListRewardTiers.
+func (mr *MockStoreMockRecorder) ListRewardTiers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRewardTiers", reflect.TypeOf((*MockStore)(nil).ListRewardTiers), arg0, arg1)
+}
+
+// ListStaleBadgeMints mocks base method.
+func (m *MockStore) ListStaleBadgeMints(arg0 context.Context, arg1 db.ListStaleBadgeMintsParams) ([]db.BadgeMints, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaleBadgeMints", arg0, arg1)
+	ret0, _ := ret[0].([]db.BadgeMints)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaleBadgeMints indicates an expected call of ListStaleBadgeMints.
+func (mr *MockStoreMockRecorder) ListStaleBadgeMints(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaleBadgeMints", reflect.TypeOf((*MockStore)(nil).ListStaleBadgeMints), arg0, arg1)
+}
+
+// ListUndepositedCampaignEscrows mocks base method.
+func (m *MockStore) ListUndepositedCampaignEscrows(arg0 context.Context, arg1 db.ListUndepositedCampaignEscrowsParams) ([]db.CampaignEscrows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUndepositedCampaignEscrows", arg0, arg1)
+	ret0, _ := ret[0].([]db.CampaignEscrows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUndepositedCampaignEscrows indicates an expected call of ListUndepositedCampaignEscrows.
+func (mr *MockStoreMockRecorder) ListUndepositedCampaignEscrows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUndepositedCampaignEscrows", reflect.TypeOf((*MockStore)(nil).ListUndepositedCampaignEscrows), arg0, arg1)
+}
+
+// ListUnreconciledGasSponsorships mocks base method.
+func (m *MockStore) ListUnreconciledGasSponsorships(arg0 context.Context, arg1 int32) ([]db.GasSponsorships, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnreconciledGasSponsorships", arg0, arg1)
+	ret0, _ := ret[0].([]db.GasSponsorships)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnreconciledGasSponsorships indicates an expected call of ListUnreconciledGasSponsorships.
+func (mr *MockStoreMockRecorder) ListUnreconciledGasSponsorships(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnreconciledGasSponsorships", reflect.TypeOf((*MockStore)(nil).ListUnreconciledGasSponsorships), arg0, arg1)
+}
+
+// ListUnvaluedDonationRecords mocks base method.
+func (m *MockStore) ListUnvaluedDonationRecords(arg0 context.Context, arg1 int32) ([]db.DonationRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnvaluedDonationRecords", arg0, arg1)
+	ret0, _ := ret[0].([]db.DonationRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnvaluedDonationRecords indicates an expected call of ListUnvaluedDonationRecords.
+func (mr *MockStoreMockRecorder) ListUnvaluedDonationRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnvaluedDonationRecords", reflect.TypeOf((*MockStore)(nil).ListUnvaluedDonationRecords), arg0, arg1)
+}
+
+// ListUserBadges mocks base method.
+func (m *MockStore) ListUserBadges(arg0 context.Context, arg1 string) ([]db.ListUserBadgesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserBadges", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListUserBadgesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserBadges indicates an expected call of ListUserBadges.
+func (mr *MockStoreMockRecorder) ListUserBadges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserBadges", reflect.TypeOf((*MockStore)(nil).ListUserBadges), arg0, arg1)
+}
+
+// ListUserFaucetGrants mocks base method.
+func (m *MockStore) ListUserFaucetGrants(arg0 context.Context, arg1 string) ([]db.FaucetGrants, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserFaucetGrants", arg0, arg1)
+	ret0, _ := ret[0].([]db.FaucetGrants)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserFaucetGrants indicates an expected call of ListUserFaucetGrants.
+func (mr *MockStoreMockRecorder) ListUserFaucetGrants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserFaucetGrants", reflect.TypeOf((*MockStore)(nil).ListUserFaucetGrants), arg0, arg1)
+}
+
+// ListUserOnRampOrders mocks base method.
+func (m *MockStore) ListUserOnRampOrders(arg0 context.Context, arg1 string) ([]db.OnrampOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserOnRampOrders", arg0, arg1)
+	ret0, _ := ret[0].([]db.OnrampOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserOnRampOrders indicates an expected call of ListUserOnRampOrders.
+func (mr *MockStoreMockRecorder) ListUserOnRampOrders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOnRampOrders", reflect.TypeOf((*MockStore)(nil).ListUserOnRampOrders), arg0, arg1)
+}
+
+// ListUserRewardClaims mocks base method.
+func (m *MockStore) ListUserRewardClaims(arg0 context.Context, arg1 db.ListUserRewardClaimsParams) ([]db.RewardClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserRewardClaims", arg0, arg1)
+	ret0, _ := ret[0].([]db.RewardClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserRewardClaims indicates an expected call of ListUserRewardClaims.
+func (mr *MockStoreMockRecorder) ListUserRewardClaims(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRewardClaims", reflect.TypeOf((*MockStore)(nil).ListUserRewardClaims), arg0, arg1)
+}
+
+// LockFaucetIP mocks base method.
+func (m *MockStore) LockFaucetIP(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockFaucetIP", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockFaucetIP indicates an expected call of LockFaucetIP.
+func (mr *MockStoreMockRecorder) LockFaucetIP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockFaucetIP", reflect.TypeOf((*MockStore)(nil).LockFaucetIP), arg0, arg1)
+}
+
+// MarkBadgeMintSent mocks base method.
+func (m *MockStore) MarkBadgeMintSent(arg0 context.Context, arg1 db.MarkBadgeMintSentParams) (db.BadgeMints, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBadgeMintSent", arg0, arg1)
+	ret0, _ := ret[0].(db.BadgeMints)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkBadgeMintSent indicates an expected call of MarkBadgeMintSent.
+func (mr *MockStoreMockRecorder) MarkBadgeMintSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBadgeMintSent", reflect.TypeOf((*MockStore)(nil).MarkBadgeMintSent), arg0, arg1)
+}
+
+// MarkDonationRecordChecked mocks base method.
+func (m *MockStore) MarkDonationRecordChecked(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDonationRecordChecked", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDonationRecordChecked indicates an expected call of MarkDonationRecordChecked.
+func (mr *MockStoreMockRecorder) MarkDonationRecordChecked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDonationRecordChecked", reflect.TypeOf((*MockStore)(nil).MarkDonationRecordChecked), arg0, arg1)
+}
+
+// MarkDonationRecordUnpriceable mocks base method.
+func (m *MockStore) MarkDonationRecordUnpriceable(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDonationRecordUnpriceable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDonationRecordUnpriceable indicates an expected call of MarkDonationRecordUnpriceable.
+func (mr *MockStoreMockRecorder) MarkDonationRecordUnpriceable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDonationRecordUnpriceable", reflect.TypeOf((*MockStore)(nil).MarkDonationRecordUnpriceable), arg0, arg1)
+}
+
+// MarkFaucetGrantSent mocks base method.
+func (m *MockStore) MarkFaucetGrantSent(arg0 context.Context, arg1 db.MarkFaucetGrantSentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFaucetGrantSent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFaucetGrantSent indicates an expected call of MarkFaucetGrantSent.
+func (mr *MockStoreMockRecorder) MarkFaucetGrantSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFaucetGrantSent", reflect.TypeOf((*MockStore)(nil).MarkFaucetGrantSent), arg0, arg1)
+}
+
+// MarkFundingRoundAllocationSent mocks base method.
+func (m *MockStore) MarkFundingRoundAllocationSent(arg0 context.Context, arg1 db.MarkFundingRoundAllocationSentParams) (db.FundingRoundAllocations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFundingRoundAllocationSent", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRoundAllocations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkFundingRoundAllocationSent indicates an expected call of MarkFundingRoundAllocationSent.
+func (mr *MockStoreMockRecorder) MarkFundingRoundAllocationSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFundingRoundAllocationSent", reflect.TypeOf((*MockStore)(nil).MarkFundingRoundAllocationSent), arg0, arg1)
+}
+
+// MarkFundingRoundCalculated mocks base method.
+func (m *MockStore) MarkFundingRoundCalculated(arg0 context.Context, arg1 int64) (db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFundingRoundCalculated", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkFundingRoundCalculated indicates an expected call of MarkFundingRoundCalculated.
+func (mr *MockStoreMockRecorder) MarkFundingRoundCalculated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFundingRoundCalculated", reflect.TypeOf((*MockStore)(nil).MarkFundingRoundCalculated), arg0, arg1)
+}
+
+// MarkFundingRoundPaid mocks base method.
+func (m *MockStore) MarkFundingRoundPaid(arg0 context.Context, arg1 int64) (db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFundingRoundPaid", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkFundingRoundPaid indicates an expected call of MarkFundingRoundPaid.
+func (mr *MockStoreMockRecorder) MarkFundingRoundPaid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFundingRoundPaid", reflect.TypeOf((*MockStore)(nil).MarkFundingRoundPaid), arg0, arg1)
+}
+
+// MarkGasSponsorshipChecked mocks base method.
+func (m *MockStore) MarkGasSponsorshipChecked(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkGasSponsorshipChecked", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkGasSponsorshipChecked indicates an expected call of MarkGasSponsorshipChecked.
+func (mr *MockStoreMockRecorder) MarkGasSponsorshipChecked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkGasSponsorshipChecked", reflect.TypeOf((*MockStore)(nil).MarkGasSponsorshipChecked), arg0, arg1)
+}
+
+// MarkGasSponsorshipSent mocks base method.
+func (m *MockStore) MarkGasSponsorshipSent(arg0 context.Context, arg1 db.MarkGasSponsorshipSentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkGasSponsorshipSent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkGasSponsorshipSent indicates an expected call of MarkGasSponsorshipSent.
+func (mr *MockStoreMockRecorder) MarkGasSponsorshipSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkGasSponsorshipSent", reflect.TypeOf((*MockStore)(nil).MarkGasSponsorshipSent), arg0, arg1)
+}
+
+// MarkMatchingPoolMatchSent mocks base method.
+func (m *MockStore) MarkMatchingPoolMatchSent(arg0 context.Context, arg1 db.MarkMatchingPoolMatchSentParams) (db.MatchingPoolMatches, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMatchingPoolMatchSent", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPoolMatches)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkMatchingPoolMatchSent indicates an expected call of MarkMatchingPoolMatchSent.
+func (mr *MockStoreMockRecorder) MarkMatchingPoolMatchSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMatchingPoolMatchSent", reflect.TypeOf((*MockStore)(nil).MarkMatchingPoolMatchSent), arg0, arg1)
+}
+
+// OpenFundingRound mocks base method.
+func (m *MockStore) OpenFundingRound(arg0 context.Context, arg1 int64) (db.FundingRounds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenFundingRound", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRounds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenFundingRound indicates an expected call of OpenFundingRound.
+func (mr *MockStoreMockRecorder) OpenFundingRound(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenFundingRound", reflect.TypeOf((*MockStore)(nil).OpenFundingRound), arg0, arg1)
+}
+
+// ReconcileGasSponsorship mocks base method.
+func (m *MockStore) ReconcileGasSponsorship(arg0 context.Context, arg1 db.ReconcileGasSponsorshipParams) (db.GasSponsorships, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileGasSponsorship", arg0, arg1)
+	ret0, _ := ret[0].(db.GasSponsorships)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileGasSponsorship indicates an expected call of ReconcileGasSponsorship.
+func (mr *MockStoreMockRecorder) ReconcileGasSponsorship(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileGasSponsorship", reflect.TypeOf((*MockStore)(nil).ReconcileGasSponsorship), arg0, arg1)
+}
+
+// RecordRecurringDonationFailure mocks base method.
+func (m *MockStore) RecordRecurringDonationFailure(arg0 context.Context, arg1 db.RecordRecurringDonationFailureParams) (db.RecurringDonations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRecurringDonationFailure", arg0, arg1)
+	ret0, _ := ret[0].(db.RecurringDonations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordRecurringDonationFailure indicates an expected call of RecordRecurringDonationFailure.
+func (mr *MockStoreMockRecorder) RecordRecurringDonationFailure(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRecurringDonationFailure", reflect.TypeOf((*MockStore)(nil).RecordRecurringDonationFailure), arg0, arg1)
+}
+
+// RecordRecurringDonationRun mocks base method.
+func (m *MockStore) RecordRecurringDonationRun(arg0 context.Context, arg1 db.RecordRecurringDonationRunParams) (db.RecurringDonations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRecurringDonationRun", arg0, arg1)
+	ret0, _ := ret[0].(db.RecurringDonations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordRecurringDonationRun indicates an expected call of RecordRecurringDonationRun.
+func (mr *MockStoreMockRecorder) RecordRecurringDonationRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRecurringDonationRun", reflect.TypeOf((*MockStore)(nil).RecordRecurringDonationRun), arg0, arg1)
+}
+
+// RefundGasBudget mocks base method.
+func (m *MockStore) RefundGasBudget(arg0 context.Context, arg1 db.RefundGasBudgetParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundGasBudget", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundGasBudget indicates an expected call of RefundGasBudget.
+func (mr *MockStoreMockRecorder) RefundGasBudget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundGasBudget", reflect.TypeOf((*MockStore)(nil).RefundGasBudget), arg0, arg1)
+}
+
+// RejectCampaignMilestone mocks base method.
+func (m *MockStore) RejectCampaignMilestone(arg0 context.Context, arg1 db.RejectCampaignMilestoneParams) (db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectCampaignMilestone", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectCampaignMilestone indicates an expected call of RejectCampaignMilestone.
+func (mr *MockStoreMockRecorder) RejectCampaignMilestone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectCampaignMilestone", reflect.TypeOf((*MockStore)(nil).RejectCampaignMilestone), arg0, arg1)
+}
+
+// ReleaseCampaignMilestone mocks base method.
+func (m *MockStore) ReleaseCampaignMilestone(arg0 context.Context, arg1 db.ReleaseCampaignMilestoneParams) (db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseCampaignMilestone", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseCampaignMilestone indicates an expected call of ReleaseCampaignMilestone.
+func (mr *MockStoreMockRecorder) ReleaseCampaignMilestone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseCampaignMilestone", reflect.TypeOf((*MockStore)(nil).ReleaseCampaignMilestone), arg0, arg1)
+}
+
+// ReleaseCampaignProposalExecution mocks base method.
+func (m *MockStore) ReleaseCampaignProposalExecution(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseCampaignProposalExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseCampaignProposalExecution indicates an expected call of ReleaseCampaignProposalExecution.
+func (mr *MockStoreMockRecorder) ReleaseCampaignProposalExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseCampaignProposalExecution", reflect.TypeOf((*MockStore)(nil).ReleaseCampaignProposalExecution), arg0, arg1)
+}
+
+// ReleaseFundingRoundAllocation mocks base method.
+func (m *MockStore) ReleaseFundingRoundAllocation(arg0 context.Context, arg1 db.ReleaseFundingRoundAllocationParams) (db.FundingRoundAllocations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseFundingRoundAllocation", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRoundAllocations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseFundingRoundAllocation indicates an expected call of ReleaseFundingRoundAllocation.
+func (mr *MockStoreMockRecorder) ReleaseFundingRoundAllocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseFundingRoundAllocation", reflect.TypeOf((*MockStore)(nil).ReleaseFundingRoundAllocation), arg0, arg1)
+}
+
+// ReleaseGasBudget mocks base method.
+func (m *MockStore) ReleaseGasBudget(arg0 context.Context, arg1 db.ReleaseGasBudgetParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseGasBudget", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseGasBudget indicates an expected call of ReleaseGasBudget.
+func (mr *MockStoreMockRecorder) ReleaseGasBudget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseGasBudget", reflect.TypeOf((*MockStore)(nil).ReleaseGasBudget), arg0, arg1)
+}
+
+// ReleaseMatchingPoolFunds mocks base method.
+func (m *MockStore) ReleaseMatchingPoolFunds(arg0 context.Context, arg1 db.ReleaseMatchingPoolFundsParams) (db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseMatchingPoolFunds", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseMatchingPoolFunds indicates an expected call of ReleaseMatchingPoolFunds.
+func (mr *MockStoreMockRecorder) ReleaseMatchingPoolFunds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseMatchingPoolFunds", reflect.TypeOf((*MockStore)(nil).ReleaseMatchingPoolFunds), arg0, arg1)
+}
+
+// ReleaseRewardTier mocks base method.
+func (m *MockStore) ReleaseRewardTier(arg0 context.Context, arg1 int64) (db.RewardTiers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRewardTier", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardTiers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseRewardTier indicates an expected call of ReleaseRewardTier.
+func (mr *MockStoreMockRecorder) ReleaseRewardTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRewardTier", reflect.TypeOf((*MockStore)(nil).ReleaseRewardTier), arg0, arg1)
+}
+
+// ReserveGasBudget mocks base method.
+func (m *MockStore) ReserveGasBudget(arg0 context.Context, arg1 db.ReserveGasBudgetParams) (db.GasBudgets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveGasBudget", arg0, arg1)
+	ret0, _ := ret[0].(db.GasBudgets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveGasBudget indicates an expected call of ReserveGasBudget.
+func (mr *MockStoreMockRecorder) ReserveGasBudget(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveGasBudget", reflect.TypeOf((*MockStore)(nil).ReserveGasBudget), arg0, arg1)
+}
+
+// ReserveMatchingPoolFunds mocks base method.
+func (m *MockStore) ReserveMatchingPoolFunds(arg0 context.Context, arg1 db.ReserveMatchingPoolFundsParams) (db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveMatchingPoolFunds", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveMatchingPoolFunds indicates an expected call of ReserveMatchingPoolFunds.
+func (mr *MockStoreMockRecorder) ReserveMatchingPoolFunds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveMatchingPoolFunds", reflect.TypeOf((*MockStore)(nil).ReserveMatchingPoolFunds), arg0, arg1)
+}
+
+// ReserveRewardTier mocks base method.
+func (m *MockStore) ReserveRewardTier(arg0 context.Context, arg1 int64) (db.RewardTiers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveRewardTier", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardTiers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveRewardTier indicates an expected call of ReserveRewardTier.
+func (mr *MockStoreMockRecorder) ReserveRewardTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveRewardTier", reflect.TypeOf((*MockStore)(nil).ReserveRewardTier), arg0, arg1)
+}
+
+// SearchCampaignsEndingSoon mocks base method.
+func (m *MockStore) SearchCampaignsEndingSoon(arg0 context.Context, arg1 db.SearchCampaignsEndingSoonParams) ([]db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCampaignsEndingSoon", arg0, arg1)
+	ret0, _ := ret[0].([]db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCampaignsEndingSoon indicates an expected call of SearchCampaignsEndingSoon.
+func (mr *MockStoreMockRecorder) SearchCampaignsEndingSoon(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCampaignsEndingSoon", reflect.TypeOf((*MockStore)(nil).SearchCampaignsEndingSoon), arg0, arg1)
+}
+
+// SearchCampaignsMostFunded mocks base method.
+func (m *MockStore) SearchCampaignsMostFunded(arg0 context.Context, arg1 db.SearchCampaignsMostFundedParams) ([]db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCampaignsMostFunded", arg0, arg1)
+	ret0, _ := ret[0].([]db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCampaignsMostFunded indicates an expected call of SearchCampaignsMostFunded.
+func (mr *MockStoreMockRecorder) SearchCampaignsMostFunded(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCampaignsMostFunded", reflect.TypeOf((*MockStore)(nil).SearchCampaignsMostFunded), arg0, arg1)
+}
+
+// SearchCampaignsNewest mocks base method.
+func (m *MockStore) SearchCampaignsNewest(arg0 context.Context, arg1 db.SearchCampaignsNewestParams) ([]db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCampaignsNewest", arg0, arg1)
+	ret0, _ := ret[0].([]db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCampaignsNewest indicates an expected call of SearchCampaignsNewest.
+func (mr *MockStoreMockRecorder) SearchCampaignsNewest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCampaignsNewest", reflect.TypeOf((*MockStore)(nil).SearchCampaignsNewest), arg0, arg1)
+}
+
+// SetBadgeSyncBlock mocks base method.
+func (m *MockStore) SetBadgeSyncBlock(arg0 context.Context, arg1 db.SetBadgeSyncBlockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBadgeSyncBlock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBadgeSyncBlock indicates an expected call of SetBadgeSyncBlock.
+func (mr *MockStoreMockRecorder) SetBadgeSyncBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBadgeSyncBlock", reflect.TypeOf((*MockStore)(nil).SetBadgeSyncBlock), arg0, arg1)
+}
+
+// SetCampaignCurrency mocks base method.
+func (m *MockStore) SetCampaignCurrency(arg0 context.Context, arg1 db.SetCampaignCurrencyParams) (db.CampaignCurrencies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCampaignCurrency", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignCurrencies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCampaignCurrency indicates an expected call of SetCampaignCurrency.
+func (mr *MockStoreMockRecorder) SetCampaignCurrency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCampaignCurrency", reflect.TypeOf((*MockStore)(nil).SetCampaignCurrency), arg0, arg1)
+}
+
+// SetCampaignEscrowDeposit mocks base method.
+func (m *MockStore) SetCampaignEscrowDeposit(arg0 context.Context, arg1 db.SetCampaignEscrowDepositParams) (db.CampaignEscrows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCampaignEscrowDeposit", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignEscrows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCampaignEscrowDeposit indicates an expected call of SetCampaignEscrowDeposit.
+func (mr *MockStoreMockRecorder) SetCampaignEscrowDeposit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCampaignEscrowDeposit", reflect.TypeOf((*MockStore)(nil).SetCampaignEscrowDeposit), arg0, arg1)
+}
+
+// SetCampaignProposalExecutionTx mocks base method.
+func (m *MockStore) SetCampaignProposalExecutionTx(arg0 context.Context, arg1 db.SetCampaignProposalExecutionTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCampaignProposalExecutionTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCampaignProposalExecutionTx indicates an expected call of SetCampaignProposalExecutionTx.
+func (mr *MockStoreMockRecorder) SetCampaignProposalExecutionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCampaignProposalExecutionTx", reflect.TypeOf((*MockStore)(nil).SetCampaignProposalExecutionTx), arg0, arg1)
+}
+
+// SetDonationRecordFiat mocks base method.
+func (m *MockStore) SetDonationRecordFiat(arg0 context.Context, arg1 db.SetDonationRecordFiatParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDonationRecordFiat", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDonationRecordFiat indicates an expected call of SetDonationRecordFiat.
+func (mr *MockStoreMockRecorder) SetDonationRecordFiat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDonationRecordFiat", reflect.TypeOf((*MockStore)(nil).SetDonationRecordFiat), arg0, arg1)
+}
+
+// SetGasSponsorshipAction mocks base method.
+func (m *MockStore) SetGasSponsorshipAction(arg0 context.Context, arg1 db.SetGasSponsorshipActionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGasSponsorshipAction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetGasSponsorshipAction indicates an expected call of SetGasSponsorshipAction.
+func (mr *MockStoreMockRecorder) SetGasSponsorshipAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGasSponsorshipAction", reflect.TypeOf((*MockStore)(nil).SetGasSponsorshipAction), arg0, arg1)
+}
+
+// SetMatchingPoolRefund mocks base method.
+func (m *MockStore) SetMatchingPoolRefund(arg0 context.Context, arg1 db.SetMatchingPoolRefundParams) (db.MatchingPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMatchingPoolRefund", arg0, arg1)
+	ret0, _ := ret[0].(db.MatchingPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMatchingPoolRefund indicates an expected call of SetMatchingPoolRefund.
+func (mr *MockStoreMockRecorder) SetMatchingPoolRefund(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMatchingPoolRefund", reflect.TypeOf((*MockStore)(nil).SetMatchingPoolRefund), arg0, arg1)
+}
+
+// SetOnRampOrderCheckout mocks base method.
+func (m *MockStore) SetOnRampOrderCheckout(arg0 context.Context, arg1 db.SetOnRampOrderCheckoutParams) (db.OnrampOrders, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOnRampOrderCheckout", arg0, arg1)
+	ret0, _ := ret[0].(db.OnrampOrders)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOnRampOrderCheckout indicates an expected call of SetOnRampOrderCheckout.
+func (mr *MockStoreMockRecorder) SetOnRampOrderCheckout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOnRampOrderCheckout", reflect.TypeOf((*MockStore)(nil).SetOnRampOrderCheckout), arg0, arg1)
+}
+
+// SoftDeleteUserWallet mocks base method.
+func (m *MockStore) SoftDeleteUserWallet(arg0 context.Context, arg1 db.SoftDeleteUserWalletParams) (db.UserWalletAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteUserWallet", arg0, arg1)
+	ret0, _ := ret[0].(db.UserWalletAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SoftDeleteUserWallet indicates an expected call of SoftDeleteUserWallet.
+func (mr *MockStoreMockRecorder) SoftDeleteUserWallet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteUserWallet", reflect.TypeOf((*MockStore)(nil).SoftDeleteUserWallet), arg0, arg1)
+}
+
+// SubmitCampaignMilestone mocks base method.
+func (m *MockStore) SubmitCampaignMilestone(arg0 context.Context, arg1 db.SubmitCampaignMilestoneParams) (db.CampaignMilestones, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCampaignMilestone", arg0, arg1)
+	ret0, _ := ret[0].(db.CampaignMilestones)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCampaignMilestone indicates an expected call of SubmitCampaignMilestone.
+func (mr *MockStoreMockRecorder) SubmitCampaignMilestone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCampaignMilestone", reflect.TypeOf((*MockStore)(nil).SubmitCampaignMilestone), arg0, arg1)
+}
+
+// SumCampaignDonationRecords mocks base method.
+func (m *MockStore) SumCampaignDonationRecords(arg0 context.Context, arg1 db.SumCampaignDonationRecordsParams) (db.SumCampaignDonationRecordsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumCampaignDonationRecords", arg0, arg1)
+	ret0, _ := ret[0].(db.SumCampaignDonationRecordsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumCampaignDonationRecords indicates an expected call of SumCampaignDonationRecords.
+func (mr *MockStoreMockRecorder) SumCampaignDonationRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumCampaignDonationRecords", reflect.TypeOf((*MockStore)(nil).SumCampaignDonationRecords), arg0, arg1)
+}
+
+// SumDonationRecordsByCampaign mocks base method.
+func (m *MockStore) SumDonationRecordsByCampaign(arg0 context.Context, arg1 []string) ([]db.SumDonationRecordsByCampaignRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumDonationRecordsByCampaign", arg0, arg1)
+	ret0, _ := ret[0].([]db.SumDonationRecordsByCampaignRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumDonationRecordsByCampaign indicates an expected call of SumDonationRecordsByCampaign.
+func (mr *MockStoreMockRecorder) SumDonationRecordsByCampaign(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumDonationRecordsByCampaign", reflect.TypeOf((*MockStore)(nil).SumDonationRecordsByCampaign), arg0, arg1)
+}
+
+// SumDonorCampaignDonationRecords mocks base method.
+func (m *MockStore) SumDonorCampaignDonationRecords(arg0 context.Context, arg1 db.SumDonorCampaignDonationRecordsParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumDonorCampaignDonationRecords", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumDonorCampaignDonationRecords indicates an expected call of SumDonorCampaignDonationRecords.
+func (mr *MockStoreMockRecorder) SumDonorCampaignDonationRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumDonorCampaignDonationRecords", reflect.TypeOf((*MockStore)(nil).SumDonorCampaignDonationRecords), arg0, arg1)
+}
+
+// SumGasSponsored mocks base method.
+func (m *MockStore) SumGasSponsored(arg0 context.Context, arg1 db.SumGasSponsoredParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumGasSponsored", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumGasSponsored indicates an expected call of SumGasSponsored.
+func (mr *MockStoreMockRecorder) SumGasSponsored(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumGasSponsored", reflect.TypeOf((*MockStore)(nil).SumGasSponsored), arg0, arg1)
+}
+
+// SumMatchingPoolMatches mocks base method.
+func (m *MockStore) SumMatchingPoolMatches(arg0 context.Context, arg1 int64) ([]db.SumMatchingPoolMatchesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumMatchingPoolMatches", arg0, arg1)
+	ret0, _ := ret[0].([]db.SumMatchingPoolMatchesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumMatchingPoolMatches indicates an expected call of SumMatchingPoolMatches.
+func (mr *MockStoreMockRecorder) SumMatchingPoolMatches(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumMatchingPoolMatches", reflect.TypeOf((*MockStore)(nil).SumMatchingPoolMatches), arg0, arg1)
+}
+
+// SumMilestoneVotes mocks base method.
+func (m *MockStore) SumMilestoneVotes(arg0 context.Context, arg1 int64) (db.SumMilestoneVotesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumMilestoneVotes", arg0, arg1)
+	ret0, _ := ret[0].(db.SumMilestoneVotesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumMilestoneVotes indicates an expected call of SumMilestoneVotes.
+func (mr *MockStoreMockRecorder) SumMilestoneVotes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumMilestoneVotes", reflect.TypeOf((*MockStore)(nil).SumMilestoneVotes), arg0, arg1)
+}
+
+// SumProposalVotes mocks base method.
+func (m *MockStore) SumProposalVotes(arg0 context.Context, arg1 int64) (db.SumProposalVotesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumProposalVotes", arg0, arg1)
+	ret0, _ := ret[0].(db.SumProposalVotesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumProposalVotes indicates an expected call of SumProposalVotes.
+func (mr *MockStoreMockRecorder) SumProposalVotes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumProposalVotes", reflect.TypeOf((*MockStore)(nil).SumProposalVotes), arg0, arg1)
+}
+
+// SummarizeGasSponsorships mocks base method.
+func (m *MockStore) SummarizeGasSponsorships(arg0 context.Context, arg1 time.Time) ([]db.SummarizeGasSponsorshipsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeGasSponsorships", arg0, arg1)
+	ret0, _ := ret[0].([]db.SummarizeGasSponsorshipsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeGasSponsorships indicates an expected call of SummarizeGasSponsorships.
+func (mr *MockStoreMockRecorder) SummarizeGasSponsorships(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeGasSponsorships", reflect.TypeOf((*MockStore)(nil).SummarizeGasSponsorships), arg0, arg1)
+}
+
+// UpdateDonationRecordStatus mocks base method.
+func (m *MockStore) UpdateDonationRecordStatus(arg0 context.Context, arg1 db.UpdateDonationRecordStatusParams) (db.DonationRecords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDonationRecordStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.DonationRecords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDonationRecordStatus indicates an expected call of UpdateDonationRecordStatus.
+func (mr *MockStoreMockRecorder) UpdateDonationRecordStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDonationRecordStatus", reflect.TypeOf((*MockStore)(nil).UpdateDonationRecordStatus), arg0, arg1)
+}
+
+// UpdateGasSponsorshipStatus mocks base method.
+func (m *MockStore) UpdateGasSponsorshipStatus(arg0 context.Context, arg1 db.UpdateGasSponsorshipStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGasSponsorshipStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGasSponsorshipStatus indicates an expected call of UpdateGasSponsorshipStatus.
+func (mr *MockStoreMockRecorder) UpdateGasSponsorshipStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGasSponsorshipStatus", reflect.TypeOf((*MockStore)(nil).UpdateGasSponsorshipStatus), arg0, arg1)
+}
+
+// UpdateIndexedCampaignDeadline mocks base method.
+func (m *MockStore) UpdateIndexedCampaignDeadline(arg0 context.Context, arg1 db.UpdateIndexedCampaignDeadlineParams) (db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIndexedCampaignDeadline", arg0, arg1)
+	ret0, _ := ret[0].(db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIndexedCampaignDeadline indicates an expected call of UpdateIndexedCampaignDeadline.
+func (mr *MockStoreMockRecorder) UpdateIndexedCampaignDeadline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIndexedCampaignDeadline", reflect.TypeOf((*MockStore)(nil).UpdateIndexedCampaignDeadline), arg0, arg1)
+}
+
+// UpdateOnRampDonation mocks base method.
+func (m *MockStore) UpdateOnRampDonation(arg0 context.Context, arg1 db.UpdateOnRampDonationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOnRampDonation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOnRampDonation indicates an expected call of UpdateOnRampDonation.
+func (mr *MockStoreMockRecorder) UpdateOnRampDonation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOnRampDonation", reflect.TypeOf((*MockStore)(nil).UpdateOnRampDonation), arg0, arg1)
+}
+
+// UpdateRecurringDonationStatus mocks base method.
+func (m *MockStore) UpdateRecurringDonationStatus(arg0 context.Context, arg1 db.UpdateRecurringDonationStatusParams) (db.RecurringDonations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurringDonationStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.RecurringDonations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecurringDonationStatus indicates an expected call of UpdateRecurringDonationStatus.
+func (mr *MockStoreMockRecorder) UpdateRecurringDonationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurringDonationStatus", reflect.TypeOf((*MockStore)(nil).UpdateRecurringDonationStatus), arg0, arg1)
+}
+
+// UpdateRewardClaimStatus mocks base method.
+func (m *MockStore) UpdateRewardClaimStatus(arg0 context.Context, arg1 db.UpdateRewardClaimStatusParams) (db.RewardClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRewardClaimStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.RewardClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRewardClaimStatus indicates an expected call of UpdateRewardClaimStatus.
+func (mr *MockStoreMockRecorder) UpdateRewardClaimStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRewardClaimStatus", reflect.TypeOf((*MockStore)(nil).UpdateRewardClaimStatus), arg0, arg1)
+}
+
+// UpdateUser mocks base method.
+func (m *MockStore) UpdateUser(arg0 context.Context, arg1 db.UpdateUserParams) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockStoreMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), arg0, arg1)
+}
+
+// UpdateUserPreferredCurrency mocks base method.
+func (m *MockStore) UpdateUserPreferredCurrency(arg0 context.Context, arg1 db.UpdateUserPreferredCurrencyParams) (db.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPreferredCurrency", arg0, arg1)
+	ret0, _ := ret[0].(db.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserPreferredCurrency indicates an expected call of UpdateUserPreferredCurrency.
+func (mr *MockStoreMockRecorder) UpdateUserPreferredCurrency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPreferredCurrency", reflect.TypeOf((*MockStore)(nil).UpdateUserPreferredCurrency), arg0, arg1)
+}
+
+// UpdateUserWalletStatus mocks base method.
+func (m *MockStore) UpdateUserWalletStatus(arg0 context.Context, arg1 db.UpdateUserWalletStatusParams) (db.UserWalletAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserWalletStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.UserWalletAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserWalletStatus indicates an expected call of UpdateUserWalletStatus.
+func (mr *MockStoreMockRecorder) UpdateUserWalletStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserWalletStatus", reflect.TypeOf((*MockStore)(nil).UpdateUserWalletStatus), arg0, arg1)
+}
+
+// UpsertFundingRoundAllocation mocks base method.
+func (m *MockStore) UpsertFundingRoundAllocation(arg0 context.Context, arg1 db.UpsertFundingRoundAllocationParams) (db.FundingRoundAllocations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFundingRoundAllocation", arg0, arg1)
+	ret0, _ := ret[0].(db.FundingRoundAllocations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertFundingRoundAllocation indicates an expected call of UpsertFundingRoundAllocation.
+func (mr *MockStoreMockRecorder) UpsertFundingRoundAllocation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFundingRoundAllocation", reflect.TypeOf((*MockStore)(nil).UpsertFundingRoundAllocation), arg0, arg1)
+}
+
+// UpsertFundingRoundContribution mocks base method.
+func (m *MockStore) UpsertFundingRoundContribution(arg0 context.Context, arg1 db.UpsertFundingRoundContributionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFundingRoundContribution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFundingRoundContribution indicates an expected call of UpsertFundingRoundContribution.
+func (mr *MockStoreMockRecorder) UpsertFundingRoundContribution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFundingRoundContribution", reflect.TypeOf((*MockStore)(nil).UpsertFundingRoundContribution), arg0, arg1)
+}
+
+// UpsertIndexedCampaign mocks base method.
+func (m *MockStore) UpsertIndexedCampaign(arg0 context.Context, arg1 db.UpsertIndexedCampaignParams) (db.IndexedCampaigns, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertIndexedCampaign", arg0, arg1)
+	ret0, _ := ret[0].(db.IndexedCampaigns)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertIndexedCampaign indicates an expected call of UpsertIndexedCampaign.
+func (mr *MockStoreMockRecorder) UpsertIndexedCampaign(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertIndexedCampaign", reflect.TypeOf((*MockStore)(nil).UpsertIndexedCampaign), arg0, arg1)
+}
+
+// UpsertMilestoneVote mocks base method.
+func (m *MockStore) UpsertMilestoneVote(arg0 context.Context, arg1 db.UpsertMilestoneVoteParams) (db.MilestoneVotes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMilestoneVote", arg0, arg1)
+	ret0, _ := ret[0].(db.MilestoneVotes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertMilestoneVote indicates an expected call of UpsertMilestoneVote.
+func (mr *MockStoreMockRecorder) UpsertMilestoneVote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMilestoneVote", reflect.TypeOf((*MockStore)(nil).UpsertMilestoneVote), arg0, arg1)
+}
+
+// UpsertProposalVote mocks base method.
+func (m *MockStore) UpsertProposalVote(arg0 context.Context, arg1 db.UpsertProposalVoteParams) (db.ProposalVotes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProposalVote", arg0, arg1)
+	ret0, _ := ret[0].(db.ProposalVotes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProposalVote indicates an expected call of UpsertProposalVote.
+func (mr *MockStoreMockRecorder) UpsertProposalVote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProposalVote", reflect.TypeOf((*MockStore)(nil).UpsertProposalVote), arg0, arg1)
 }
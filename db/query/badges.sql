@@ -0,0 +1,139 @@
+-- name: EnsureBadge :one
+
+INSERT INTO badges (
+    chain_id,
+    contract_version,
+    campaign_id,
+    tier_id,
+    name,
+    description,
+    image
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (chain_id, contract_version, campaign_id, tier_id) DO UPDATE SET name = badges.name
+RETURNING *;
+
+-- name: GetBadge :one
+
+SELECT * FROM badges WHERE id = $1 LIMIT 1;
+
+-- name: ListBadgeableDonations :many
+
+SELECT
+    d.id,
+    d.contract_version,
+    d.campaign_id,
+    d.donor_address,
+    COALESCE(r.tier_id, 0)::bigint AS tier_id
+FROM donation_records d
+LEFT JOIN reward_claims r ON r.tx_hash = d.tx_hash AND r.status <> 'cancelled'
+WHERE d.chain_id = sqlc.arg('chain_id')
+    AND d.status = 'confirmed'
+    AND NOT EXISTS (
+        SELECT 1 FROM matching_pools w WHERE lower(w.wallet_address) = lower(d.donor_address)
+    )
+    AND (
+        NOT EXISTS (
+            SELECT 1 FROM badge_mints m
+            JOIN badges b ON b.id = m.badge_id
+            WHERE b.chain_id = d.chain_id
+                AND b.contract_version = d.contract_version
+                AND b.campaign_id = d.campaign_id
+                AND b.tier_id = 0
+                AND m.recipient = lower(d.donor_address)
+        )
+        OR (r.tier_id IS NOT NULL AND NOT EXISTS (
+            SELECT 1 FROM badge_mints m
+            JOIN badges b ON b.id = m.badge_id
+            WHERE b.chain_id = d.chain_id
+                AND b.contract_version = d.contract_version
+                AND b.campaign_id = d.campaign_id
+                AND b.tier_id = r.tier_id
+                AND m.recipient = lower(d.donor_address)
+        ))
+    )
+ORDER BY d.id
+LIMIT sqlc.arg('limit');
+
+-- name: ClaimBadgeMint :one
+
+INSERT INTO badge_mints (
+    badge_id,
+    recipient,
+    donation_record_id
+) VALUES ($1, $2, $3)
+ON CONFLICT (badge_id, recipient) DO NOTHING
+RETURNING *;
+
+-- name: MarkBadgeMintSent :one
+
+UPDATE badge_mints
+SET status = 'sent', tx_hash = $2
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: ConfirmBadgeMint :execrows
+
+UPDATE badge_mints
+SET status = 'confirmed', tx_hash = $3, block_number = $4, minted_at = now()
+WHERE badge_id = $1 AND recipient = $2 AND status <> 'confirmed';
+
+-- name: DeleteBadgeMint :exec
+
+DELETE FROM badge_mints WHERE id = $1 AND status <> 'confirmed';
+
+-- name: ListStaleBadgeMints :many
+
+SELECT
+    m.id,
+    m.badge_id,
+    m.recipient,
+    m.donation_record_id,
+    m.status,
+    m.tx_hash,
+    m.block_number,
+    m.created_at,
+    m.minted_at
+FROM badge_mints m
+JOIN badges b ON b.id = m.badge_id
+WHERE b.chain_id = sqlc.arg('chain_id')
+    AND m.status <> 'confirmed'
+    AND m.created_at < sqlc.arg('before')
+ORDER BY m.id
+LIMIT sqlc.arg('limit');
+
+-- name: GetBadgeSyncBlock :one
+
+SELECT block_number FROM badge_sync
+WHERE chain_id = $1 AND contract_address = $2;
+
+-- name: SetBadgeSyncBlock :exec
+
+INSERT INTO badge_sync (chain_id, contract_address, block_number)
+VALUES ($1, $2, $3)
+ON CONFLICT (chain_id, contract_address) DO UPDATE SET block_number = EXCLUDED.block_number;
+
+-- name: ListUserBadges :many
+
+SELECT
+    b.id,
+    b.chain_id,
+    b.contract_version,
+    b.campaign_id,
+    b.tier_id,
+    b.name,
+    b.description,
+    b.image,
+    m.recipient,
+    m.status,
+    m.tx_hash,
+    m.minted_at
+FROM badge_mints m
+JOIN badges b ON b.id = m.badge_id
+WHERE m.status <> 'pending'
+    AND m.recipient IN (
+        SELECT lower(u.address) FROM users u WHERE u.username = sqlc.arg('username')
+        UNION
+        SELECT lower(w.wallet_address) FROM user_wallet_addresses w
+        WHERE w.user_id = sqlc.arg('username') AND w.status = 'active' AND w.deleted_at IS NULL
+    )
+ORDER BY m.id DESC;
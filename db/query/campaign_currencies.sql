@@ -0,0 +1,23 @@
+-- name: SetCampaignCurrency :one
+
+INSERT INTO campaign_currencies (
+    chain_id,
+    contract_version,
+    campaign_id,
+    currency
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (chain_id, contract_version, campaign_id) DO UPDATE SET
+    currency = EXCLUDED.currency,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetCampaignCurrency :one
+
+SELECT * FROM campaign_currencies WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3 LIMIT 1;
+
+-- name: ListCampaignCurrencies :many
+
+SELECT * FROM campaign_currencies
+WHERE chain_id = sqlc.arg('chain_id')
+    AND contract_version = sqlc.arg('contract_version')
+    AND campaign_id = ANY(sqlc.arg('campaign_ids')::bigint[]);
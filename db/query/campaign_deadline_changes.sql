@@ -0,0 +1,20 @@
+-- name: CreateCampaignDeadlineChange :one
+
+INSERT INTO campaign_deadline_changes (
+    chain_id,
+    contract_version,
+    campaign_id,
+    kind,
+    previous_deadline,
+    deadline,
+    tx_hash,
+    proposal_id,
+    created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: ListCampaignDeadlineChanges :many
+
+SELECT * FROM campaign_deadline_changes
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3
+ORDER BY created_at, id;
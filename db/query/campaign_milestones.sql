@@ -0,0 +1,157 @@
+-- name: CreateCampaignMilestones :many
+
+INSERT INTO campaign_milestones (
+    chain_id,
+    contract_version,
+    campaign_id,
+    position,
+    title,
+    description,
+    amount
+)
+SELECT
+    sqlc.arg('chain_id')::bigint,
+    sqlc.arg('contract_version')::int,
+    sqlc.arg('campaign_id')::bigint,
+    m.position,
+    m.title,
+    m.description,
+    m.amount
+FROM unnest(
+    sqlc.arg('titles')::text[],
+    sqlc.arg('descriptions')::text[],
+    sqlc.arg('amounts')::numeric[]
+) WITH ORDINALITY AS m (title, description, amount, position)
+RETURNING *;
+
+-- name: GetCampaignMilestone :one
+
+SELECT * FROM campaign_milestones WHERE id = $1 LIMIT 1;
+
+-- name: ListCampaignMilestones :many
+
+SELECT * FROM campaign_milestones
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3
+ORDER BY position;
+
+-- name: SubmitCampaignMilestone :one
+
+UPDATE campaign_milestones SET
+    status = 'submitted',
+    evidence = $2,
+    evidence_url = $3,
+    submitted_at = now()
+WHERE id = $1 AND status IN ('pending', 'rejected')
+RETURNING *;
+
+-- name: ApproveCampaignMilestone :one
+
+UPDATE campaign_milestones SET
+    status = 'approved',
+    approved_by = $2,
+    approved_at = now()
+WHERE id = $1 AND status = 'submitted'
+RETURNING *;
+
+-- name: RejectCampaignMilestone :one
+
+UPDATE campaign_milestones SET
+    status = 'rejected',
+    approved_by = $2
+WHERE id = $1 AND status = 'submitted'
+RETURNING *;
+
+-- name: ReleaseCampaignMilestone :one
+
+UPDATE campaign_milestones SET
+    status = 'released',
+    release_tx_hash = $2,
+    released_at = now()
+WHERE id = $1 AND status = 'approved'
+RETURNING *;
+
+-- name: ListApprovedCampaignMilestones :many
+
+SELECT * FROM campaign_milestones
+WHERE chain_id = $1 AND contract_version = $2 AND status = 'approved'
+ORDER BY campaign_id, position;
+
+-- name: UpsertMilestoneVote :one
+
+INSERT INTO milestone_votes (
+    milestone_id,
+    voter_address,
+    approve,
+    weight
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (milestone_id, voter_address) DO UPDATE SET
+    approve = EXCLUDED.approve,
+    weight = EXCLUDED.weight,
+    created_at = now()
+RETURNING *;
+
+-- name: SumMilestoneVotes :one
+
+SELECT
+    COALESCE(sum(weight) FILTER (WHERE approve), 0)::text AS approve_weight,
+    COALESCE(sum(weight) FILTER (WHERE NOT approve), 0)::text AS reject_weight,
+    count(*) AS vote_count
+FROM milestone_votes
+WHERE milestone_id = $1;
+
+-- name: DeleteMilestoneVotes :exec
+
+DELETE FROM milestone_votes WHERE milestone_id = $1;
+
+-- name: CreateCampaignEscrow :one
+
+INSERT INTO campaign_escrows (
+    chain_id,
+    contract_version,
+    campaign_id,
+    amount,
+    payout_tx_hash
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetCampaignEscrow :one
+
+SELECT * FROM campaign_escrows WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3 LIMIT 1;
+
+-- name: ListUndepositedCampaignEscrows :many
+
+SELECT * FROM campaign_escrows
+WHERE chain_id = $1 AND contract_version = $2 AND deposit_tx_hash IS NULL
+ORDER BY campaign_id;
+
+-- name: DeleteCampaignEscrow :exec
+
+DELETE FROM campaign_escrows WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3;
+
+-- name: SetCampaignEscrowDeposit :one
+
+UPDATE campaign_escrows SET deposit_tx_hash = $4
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3
+RETURNING *;
+
+-- name: CountOwnerUnescrowedCampaigns :one
+
+SELECT count(*) FROM indexed_campaigns c
+WHERE c.owner = $1
+    AND EXISTS (
+        SELECT 1 FROM campaign_milestones m
+        WHERE m.chain_id = c.chain_id AND m.contract_version = c.contract_version AND m.campaign_id = c.campaign_id
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM campaign_escrows e
+        WHERE e.chain_id = c.chain_id AND e.contract_version = c.contract_version AND e.campaign_id = c.campaign_id
+            AND e.deposit_tx_hash IS NOT NULL
+    )
+    AND (
+        c.deadline > now()
+        OR c.total_funds >= c.goal
+        OR EXISTS (
+            SELECT 1 FROM campaign_escrows e
+            WHERE e.chain_id = c.chain_id AND e.contract_version = c.contract_version AND e.campaign_id = c.campaign_id
+        )
+    );
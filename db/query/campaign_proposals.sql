@@ -0,0 +1,105 @@
+-- name: CreateCampaignProposal :one
+
+INSERT INTO campaign_proposals (
+    chain_id,
+    contract_version,
+    campaign_id,
+    kind,
+    title,
+    description,
+    milestone_id,
+    new_deadline,
+    quorum,
+    ends_at,
+    created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: GetCampaignProposal :one
+
+SELECT * FROM campaign_proposals WHERE id = $1 LIMIT 1;
+
+-- name: ListCampaignProposals :many
+
+SELECT * FROM campaign_proposals
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListEndedCampaignProposals :many
+
+SELECT * FROM campaign_proposals
+WHERE chain_id = $1 AND contract_version = $2 AND status = 'open' AND ends_at <= $3
+ORDER BY ends_at, id;
+
+-- name: CloseCampaignProposal :one
+
+UPDATE campaign_proposals SET
+    status = $2,
+    for_weight = $3,
+    against_weight = $4,
+    total_weight = $5,
+    closed_at = now()
+WHERE id = $1 AND status = 'open'
+RETURNING *;
+
+-- name: ExecuteCampaignProposal :one
+
+UPDATE campaign_proposals SET
+    status = 'executed',
+    executed_at = now()
+WHERE id = $1 AND status IN ('passed', 'executing')
+RETURNING *;
+
+-- name: ListPassedCampaignProposals :many
+
+SELECT * FROM campaign_proposals
+WHERE chain_id = $1 AND contract_version = $2
+    AND (status = 'passed' OR (status = 'executing' AND execution_tx_hash IS NOT NULL))
+ORDER BY closed_at, id;
+
+-- name: ClaimCampaignProposalExecution :one
+
+UPDATE campaign_proposals SET status = 'executing'
+WHERE id = $1 AND status = 'passed'
+RETURNING *;
+
+-- name: ReleaseCampaignProposalExecution :exec
+
+UPDATE campaign_proposals SET status = 'passed'
+WHERE id = $1 AND status = 'executing' AND execution_tx_hash IS NULL;
+
+-- name: SetCampaignProposalExecutionTx :exec
+
+UPDATE campaign_proposals SET execution_tx_hash = $2
+WHERE id = $1 AND status = 'executing';
+
+-- name: FailCampaignProposal :one
+
+UPDATE campaign_proposals SET status = 'failed'
+WHERE id = $1 AND status = 'passed'
+RETURNING *;
+
+-- name: UpsertProposalVote :one
+
+INSERT INTO proposal_votes (
+    proposal_id,
+    voter_address,
+    support,
+    weight,
+    signature
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (proposal_id, voter_address) DO UPDATE SET
+    support = EXCLUDED.support,
+    weight = EXCLUDED.weight,
+    signature = EXCLUDED.signature,
+    created_at = now()
+RETURNING *;
+
+-- name: SumProposalVotes :one
+
+SELECT
+    COALESCE(sum(weight) FILTER (WHERE support), 0)::text AS for_weight,
+    COALESCE(sum(weight) FILTER (WHERE NOT support), 0)::text AS against_weight,
+    count(*) AS vote_count
+FROM proposal_votes
+WHERE proposal_id = $1;
@@ -0,0 +1,24 @@
+-- name: CreateContractDeployment :one
+
+INSERT INTO contract_deployments (
+    chain_id,
+    version,
+    address,
+    proxy,
+    tx_hash,
+    block_number,
+    deployer
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetLatestContractDeployment :one
+
+SELECT * FROM contract_deployments
+WHERE chain_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: ListContractDeployments :many
+
+SELECT * FROM contract_deployments
+ORDER BY chain_id, version;
@@ -0,0 +1,201 @@
+-- name: CreateDonationRecord :one
+
+INSERT INTO donation_records (
+    chain_id,
+    contract_version,
+    campaign_id,
+    donor_address,
+    amount,
+    token,
+    tx_hash,
+    fiat_amount,
+    fiat_currency
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: CreateChainDonationRecord :one
+
+INSERT INTO donation_records (
+    chain_id,
+    contract_version,
+    campaign_id,
+    donor_address,
+    amount,
+    token,
+    donor_index,
+    status
+) VALUES ($1, $2, $3, $4, $5, $6, $7, 'confirmed')
+RETURNING *;
+
+-- name: ListPendingDonationRecords :many
+
+SELECT * FROM donation_records
+WHERE status = 'pending' AND tx_hash IS NOT NULL
+ORDER BY checked_at NULLS FIRST, id
+LIMIT $1;
+
+-- name: MarkDonationRecordChecked :exec
+
+UPDATE donation_records SET checked_at = now() WHERE id = $1;
+
+-- name: UpdateDonationRecordStatus :one
+
+UPDATE donation_records
+SET status = $2, block_number = $3, donated_at = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: GetDonationRecordByIndex :one
+
+SELECT * FROM donation_records
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3 AND donor_index = $4
+LIMIT 1;
+
+-- name: LinkDonationRecordIndex :execrows
+
+UPDATE donation_records
+SET donor_index = sqlc.arg('donor_index')
+WHERE id = (
+    SELECT id FROM donation_records
+    WHERE chain_id = sqlc.arg('chain_id')
+        AND contract_version = sqlc.arg('contract_version')
+        AND campaign_id = sqlc.arg('campaign_id')
+        AND lower(donor_address) = lower(sqlc.arg('donor_address'))
+        AND amount = sqlc.arg('amount')
+        AND donor_index IS NULL
+        AND status <> 'failed'
+    ORDER BY id
+    LIMIT 1
+);
+
+-- name: ListDonationRecordsByDonors :many
+
+SELECT
+    d.id,
+    d.campaign_id,
+    COALESCE(c.title, '')::text AS campaign_title,
+    d.donor_address,
+    d.amount,
+    d.token,
+    d.tx_hash,
+    d.block_number,
+    d.status,
+    d.fiat_amount,
+    d.fiat_currency,
+    d.donated_at
+FROM donation_records d
+LEFT JOIN indexed_campaigns c ON c.chain_id = d.chain_id AND c.contract_version = d.contract_version AND c.campaign_id = d.campaign_id
+WHERE lower(d.donor_address) = ANY(sqlc.arg('donors')::text[])
+    AND d.status <> 'failed'
+    AND (sqlc.narg('cursor_id')::bigint IS NULL OR d.id < sqlc.narg('cursor_id')::bigint)
+ORDER BY d.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SumDonationRecordsByCampaign :many
+
+SELECT
+    d.chain_id,
+    d.contract_version,
+    d.campaign_id,
+    COALESCE(c.title, '')::text AS campaign_title,
+    count(*) AS donation_count,
+    sum(d.amount)::text AS total_amount,
+    COALESCE(sum(d.fiat_amount), 0)::text AS total_fiat
+FROM donation_records d
+LEFT JOIN indexed_campaigns c ON c.chain_id = d.chain_id AND c.contract_version = d.contract_version AND c.campaign_id = d.campaign_id
+WHERE lower(d.donor_address) = ANY(sqlc.arg('donors')::text[])
+    AND d.status <> 'failed'
+GROUP BY d.chain_id, d.contract_version, d.campaign_id, c.title
+ORDER BY d.chain_id, d.contract_version, d.campaign_id;
+
+-- name: GetDonationReceipt :one
+
+SELECT
+    d.id,
+    d.campaign_id,
+    COALESCE(c.title, '')::text AS campaign_title,
+    COALESCE(c.owner, '')::text AS campaign_owner,
+    d.donor_address,
+    d.amount,
+    d.token,
+    d.tx_hash,
+    d.block_number,
+    d.fiat_amount,
+    d.fiat_currency,
+    d.status,
+    d.donated_at
+FROM donation_records d
+LEFT JOIN indexed_campaigns c ON c.chain_id = d.chain_id AND c.contract_version = d.contract_version AND c.campaign_id = d.campaign_id
+WHERE d.id = sqlc.arg('id')
+    AND lower(d.donor_address) = ANY(sqlc.arg('donors')::text[])
+    AND d.status = 'confirmed'
+    AND d.tx_hash IS NOT NULL
+    AND d.block_number IS NOT NULL
+LIMIT 1;
+
+-- name: ListDonationReceiptsInRange :many
+
+SELECT
+    d.id,
+    d.campaign_id,
+    COALESCE(c.title, '')::text AS campaign_title,
+    COALESCE(c.owner, '')::text AS campaign_owner,
+    d.donor_address,
+    d.amount,
+    d.token,
+    d.tx_hash,
+    d.block_number,
+    d.fiat_amount,
+    d.fiat_currency,
+    d.status,
+    d.donated_at
+FROM donation_records d
+LEFT JOIN indexed_campaigns c ON c.chain_id = d.chain_id AND c.contract_version = d.contract_version AND c.campaign_id = d.campaign_id
+WHERE lower(d.donor_address) = ANY(sqlc.arg('donors')::text[])
+    AND d.status = 'confirmed'
+    AND d.tx_hash IS NOT NULL
+    AND d.block_number IS NOT NULL
+    AND d.donated_at >= sqlc.arg('from_time')
+    AND d.donated_at < sqlc.arg('to_time')
+ORDER BY d.donated_at, d.id;
+
+-- name: ListUnvaluedDonationRecords :many
+
+SELECT * FROM donation_records
+WHERE status = 'confirmed' AND fiat_amount IS NULL AND block_number IS NOT NULL AND NOT unpriceable
+ORDER BY id
+LIMIT $1;
+
+-- name: MarkDonationRecordUnpriceable :exec
+
+UPDATE donation_records SET unpriceable = true WHERE id = $1;
+
+-- name: SetDonationRecordFiat :exec
+
+UPDATE donation_records
+SET fiat_amount = $2, fiat_currency = $3
+WHERE id = $1;
+
+-- name: SumCampaignDonationRecords :one
+
+SELECT
+    count(*) AS donation_count,
+    COALESCE(sum(amount), 0)::text AS total_amount,
+    COALESCE(sum(fiat_amount), 0)::text AS total_fiat
+FROM donation_records
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3 AND status <> 'failed';
+
+-- name: SumDonorCampaignDonationRecords :one
+
+SELECT COALESCE(sum(amount), 0)::text AS total_amount
+FROM donation_records
+WHERE chain_id = sqlc.arg('chain_id')
+    AND contract_version = sqlc.arg('contract_version')
+    AND campaign_id = sqlc.arg('campaign_id')
+    AND lower(donor_address) = ANY(sqlc.arg('donors')::text[])
+    AND status <> 'failed';
+
+-- name: CountPendingCampaignDonationRecords :one
+
+SELECT count(*) FROM donation_records
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3 AND status = 'pending';
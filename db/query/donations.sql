@@ -3,6 +3,13 @@
 SELECT *
 FROM donations
 WHERE deadline > now()
-ORDER BY created_at DESC
-LIMIT 10
-OFFSET 0;
\ No newline at end of file
+    AND (
+        sqlc.narg('cursor_created_at')::timestamptz IS NULL
+        OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::bigint)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountActiveDonations :one
+
+SELECT count(*) FROM donations WHERE deadline > now();
@@ -0,0 +1,40 @@
+-- name: ClaimFaucetGrant :one
+
+INSERT INTO faucet_grants (
+    username,
+    chain_id,
+    asset,
+    address,
+    ip,
+    amount
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (username, chain_id, asset) DO UPDATE SET
+    address = EXCLUDED.address,
+    ip = EXCLUDED.ip,
+    amount = EXCLUDED.amount,
+    status = 'pending',
+    tx_hash = NULL,
+    created_at = now()
+WHERE faucet_grants.status = 'failed'
+RETURNING *;
+
+-- name: MarkFaucetGrantSent :exec
+
+UPDATE faucet_grants SET status = 'sent', tx_hash = $2 WHERE id = $1;
+
+-- name: FailFaucetGrant :exec
+
+UPDATE faucet_grants SET status = 'failed' WHERE id = $1;
+
+-- name: CountFaucetIPUsers :one
+
+SELECT count(DISTINCT username) FROM faucet_grants
+WHERE ip = $1 AND created_at > $2 AND status <> 'failed' AND username <> $3;
+
+-- name: ListUserFaucetGrants :many
+
+SELECT * FROM faucet_grants WHERE username = $1 ORDER BY id;
+
+-- name: LockFaucetIP :exec
+
+SELECT pg_advisory_xact_lock(hashtext(sqlc.arg('ip')::text));
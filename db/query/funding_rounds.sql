@@ -0,0 +1,161 @@
+-- name: CreateFundingRound :one
+
+INSERT INTO funding_rounds (
+    name,
+    chain_id,
+    contract_version,
+    campaign_ids,
+    matching_amount,
+    wallet_address,
+    file_path,
+    starts_at,
+    ends_at,
+    require_verified,
+    min_contribution,
+    created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING *;
+
+-- name: GetFundingRound :one
+
+SELECT * FROM funding_rounds WHERE id = $1 LIMIT 1;
+
+-- name: ListFundingRounds :many
+
+SELECT * FROM funding_rounds
+WHERE sqlc.narg('cursor_id')::bigint IS NULL OR id < sqlc.narg('cursor_id')::bigint
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountFundingRounds :one
+
+SELECT count(*) FROM funding_rounds;
+
+-- name: ListFundingRoundsByStatus :many
+
+SELECT * FROM funding_rounds
+WHERE chain_id = $1 AND contract_version = $2 AND status = $3
+ORDER BY id;
+
+-- name: OpenFundingRound :one
+
+UPDATE funding_rounds SET status = 'open', opened_at = now()
+WHERE id = $1 AND status = 'scheduled'
+RETURNING *;
+
+-- name: MarkFundingRoundCalculated :one
+
+UPDATE funding_rounds SET status = 'calculated', calculated_at = now()
+WHERE id = $1 AND status = 'open'
+RETURNING *;
+
+-- name: ApproveFundingRound :one
+
+UPDATE funding_rounds SET status = 'approved', approved_by = $2, approved_at = now()
+WHERE id = $1 AND status = 'calculated'
+RETURNING *;
+
+-- name: MarkFundingRoundPaid :one
+
+UPDATE funding_rounds SET status = 'paid', paid_at = now()
+WHERE id = $1 AND status = 'approved'
+RETURNING *;
+
+-- name: CreateFundingRoundBaseline :exec
+
+INSERT INTO funding_round_baselines (
+    round_id,
+    campaign_id,
+    donor_address,
+    amount
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (round_id, campaign_id, donor_address) DO NOTHING;
+
+-- name: ListFundingRoundBaselines :many
+
+SELECT * FROM funding_round_baselines
+WHERE round_id = $1 AND campaign_id = $2;
+
+-- name: UpsertFundingRoundContribution :exec
+
+INSERT INTO funding_round_contributions (
+    round_id,
+    campaign_id,
+    donor,
+    amount,
+    eligible
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (round_id, campaign_id, donor) DO UPDATE SET
+    amount = EXCLUDED.amount,
+    eligible = EXCLUDED.eligible;
+
+-- name: ListFundingRoundContributions :many
+
+SELECT * FROM funding_round_contributions
+WHERE round_id = $1
+ORDER BY campaign_id, amount DESC;
+
+-- name: UpsertFundingRoundAllocation :one
+
+INSERT INTO funding_round_allocations (
+    round_id,
+    campaign_id,
+    contributors,
+    excluded_contributors,
+    contributed,
+    match_amount
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (round_id, campaign_id) DO UPDATE SET
+    contributors = EXCLUDED.contributors,
+    excluded_contributors = EXCLUDED.excluded_contributors,
+    contributed = EXCLUDED.contributed,
+    match_amount = EXCLUDED.match_amount
+WHERE funding_round_allocations.status = 'pending'
+RETURNING *;
+
+-- name: ListFundingRoundAllocations :many
+
+SELECT * FROM funding_round_allocations
+WHERE round_id = $1
+ORDER BY match_amount DESC, campaign_id;
+
+-- name: ClaimFundingRoundAllocation :one
+
+UPDATE funding_round_allocations SET status = 'sending'
+WHERE round_id = $1 AND campaign_id = $2 AND status = 'pending'
+RETURNING *;
+
+-- name: MarkFundingRoundAllocationSent :one
+
+UPDATE funding_round_allocations SET
+    status = 'sent',
+    tx_hash = $3,
+    last_error = NULL,
+    paid_at = now()
+WHERE round_id = $1 AND campaign_id = $2 AND status = 'sending'
+RETURNING *;
+
+-- name: ReleaseFundingRoundAllocation :one
+
+UPDATE funding_round_allocations SET
+    status = 'pending',
+    last_error = $3
+WHERE round_id = $1 AND campaign_id = $2 AND status = 'sending'
+RETURNING *;
+
+-- name: CountUnpaidFundingRoundAllocations :one
+
+SELECT count(*) FROM funding_round_allocations
+WHERE round_id = $1 AND status <> 'sent' AND match_amount > 0;
+
+-- name: GetDonorIdentity :one
+
+SELECT u.username, u.is_email_verified FROM users u
+WHERE lower(u.address) = lower(sqlc.arg('address'))
+UNION ALL
+SELECT u.username, u.is_email_verified FROM user_wallet_addresses w
+JOIN users u ON u.username = w.user_id
+WHERE lower(w.wallet_address) = lower(sqlc.arg('address'))
+    AND w.status = 'active'
+    AND w.deleted_at IS NULL
+LIMIT 1;
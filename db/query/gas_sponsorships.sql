@@ -0,0 +1,90 @@
+-- name: ReserveGasBudget :one
+
+INSERT INTO gas_budgets AS b (username, chain_id, period_start, spent, grants)
+SELECT sqlc.arg('username'), sqlc.arg('chain_id')::bigint, now(), sqlc.arg('amount')::numeric, 1
+WHERE sqlc.arg('amount')::numeric <= sqlc.arg('budget')::numeric
+ON CONFLICT (username, chain_id) DO UPDATE SET
+    period_start = CASE WHEN b.period_start <= sqlc.arg('since') THEN now() ELSE b.period_start END,
+    spent = CASE WHEN b.period_start <= sqlc.arg('since') THEN 0 ELSE b.spent END + EXCLUDED.spent,
+    grants = CASE WHEN b.period_start <= sqlc.arg('since') THEN 0 ELSE b.grants END + 1
+WHERE CASE WHEN b.period_start <= sqlc.arg('since') THEN 0 ELSE b.spent END + EXCLUDED.spent <= sqlc.arg('budget')::numeric
+    AND CASE WHEN b.period_start <= sqlc.arg('since') THEN 0 ELSE b.grants END < sqlc.arg('max_grants')
+RETURNING *;
+
+-- name: ReleaseGasBudget :exec
+
+UPDATE gas_budgets SET
+    spent = greatest(spent - sqlc.arg('amount')::numeric, 0),
+    grants = greatest(grants - 1, 0)
+WHERE username = sqlc.arg('username') AND chain_id = sqlc.arg('chain_id');
+
+-- name: RefundGasBudget :exec
+
+UPDATE gas_budgets SET spent = greatest(spent - sqlc.arg('amount')::numeric, 0)
+WHERE username = sqlc.arg('username')
+    AND chain_id = sqlc.arg('chain_id')
+    AND period_start <= sqlc.arg('sponsored_at');
+
+-- name: GetGasBudget :one
+
+SELECT * FROM gas_budgets WHERE username = $1 AND chain_id = $2 LIMIT 1;
+
+-- name: CreateGasSponsorship :one
+
+INSERT INTO gas_sponsorships (
+    chain_id,
+    username,
+    address,
+    action,
+    gas_price,
+    amount
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: MarkGasSponsorshipSent :exec
+
+UPDATE gas_sponsorships SET status = 'sent', tx_hash = $2 WHERE id = $1;
+
+-- name: UpdateGasSponsorshipStatus :exec
+
+UPDATE gas_sponsorships SET status = $2 WHERE id = $1;
+
+-- name: SetGasSponsorshipAction :exec
+
+UPDATE gas_sponsorships SET action_tx_hash = $2 WHERE id = $1;
+
+-- name: SumGasSponsored :one
+
+SELECT COALESCE(sum(COALESCE(spent, amount)), 0)::text AS amount
+FROM gas_sponsorships
+WHERE chain_id = $1 AND created_at > $2 AND status <> 'failed';
+
+-- name: SummarizeGasSponsorships :many
+
+SELECT
+    chain_id,
+    action,
+    count(*)::bigint AS sponsorships,
+    count(DISTINCT username)::bigint AS users,
+    COALESCE(sum(COALESCE(spent, amount)), 0)::text AS amount
+FROM gas_sponsorships
+WHERE created_at > $1 AND status <> 'failed'
+GROUP BY chain_id, action
+ORDER BY chain_id, action;
+
+-- name: ListUnreconciledGasSponsorships :many
+
+SELECT * FROM gas_sponsorships
+WHERE status = 'confirmed' AND action_tx_hash IS NOT NULL
+ORDER BY checked_at NULLS FIRST, id
+LIMIT $1;
+
+-- name: MarkGasSponsorshipChecked :exec
+
+UPDATE gas_sponsorships SET checked_at = now() WHERE id = $1;
+
+-- name: ReconcileGasSponsorship :one
+
+UPDATE gas_sponsorships SET status = 'reconciled', spent = $2
+WHERE id = $1 AND status = 'confirmed'
+RETURNING *;
@@ -0,0 +1,177 @@
+-- name: UpsertIndexedCampaign :one
+
+INSERT INTO indexed_campaigns (
+    chain_id,
+    contract_version,
+    campaign_id,
+    owner,
+    title,
+    description,
+    category,
+    image,
+    goal,
+    total_funds,
+    total_contributors,
+    deadline
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (chain_id, contract_version, campaign_id) DO UPDATE
+SET
+    owner = EXCLUDED.owner,
+    title = EXCLUDED.title,
+    description = EXCLUDED.description,
+    category = EXCLUDED.category,
+    image = EXCLUDED.image,
+    goal = EXCLUDED.goal,
+    total_funds = EXCLUDED.total_funds,
+    total_contributors = EXCLUDED.total_contributors,
+    deadline = EXCLUDED.deadline,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetIndexedCampaign :one
+
+SELECT * FROM indexed_campaigns WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3 LIMIT 1;
+
+-- name: SearchCampaignsNewest :many
+
+SELECT * FROM indexed_campaigns
+WHERE
+    chain_id = sqlc.arg('chain_id')
+    AND (sqlc.narg('contract_version')::int IS NULL OR contract_version = sqlc.narg('contract_version')::int)
+    AND (
+        sqlc.narg('query')::text IS NULL
+        OR to_tsvector('english', title || ' ' || description || ' ' || category) @@ plainto_tsquery('english', sqlc.narg('query')::text)
+        OR title % sqlc.narg('query')::text
+    )
+    AND (
+        sqlc.narg('status')::text IS NULL
+        OR (sqlc.narg('status')::text = 'active' AND deadline > now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'expired' AND deadline <= now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'funded' AND total_funds >= goal)
+    )
+    AND (sqlc.narg('min_goal')::numeric IS NULL OR goal >= sqlc.narg('min_goal')::numeric)
+    AND (sqlc.narg('max_goal')::numeric IS NULL OR goal <= sqlc.narg('max_goal')::numeric)
+    AND (sqlc.narg('min_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 >= sqlc.narg('min_percent_funded')::float8)
+    AND (sqlc.narg('max_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 <= sqlc.narg('max_percent_funded')::float8)
+    AND (
+        sqlc.narg('cursor_id')::bigint IS NULL
+        OR (contract_version, campaign_id) < (sqlc.narg('cursor_version')::int, sqlc.narg('cursor_id')::bigint)
+    )
+ORDER BY contract_version DESC, campaign_id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SearchCampaignsEndingSoon :many
+
+SELECT * FROM indexed_campaigns
+WHERE
+    chain_id = sqlc.arg('chain_id')
+    AND (sqlc.narg('contract_version')::int IS NULL OR contract_version = sqlc.narg('contract_version')::int)
+    AND (
+        sqlc.narg('query')::text IS NULL
+        OR to_tsvector('english', title || ' ' || description || ' ' || category) @@ plainto_tsquery('english', sqlc.narg('query')::text)
+        OR title % sqlc.narg('query')::text
+    )
+    AND (
+        sqlc.narg('status')::text IS NULL
+        OR (sqlc.narg('status')::text = 'active' AND deadline > now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'expired' AND deadline <= now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'funded' AND total_funds >= goal)
+    )
+    AND (sqlc.narg('min_goal')::numeric IS NULL OR goal >= sqlc.narg('min_goal')::numeric)
+    AND (sqlc.narg('max_goal')::numeric IS NULL OR goal <= sqlc.narg('max_goal')::numeric)
+    AND (sqlc.narg('min_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 >= sqlc.narg('min_percent_funded')::float8)
+    AND (sqlc.narg('max_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 <= sqlc.narg('max_percent_funded')::float8)
+    AND (
+        sqlc.narg('cursor_deadline')::timestamptz IS NULL
+        OR (deadline, contract_version, campaign_id) > (sqlc.narg('cursor_deadline')::timestamptz, sqlc.narg('cursor_version')::int, sqlc.narg('cursor_id')::bigint)
+    )
+ORDER BY deadline ASC, contract_version ASC, campaign_id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: SearchCampaignsMostFunded :many
+
+SELECT * FROM indexed_campaigns
+WHERE
+    chain_id = sqlc.arg('chain_id')
+    AND (sqlc.narg('contract_version')::int IS NULL OR contract_version = sqlc.narg('contract_version')::int)
+    AND (
+        sqlc.narg('query')::text IS NULL
+        OR to_tsvector('english', title || ' ' || description || ' ' || category) @@ plainto_tsquery('english', sqlc.narg('query')::text)
+        OR title % sqlc.narg('query')::text
+    )
+    AND (
+        sqlc.narg('status')::text IS NULL
+        OR (sqlc.narg('status')::text = 'active' AND deadline > now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'expired' AND deadline <= now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'funded' AND total_funds >= goal)
+    )
+    AND (sqlc.narg('min_goal')::numeric IS NULL OR goal >= sqlc.narg('min_goal')::numeric)
+    AND (sqlc.narg('max_goal')::numeric IS NULL OR goal <= sqlc.narg('max_goal')::numeric)
+    AND (sqlc.narg('min_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 >= sqlc.narg('min_percent_funded')::float8)
+    AND (sqlc.narg('max_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 <= sqlc.narg('max_percent_funded')::float8)
+    AND (
+        sqlc.narg('cursor_funds')::numeric IS NULL
+        OR (total_funds, contract_version, campaign_id) < (sqlc.narg('cursor_funds')::numeric, sqlc.narg('cursor_version')::int, sqlc.narg('cursor_id')::bigint)
+    )
+ORDER BY total_funds DESC, contract_version DESC, campaign_id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountSearchCampaigns :one
+
+SELECT count(*) FROM indexed_campaigns
+WHERE
+    chain_id = sqlc.arg('chain_id')
+    AND (sqlc.narg('contract_version')::int IS NULL OR contract_version = sqlc.narg('contract_version')::int)
+    AND (
+        sqlc.narg('query')::text IS NULL
+        OR to_tsvector('english', title || ' ' || description || ' ' || category) @@ plainto_tsquery('english', sqlc.narg('query')::text)
+        OR title % sqlc.narg('query')::text
+    )
+    AND (
+        sqlc.narg('status')::text IS NULL
+        OR (sqlc.narg('status')::text = 'active' AND deadline > now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'expired' AND deadline <= now() AND total_funds < goal)
+        OR (sqlc.narg('status')::text = 'funded' AND total_funds >= goal)
+    )
+    AND (sqlc.narg('min_goal')::numeric IS NULL OR goal >= sqlc.narg('min_goal')::numeric)
+    AND (sqlc.narg('max_goal')::numeric IS NULL OR goal <= sqlc.narg('max_goal')::numeric)
+    AND (sqlc.narg('min_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 >= sqlc.narg('min_percent_funded')::float8)
+    AND (sqlc.narg('max_percent_funded')::float8 IS NULL OR (total_funds * 100 / NULLIF(goal, 0))::float8 <= sqlc.narg('max_percent_funded')::float8);
+
+-- name: ListEndedCampaigns :many
+
+SELECT * FROM indexed_campaigns
+WHERE chain_id = $1 AND contract_version = $2 AND deadline <= $3 AND total_funds > 0
+ORDER BY campaign_id;
+
+-- name: UpdateIndexedCampaignDeadline :one
+
+UPDATE indexed_campaigns SET deadline = $4, updated_at = now()
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3
+RETURNING *;
+
+-- name: ListIndexedCampaigns :many
+
+SELECT * FROM indexed_campaigns
+WHERE
+    chain_id = sqlc.arg('chain_id')
+    AND (sqlc.narg('contract_version')::int IS NULL OR contract_version = sqlc.narg('contract_version')::int)
+    AND (NOT sqlc.arg('active_only')::bool OR deadline > now())
+    AND (sqlc.narg('category')::text IS NULL OR category = sqlc.narg('category')::text)
+    AND (sqlc.narg('owner')::text IS NULL OR lower(owner) = lower(sqlc.narg('owner')::text))
+    AND (
+        sqlc.narg('cursor_id')::bigint IS NULL
+        OR (contract_version, campaign_id) > (sqlc.narg('cursor_version')::int, sqlc.narg('cursor_id')::bigint)
+    )
+ORDER BY contract_version ASC, campaign_id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: CountIndexedCampaigns :one
+
+SELECT count(*) FROM indexed_campaigns
+WHERE
+    chain_id = sqlc.arg('chain_id')
+    AND (sqlc.narg('contract_version')::int IS NULL OR contract_version = sqlc.narg('contract_version')::int)
+    AND (NOT sqlc.arg('active_only')::bool OR deadline > now())
+    AND (sqlc.narg('category')::text IS NULL OR category = sqlc.narg('category')::text)
+    AND (sqlc.narg('owner')::text IS NULL OR lower(owner) = lower(sqlc.narg('owner')::text));
@@ -0,0 +1,162 @@
+-- name: CreateMatchingPool :one
+
+INSERT INTO matching_pools (
+    sponsor,
+    name,
+    chain_id,
+    contract_version,
+    wallet_address,
+    file_path,
+    ratio_percent,
+    cap,
+    max_match,
+    min_donation,
+    campaign_ids,
+    categories,
+    ends_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetMatchingPool :one
+
+SELECT * FROM matching_pools WHERE id = $1 LIMIT 1;
+
+-- name: ListMatchingPools :many
+
+SELECT * FROM matching_pools
+WHERE sqlc.narg('cursor_id')::bigint IS NULL OR id < sqlc.narg('cursor_id')::bigint
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountMatchingPools :one
+
+SELECT count(*) FROM matching_pools;
+
+-- name: ListActiveMatchingPools :many
+
+SELECT * FROM matching_pools
+WHERE status = 'active'
+ORDER BY id;
+
+-- name: ListMatchableDonations :many
+
+SELECT
+    d.id,
+    d.campaign_id,
+    d.donor_address,
+    d.amount,
+    d.token,
+    d.tx_hash,
+    d.block_number,
+    d.donor_index,
+    d.status,
+    d.donated_at,
+    d.created_at,
+    d.fiat_amount,
+    d.fiat_currency,
+    d.chain_id,
+    d.contract_version,
+    d.checked_at,
+    d.unpriceable
+FROM donation_records d
+JOIN matching_pools p ON p.id = sqlc.arg('pool_id')
+LEFT JOIN indexed_campaigns c ON c.chain_id = d.chain_id
+    AND c.contract_version = d.contract_version
+    AND c.campaign_id = d.campaign_id
+WHERE d.chain_id = p.chain_id
+    AND d.contract_version = p.contract_version
+    AND d.status = 'confirmed'
+    AND d.donated_at >= p.created_at
+    AND d.amount >= p.min_donation
+    AND (
+        (cardinality(p.campaign_ids) = 0 AND cardinality(p.categories) = 0)
+        OR d.campaign_id = ANY(p.campaign_ids)
+        OR c.category = ANY(p.categories)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM matching_pools w WHERE lower(w.wallet_address) = lower(d.donor_address)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM matching_pool_matches m WHERE m.pool_id = p.id AND m.donation_record_id = d.id
+    )
+ORDER BY d.id
+LIMIT sqlc.arg('limit');
+
+-- name: ClaimMatchingPoolMatch :one
+
+INSERT INTO matching_pool_matches (
+    pool_id,
+    donation_record_id,
+    campaign_id,
+    amount,
+    status,
+    reason
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (pool_id, donation_record_id) DO NOTHING
+RETURNING *;
+
+-- name: MarkMatchingPoolMatchSent :one
+
+UPDATE matching_pool_matches SET
+    status = 'sent',
+    tx_hash = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteMatchingPoolMatch :exec
+
+DELETE FROM matching_pool_matches WHERE id = $1 AND status = 'pending';
+
+-- name: ReserveMatchingPoolFunds :one
+
+UPDATE matching_pools SET
+    matched_total = matched_total + sqlc.arg('amount'),
+    status = CASE WHEN matched_total + sqlc.arg('amount') >= cap THEN 'exhausted' ELSE status END
+WHERE id = sqlc.arg('id') AND status = 'active' AND matched_total + sqlc.arg('amount') <= cap
+RETURNING *;
+
+-- name: ReleaseMatchingPoolFunds :one
+
+UPDATE matching_pools SET
+    matched_total = matched_total - sqlc.arg('amount'),
+    status = CASE WHEN status = 'exhausted' THEN 'active' ELSE status END
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: ExhaustMatchingPool :one
+
+UPDATE matching_pools SET status = 'exhausted'
+WHERE id = $1 AND status = 'active'
+RETURNING *;
+
+-- name: CloseMatchingPool :one
+
+UPDATE matching_pools SET
+    status = 'closed',
+    closed_at = COALESCE(closed_at, now())
+WHERE id = $1 AND refund_tx_hash IS NULL
+RETURNING *;
+
+-- name: SetMatchingPoolRefund :one
+
+UPDATE matching_pools SET refund_tx_hash = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: ListMatchingPoolMatches :many
+
+SELECT * FROM matching_pool_matches
+WHERE pool_id = $1
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: SumMatchingPoolMatches :many
+
+SELECT
+    campaign_id,
+    count(*) AS match_count,
+    COALESCE(sum(amount), 0)::text AS matched_amount
+FROM matching_pool_matches
+WHERE pool_id = $1 AND status = 'sent'
+GROUP BY campaign_id
+ORDER BY campaign_id;
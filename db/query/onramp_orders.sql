@@ -0,0 +1,58 @@
+-- name: CreateOnRampOrder :one
+
+INSERT INTO onramp_orders (
+    provider,
+    username,
+    chain_id,
+    contract_version,
+    address,
+    asset,
+    fiat_amount,
+    fiat_currency,
+    campaign_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: SetOnRampOrderCheckout :one
+
+UPDATE onramp_orders SET provider_order_id = $2, checkout_url = $3 WHERE id = $1
+RETURNING *;
+
+-- name: GetOnRampOrderByProviderID :one
+
+SELECT * FROM onramp_orders WHERE provider = $1 AND provider_order_id = $2;
+
+-- name: CompleteOnRampOrder :one
+
+UPDATE onramp_orders SET
+    status = 'completed',
+    crypto_amount = $2,
+    tx_hash = $3,
+    completed_at = now(),
+    donation_status = CASE WHEN campaign_id IS NULL THEN NULL ELSE 'pending' END
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: FailOnRampOrder :exec
+
+UPDATE onramp_orders SET status = 'failed' WHERE id = $1 AND status = 'pending';
+
+-- name: UpdateOnRampDonation :exec
+
+UPDATE onramp_orders SET donation_status = $2, donation_tx_hash = $3 WHERE id = $1;
+
+-- name: ListUserOnRampOrders :many
+
+SELECT * FROM onramp_orders WHERE username = $1 ORDER BY id DESC;
+
+-- name: ListPendingOnRampDonations :many
+
+SELECT * FROM onramp_orders
+WHERE status = 'completed' AND donation_status = 'pending' AND id > sqlc.arg('after_id')
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: ClaimOnRampDonation :one
+
+UPDATE onramp_orders SET donation_status = 'sending' WHERE id = $1 AND donation_status = 'pending'
+RETURNING *;
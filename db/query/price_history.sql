@@ -0,0 +1,43 @@
+-- name: CreatePriceSample :one
+
+INSERT INTO price_history (
+    token,
+    currency,
+    price,
+    source,
+    recorded_at
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetPriceAtOrBefore :one
+
+SELECT * FROM price_history
+WHERE token = $1 AND currency = $2 AND recorded_at <= $3
+ORDER BY recorded_at DESC
+LIMIT 1;
+
+-- name: GetPriceAfter :one
+
+SELECT * FROM price_history
+WHERE token = $1 AND currency = $2 AND recorded_at > $3
+ORDER BY recorded_at ASC
+LIMIT 1;
+
+-- name: ListPriceHistory :many
+
+SELECT * FROM price_history
+WHERE token = sqlc.arg('token')
+    AND currency = sqlc.arg('currency')
+    AND recorded_at >= sqlc.arg('from_time')
+    AND recorded_at < sqlc.arg('to_time')
+    AND (sqlc.narg('cursor_id')::bigint IS NULL OR id > sqlc.narg('cursor_id')::bigint)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: CountPriceHistory :one
+
+SELECT count(*) FROM price_history
+WHERE token = sqlc.arg('token')
+    AND currency = sqlc.arg('currency')
+    AND recorded_at >= sqlc.arg('from_time')
+    AND recorded_at < sqlc.arg('to_time');
@@ -0,0 +1,73 @@
+-- name: CreateRecurringDonation :one
+
+INSERT INTO recurring_donations (
+    username,
+    chain_id,
+    contract_version,
+    campaign_id,
+    amount,
+    token,
+    interval,
+    ends_at,
+    max_donations,
+    next_run_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
+
+-- name: GetRecurringDonation :one
+
+SELECT * FROM recurring_donations WHERE id = $1 LIMIT 1;
+
+-- name: ListRecurringDonationsByUser :many
+
+SELECT * FROM recurring_donations
+WHERE username = $1
+ORDER BY id DESC;
+
+-- name: ClaimDueRecurringDonations :many
+
+UPDATE recurring_donations SET
+    next_run_at = sqlc.arg('lease_until'),
+    updated_at = now()
+WHERE id IN (
+    SELECT id FROM recurring_donations
+    WHERE status = 'active' AND next_run_at <= sqlc.arg('now')
+    ORDER BY next_run_at, id
+    LIMIT sqlc.arg('limit')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateRecurringDonationStatus :one
+
+UPDATE recurring_donations SET
+    status = sqlc.arg('status'),
+    next_run_at = COALESCE(sqlc.narg('next_run_at'), next_run_at),
+    failed_attempts = 0,
+    updated_at = now()
+WHERE id = sqlc.arg('id') AND status = ANY(sqlc.arg('from_statuses')::varchar[])
+RETURNING *;
+
+-- name: RecordRecurringDonationRun :one
+
+UPDATE recurring_donations SET
+    status = CASE WHEN status = 'active' THEN $2 ELSE status END,
+    next_run_at = $3,
+    donation_count = donation_count + 1,
+    failed_attempts = 0,
+    last_tx_hash = $4,
+    last_error = NULL,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: RecordRecurringDonationFailure :one
+
+UPDATE recurring_donations SET
+    status = CASE WHEN status = 'active' THEN $2 ELSE status END,
+    next_run_at = $3,
+    failed_attempts = $4,
+    last_error = $5,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
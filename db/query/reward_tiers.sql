@@ -0,0 +1,96 @@
+-- name: CreateRewardTier :one
+
+INSERT INTO reward_tiers (
+    chain_id,
+    contract_version,
+    campaign_id,
+    title,
+    description,
+    min_amount,
+    quantity
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetRewardTier :one
+
+SELECT * FROM reward_tiers WHERE id = $1 LIMIT 1;
+
+-- name: ListRewardTiers :many
+
+SELECT * FROM reward_tiers
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3
+ORDER BY min_amount, id;
+
+-- name: ReserveRewardTier :one
+
+UPDATE reward_tiers
+SET claimed = claimed + 1
+WHERE id = $1 AND (quantity IS NULL OR claimed < quantity)
+RETURNING *;
+
+-- name: ReleaseRewardTier :one
+
+UPDATE reward_tiers
+SET claimed = claimed - 1
+WHERE id = $1 AND claimed > 0
+RETURNING *;
+
+-- name: CreateRewardClaim :one
+
+INSERT INTO reward_claims (
+    tier_id,
+    chain_id,
+    contract_version,
+    campaign_id,
+    username,
+    donor_address,
+    amount,
+    tx_hash,
+    donation_record_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetRewardClaim :one
+
+SELECT * FROM reward_claims WHERE id = $1 LIMIT 1;
+
+-- name: ListCampaignRewardClaims :many
+
+SELECT * FROM reward_claims
+WHERE chain_id = sqlc.arg('chain_id')
+    AND contract_version = sqlc.arg('contract_version')
+    AND campaign_id = sqlc.arg('campaign_id')
+    AND (sqlc.narg('cursor_id')::bigint IS NULL OR id < sqlc.narg('cursor_id')::bigint)
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountCampaignRewardClaims :one
+
+SELECT count(*) FROM reward_claims
+WHERE chain_id = $1 AND contract_version = $2 AND campaign_id = $3;
+
+-- name: ListUserRewardClaims :many
+
+SELECT * FROM reward_claims
+WHERE username = sqlc.arg('username')
+    AND (sqlc.narg('cursor_id')::bigint IS NULL OR id < sqlc.narg('cursor_id')::bigint)
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountUserRewardClaims :one
+
+SELECT count(*) FROM reward_claims WHERE username = $1;
+
+-- name: UpdateRewardClaimStatus :one
+
+UPDATE reward_claims
+SET status = $2, note = $3, updated_at = now()
+WHERE id = $1 AND status <> 'cancelled'
+RETURNING *;
+
+-- name: CancelDonationRewardClaim :one
+
+UPDATE reward_claims
+SET status = 'cancelled', note = $2, updated_at = now()
+WHERE donation_record_id = $1 AND status <> 'cancelled'
+RETURNING *;
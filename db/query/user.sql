@@ -93,4 +93,8 @@ SET
         is_first_time
     )
 WHERE
-    username = sqlc.arg(username) RETURNING *;
\ No newline at end of file
+    username = sqlc.arg(username) RETURNING *;
+
+-- name: UpdateUserPreferredCurrency :one
+
+UPDATE users SET preferred_currency = $2 WHERE username = $1 RETURNING *;
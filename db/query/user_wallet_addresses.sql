@@ -11,9 +11,17 @@ RETURNING *;
 -- name: GetUserWallets :many
 
 SELECT * FROM user_wallet_addresses
-WHERE user_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+WHERE user_id = sqlc.arg('user_id')
+    AND (
+        sqlc.narg('cursor_created_at')::timestamptz IS NULL
+        OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::bigint)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountUserWallets :one
+
+SELECT count(*) FROM user_wallet_addresses WHERE user_id = $1;
 
 -- name: GetWalletById :one
 
@@ -46,3 +54,8 @@ DELETE FROM user_wallet_addresses WHERE wallet_address = $1 AND user_id = $2 RET
 SELECT EXISTS (
     SELECT 1 FROM user_wallet_addresses WHERE wallet_address = $1 AND user_id = $2 LIMIT 1
 );
+
+-- name: ListActiveWalletAddresses :many
+
+SELECT wallet_address FROM user_wallet_addresses
+WHERE user_id = $1 AND status = 'active';
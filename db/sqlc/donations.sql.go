@@ -7,20 +7,42 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const countActiveDonations = `-- name: CountActiveDonations :one
+
+SELECT count(*) FROM donations WHERE deadline > now()
+`
+
+func (q *Queries) CountActiveDonations(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveDonations)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getAllActiveDonations = `-- name: GetAllActiveDonations :many
 
 SELECT id, owner, title, campaign_type, description, goal, deadline, image, created_at
 FROM donations
 WHERE deadline > now()
-ORDER BY created_at DESC
-LIMIT 10
-OFFSET 0
+    AND (
+        $1::timestamptz IS NULL
+        OR (created_at, id) < ($1::timestamptz, $2::bigint)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT $3
 `
 
-func (q *Queries) GetAllActiveDonations(ctx context.Context) ([]Donations, error) {
-	rows, err := q.db.QueryContext(ctx, getAllActiveDonations)
+type GetAllActiveDonationsParams struct {
+	CursorCreatedAt sql.NullTime  `json:"cursor_created_at"`
+	CursorID        sql.NullInt64 `json:"cursor_id"`
+	Limit           int32         `json:"limit"`
+}
+
+func (q *Queries) GetAllActiveDonations(ctx context.Context, arg GetAllActiveDonationsParams) ([]Donations, error) {
+	rows, err := q.db.QueryContext(ctx, getAllActiveDonations, arg.CursorCreatedAt, arg.CursorID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -57,12 +57,149 @@ func (ns NullUserWalletAddressesStatuses) Value() (driver.Value, error) {
 	return string(ns.UserWalletAddressesStatuses), nil
 }
 
+type BadgeMints struct {
+	ID               int64          `json:"id"`
+	BadgeID          int64          `json:"badge_id"`
+	Recipient        string         `json:"recipient"`
+	DonationRecordID int64          `json:"donation_record_id"`
+	Status           string         `json:"status"`
+	TxHash           sql.NullString `json:"tx_hash"`
+	BlockNumber      sql.NullInt64  `json:"block_number"`
+	CreatedAt        time.Time      `json:"created_at"`
+	MintedAt         sql.NullTime   `json:"minted_at"`
+}
+
+type BadgeSync struct {
+	ChainID         int64  `json:"chain_id"`
+	ContractAddress string `json:"contract_address"`
+	BlockNumber     int64  `json:"block_number"`
+}
+
+type Badges struct {
+	ID              int64     `json:"id"`
+	ChainID         int64     `json:"chain_id"`
+	ContractVersion int32     `json:"contract_version"`
+	CampaignID      int64     `json:"campaign_id"`
+	TierID          int64     `json:"tier_id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	Image           string    `json:"image"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
 type Campaigns struct {
 	ID           int64  `json:"id"`
 	Image        string `json:"image"`
 	CampaignName string `json:"campaign_name"`
 }
 
+type CampaignCurrencies struct {
+	CampaignID      int64     `json:"campaign_id"`
+	Currency        string    `json:"currency"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	ChainID         int64     `json:"chain_id"`
+	ContractVersion int32     `json:"contract_version"`
+}
+
+type CampaignDeadlineChanges struct {
+	ID               int64         `json:"id"`
+	ChainID          int64         `json:"chain_id"`
+	ContractVersion  int32         `json:"contract_version"`
+	CampaignID       int64         `json:"campaign_id"`
+	Kind             string        `json:"kind"`
+	PreviousDeadline time.Time     `json:"previous_deadline"`
+	Deadline         time.Time     `json:"deadline"`
+	TxHash           string        `json:"tx_hash"`
+	ProposalID       sql.NullInt64 `json:"proposal_id"`
+	CreatedBy        string        `json:"created_by"`
+	CreatedAt        time.Time     `json:"created_at"`
+}
+
+type CampaignEscrows struct {
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	CampaignID      int64          `json:"campaign_id"`
+	Amount          string         `json:"amount"`
+	PayoutTxHash    string         `json:"payout_tx_hash"`
+	DepositTxHash   sql.NullString `json:"deposit_tx_hash"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+type CampaignMilestones struct {
+	ID              int64          `json:"id"`
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	CampaignID      int64          `json:"campaign_id"`
+	Position        int32          `json:"position"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description"`
+	Amount          string         `json:"amount"`
+	Status          string         `json:"status"`
+	Evidence        string         `json:"evidence"`
+	EvidenceURL     string         `json:"evidence_url"`
+	SubmittedAt     sql.NullTime   `json:"submitted_at"`
+	ApprovedBy      string         `json:"approved_by"`
+	ApprovedAt      sql.NullTime   `json:"approved_at"`
+	ReleaseTxHash   sql.NullString `json:"release_tx_hash"`
+	ReleasedAt      sql.NullTime   `json:"released_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+type CampaignProposals struct {
+	ID              int64          `json:"id"`
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	CampaignID      int64          `json:"campaign_id"`
+	Kind            string         `json:"kind"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description"`
+	MilestoneID     sql.NullInt64  `json:"milestone_id"`
+	NewDeadline     sql.NullTime   `json:"new_deadline"`
+	Quorum          int32          `json:"quorum"`
+	EndsAt          time.Time      `json:"ends_at"`
+	Status          string         `json:"status"`
+	ForWeight       string         `json:"for_weight"`
+	AgainstWeight   string         `json:"against_weight"`
+	TotalWeight     string         `json:"total_weight"`
+	CreatedBy       string         `json:"created_by"`
+	ClosedAt        sql.NullTime   `json:"closed_at"`
+	ExecutedAt      sql.NullTime   `json:"executed_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	ExecutionTxHash sql.NullString `json:"execution_tx_hash"`
+}
+
+type ContractDeployments struct {
+	ID          int64     `json:"id"`
+	ChainID     int64     `json:"chain_id"`
+	Address     string    `json:"address"`
+	TxHash      string    `json:"tx_hash"`
+	BlockNumber int64     `json:"block_number"`
+	Deployer    string    `json:"deployer"`
+	CreatedAt   time.Time `json:"created_at"`
+	Version     int32     `json:"version"`
+	Proxy       bool      `json:"proxy"`
+}
+
+type DonationRecords struct {
+	ID              int64          `json:"id"`
+	CampaignID      int64          `json:"campaign_id"`
+	DonorAddress    string         `json:"donor_address"`
+	Amount          string         `json:"amount"`
+	Token           string         `json:"token"`
+	TxHash          sql.NullString `json:"tx_hash"`
+	BlockNumber     sql.NullInt64  `json:"block_number"`
+	DonorIndex      sql.NullInt32  `json:"donor_index"`
+	Status          string         `json:"status"`
+	DonatedAt       time.Time      `json:"donated_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	FiatAmount      sql.NullString `json:"fiat_amount"`
+	FiatCurrency    string         `json:"fiat_currency"`
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	CheckedAt       sql.NullTime   `json:"checked_at"`
+	Unpriceable     bool           `json:"unpriceable"`
+}
+
 type Donations struct {
 	ID           int64     `json:"id"`
 	Owner        string    `json:"owner"`
@@ -75,6 +212,244 @@ type Donations struct {
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+type FaucetGrants struct {
+	ID        int64          `json:"id"`
+	Username  string         `json:"username"`
+	ChainID   int64          `json:"chain_id"`
+	Asset     string         `json:"asset"`
+	Address   string         `json:"address"`
+	IP        string         `json:"ip"`
+	Amount    string         `json:"amount"`
+	Status    string         `json:"status"`
+	TxHash    sql.NullString `json:"tx_hash"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+type FundingRoundAllocations struct {
+	RoundID              int64          `json:"round_id"`
+	CampaignID           int64          `json:"campaign_id"`
+	Contributors         int32          `json:"contributors"`
+	ExcludedContributors int32          `json:"excluded_contributors"`
+	Contributed          string         `json:"contributed"`
+	MatchAmount          string         `json:"match_amount"`
+	Status               string         `json:"status"`
+	TxHash               sql.NullString `json:"tx_hash"`
+	LastError            sql.NullString `json:"last_error"`
+	PaidAt               sql.NullTime   `json:"paid_at"`
+}
+
+type FundingRoundBaselines struct {
+	RoundID      int64  `json:"round_id"`
+	CampaignID   int64  `json:"campaign_id"`
+	DonorAddress string `json:"donor_address"`
+	Amount       string `json:"amount"`
+}
+
+type FundingRoundContributions struct {
+	RoundID    int64  `json:"round_id"`
+	CampaignID int64  `json:"campaign_id"`
+	Donor      string `json:"donor"`
+	Amount     string `json:"amount"`
+	Eligible   bool   `json:"eligible"`
+}
+
+type FundingRounds struct {
+	ID              int64          `json:"id"`
+	Name            string         `json:"name"`
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	CampaignIds     []int64        `json:"campaign_ids"`
+	MatchingAmount  string         `json:"matching_amount"`
+	WalletAddress   string         `json:"wallet_address"`
+	FilePath        string         `json:"file_path"`
+	StartsAt        time.Time      `json:"starts_at"`
+	EndsAt          time.Time      `json:"ends_at"`
+	RequireVerified bool           `json:"require_verified"`
+	MinContribution string         `json:"min_contribution"`
+	Status          string         `json:"status"`
+	CreatedBy       string         `json:"created_by"`
+	ApprovedBy      sql.NullString `json:"approved_by"`
+	OpenedAt        sql.NullTime   `json:"opened_at"`
+	CalculatedAt    sql.NullTime   `json:"calculated_at"`
+	ApprovedAt      sql.NullTime   `json:"approved_at"`
+	PaidAt          sql.NullTime   `json:"paid_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+type GasBudgets struct {
+	Username    string    `json:"username"`
+	ChainID     int64     `json:"chain_id"`
+	PeriodStart time.Time `json:"period_start"`
+	Spent       string    `json:"spent"`
+	Grants      int32     `json:"grants"`
+}
+
+type GasSponsorships struct {
+	ID           int64          `json:"id"`
+	ChainID      int64          `json:"chain_id"`
+	Username     string         `json:"username"`
+	Address      string         `json:"address"`
+	Action       string         `json:"action"`
+	GasPrice     string         `json:"gas_price"`
+	Amount       string         `json:"amount"`
+	Status       string         `json:"status"`
+	TxHash       sql.NullString `json:"tx_hash"`
+	ActionTxHash sql.NullString `json:"action_tx_hash"`
+	CreatedAt    time.Time      `json:"created_at"`
+	Spent        sql.NullString `json:"spent"`
+	CheckedAt    sql.NullTime   `json:"checked_at"`
+}
+
+type IndexedCampaigns struct {
+	CampaignID        int64     `json:"campaign_id"`
+	Owner             string    `json:"owner"`
+	Title             string    `json:"title"`
+	Description       string    `json:"description"`
+	Category          string    `json:"category"`
+	Image             string    `json:"image"`
+	Goal              string    `json:"goal"`
+	TotalFunds        string    `json:"total_funds"`
+	TotalContributors int64     `json:"total_contributors"`
+	Deadline          time.Time `json:"deadline"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+	ChainID           int64     `json:"chain_id"`
+	ContractVersion   int32     `json:"contract_version"`
+}
+
+type MatchingPoolMatches struct {
+	ID               int64          `json:"id"`
+	PoolID           int64          `json:"pool_id"`
+	DonationRecordID int64          `json:"donation_record_id"`
+	CampaignID       int64          `json:"campaign_id"`
+	Amount           string         `json:"amount"`
+	Status           string         `json:"status"`
+	TxHash           sql.NullString `json:"tx_hash"`
+	Reason           sql.NullString `json:"reason"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+type MatchingPools struct {
+	ID              int64          `json:"id"`
+	Sponsor         string         `json:"sponsor"`
+	Name            string         `json:"name"`
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	WalletAddress   string         `json:"wallet_address"`
+	FilePath        string         `json:"file_path"`
+	RatioPercent    int32          `json:"ratio_percent"`
+	Cap             string         `json:"cap"`
+	MaxMatch        sql.NullString `json:"max_match"`
+	MinDonation     string         `json:"min_donation"`
+	CampaignIds     []int64        `json:"campaign_ids"`
+	Categories      []string       `json:"categories"`
+	EndsAt          sql.NullTime   `json:"ends_at"`
+	MatchedTotal    string         `json:"matched_total"`
+	Status          string         `json:"status"`
+	RefundTxHash    sql.NullString `json:"refund_tx_hash"`
+	ClosedAt        sql.NullTime   `json:"closed_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+type MilestoneVotes struct {
+	MilestoneID  int64     `json:"milestone_id"`
+	VoterAddress string    `json:"voter_address"`
+	Approve      bool      `json:"approve"`
+	Weight       string    `json:"weight"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type OnrampOrders struct {
+	ID              int64          `json:"id"`
+	Provider        string         `json:"provider"`
+	ProviderOrderID sql.NullString `json:"provider_order_id"`
+	Username        string         `json:"username"`
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	Address         string         `json:"address"`
+	Asset           string         `json:"asset"`
+	FiatAmount      string         `json:"fiat_amount"`
+	FiatCurrency    string         `json:"fiat_currency"`
+	CampaignID      sql.NullInt64  `json:"campaign_id"`
+	CheckoutURL     string         `json:"checkout_url"`
+	Status          string         `json:"status"`
+	CryptoAmount    sql.NullString `json:"crypto_amount"`
+	TxHash          sql.NullString `json:"tx_hash"`
+	DonationStatus  sql.NullString `json:"donation_status"`
+	DonationTxHash  sql.NullString `json:"donation_tx_hash"`
+	CreatedAt       time.Time      `json:"created_at"`
+	CompletedAt     sql.NullTime   `json:"completed_at"`
+}
+
+type PriceHistory struct {
+	ID         int64     `json:"id"`
+	Token      string    `json:"token"`
+	Currency   string    `json:"currency"`
+	Price      string    `json:"price"`
+	Source     string    `json:"source"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+type ProposalVotes struct {
+	ProposalID   int64     `json:"proposal_id"`
+	VoterAddress string    `json:"voter_address"`
+	Support      bool      `json:"support"`
+	Weight       string    `json:"weight"`
+	Signature    string    `json:"signature"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type RecurringDonations struct {
+	ID              int64          `json:"id"`
+	Username        string         `json:"username"`
+	ChainID         int64          `json:"chain_id"`
+	ContractVersion int32          `json:"contract_version"`
+	CampaignID      int64          `json:"campaign_id"`
+	Amount          string         `json:"amount"`
+	Token           string         `json:"token"`
+	Interval        string         `json:"interval"`
+	EndsAt          sql.NullTime   `json:"ends_at"`
+	MaxDonations    sql.NullInt32  `json:"max_donations"`
+	Status          string         `json:"status"`
+	NextRunAt       time.Time      `json:"next_run_at"`
+	DonationCount   int32          `json:"donation_count"`
+	FailedAttempts  int32          `json:"failed_attempts"`
+	LastTxHash      sql.NullString `json:"last_tx_hash"`
+	LastError       sql.NullString `json:"last_error"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+}
+
+type RewardClaims struct {
+	ID               int64         `json:"id"`
+	TierID           int64         `json:"tier_id"`
+	ChainID          int64         `json:"chain_id"`
+	ContractVersion  int32         `json:"contract_version"`
+	CampaignID       int64         `json:"campaign_id"`
+	Username         string        `json:"username"`
+	DonorAddress     string        `json:"donor_address"`
+	Amount           string        `json:"amount"`
+	TxHash           string        `json:"tx_hash"`
+	Status           string        `json:"status"`
+	Note             string        `json:"note"`
+	CreatedAt        time.Time     `json:"created_at"`
+	UpdatedAt        time.Time     `json:"updated_at"`
+	DonationRecordID sql.NullInt64 `json:"donation_record_id"`
+}
+
+type RewardTiers struct {
+	ID              int64         `json:"id"`
+	ChainID         int64         `json:"chain_id"`
+	ContractVersion int32         `json:"contract_version"`
+	CampaignID      int64         `json:"campaign_id"`
+	Title           string        `json:"title"`
+	Description     string        `json:"description"`
+	MinAmount       string        `json:"min_amount"`
+	Quantity        sql.NullInt32 `json:"quantity"`
+	Claimed         int32         `json:"claimed"`
+	CreatedAt       time.Time     `json:"created_at"`
+}
+
 type UserSession struct {
 	ID           uuid.UUID `json:"id"`
 	Username     string    `json:"username"`
@@ -113,4 +488,5 @@ type Users struct {
 	IsFirstTime       bool      `json:"is_first_time"`
 	CreatedAt         time.Time `json:"created_at"`
 	ExpiredAt         time.Time `json:"expired_at"`
+	PreferredCurrency string    `json:"preferred_currency"`
 }
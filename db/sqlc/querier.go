@@ -6,22 +6,96 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	ApproveCampaignMilestone(ctx context.Context, arg ApproveCampaignMilestoneParams) (CampaignMilestones, error)
+	ApproveFundingRound(ctx context.Context, arg ApproveFundingRoundParams) (FundingRounds, error)
+	CancelDonationRewardClaim(ctx context.Context, arg CancelDonationRewardClaimParams) (RewardClaims, error)
 	ChangePassword(ctx context.Context, arg ChangePasswordParams) (Users, error)
 	CheckUsernameExists(ctx context.Context, username string) (bool, error)
 	CheckWalletExists(ctx context.Context, arg CheckWalletExistsParams) (bool, error)
+	ClaimBadgeMint(ctx context.Context, arg ClaimBadgeMintParams) (BadgeMints, error)
+	ClaimCampaignProposalExecution(ctx context.Context, id int64) (CampaignProposals, error)
+	ClaimDueRecurringDonations(ctx context.Context, arg ClaimDueRecurringDonationsParams) ([]RecurringDonations, error)
+	ClaimFaucetGrant(ctx context.Context, arg ClaimFaucetGrantParams) (FaucetGrants, error)
+	ClaimFundingRoundAllocation(ctx context.Context, arg ClaimFundingRoundAllocationParams) (FundingRoundAllocations, error)
+	ClaimMatchingPoolMatch(ctx context.Context, arg ClaimMatchingPoolMatchParams) (MatchingPoolMatches, error)
+	ClaimOnRampDonation(ctx context.Context, id int64) (OnrampOrders, error)
+	CloseCampaignProposal(ctx context.Context, arg CloseCampaignProposalParams) (CampaignProposals, error)
+	CloseMatchingPool(ctx context.Context, id int64) (MatchingPools, error)
+	CompleteOnRampOrder(ctx context.Context, arg CompleteOnRampOrderParams) (OnrampOrders, error)
+	ConfirmBadgeMint(ctx context.Context, arg ConfirmBadgeMintParams) (int64, error)
+	CountActiveDonations(ctx context.Context) (int64, error)
+	CountCampaignRewardClaims(ctx context.Context, arg CountCampaignRewardClaimsParams) (int64, error)
+	CountFaucetIPUsers(ctx context.Context, arg CountFaucetIPUsersParams) (int64, error)
+	CountFundingRounds(ctx context.Context) (int64, error)
+	CountIndexedCampaigns(ctx context.Context, arg CountIndexedCampaignsParams) (int64, error)
+	CountMatchingPools(ctx context.Context) (int64, error)
+	CountOwnerUnescrowedCampaigns(ctx context.Context, owner string) (int64, error)
+	CountPendingCampaignDonationRecords(ctx context.Context, arg CountPendingCampaignDonationRecordsParams) (int64, error)
+	CountPriceHistory(ctx context.Context, arg CountPriceHistoryParams) (int64, error)
+	CountSearchCampaigns(ctx context.Context, arg CountSearchCampaignsParams) (int64, error)
+	CountUnpaidFundingRoundAllocations(ctx context.Context, roundID int64) (int64, error)
+	CountUserRewardClaims(ctx context.Context, username string) (int64, error)
+	CountUserWallets(ctx context.Context, userID string) (int64, error)
+	CreateCampaignDeadlineChange(ctx context.Context, arg CreateCampaignDeadlineChangeParams) (CampaignDeadlineChanges, error)
+	CreateCampaignEscrow(ctx context.Context, arg CreateCampaignEscrowParams) (CampaignEscrows, error)
+	CreateCampaignMilestones(ctx context.Context, arg CreateCampaignMilestonesParams) ([]CampaignMilestones, error)
+	CreateCampaignProposal(ctx context.Context, arg CreateCampaignProposalParams) (CampaignProposals, error)
 	CreateCampaignType(ctx context.Context, arg CreateCampaignTypeParams) (Campaigns, error)
+	CreateChainDonationRecord(ctx context.Context, arg CreateChainDonationRecordParams) (DonationRecords, error)
+	CreateContractDeployment(ctx context.Context, arg CreateContractDeploymentParams) (ContractDeployments, error)
+	CreateDonationRecord(ctx context.Context, arg CreateDonationRecordParams) (DonationRecords, error)
+	CreateFundingRound(ctx context.Context, arg CreateFundingRoundParams) (FundingRounds, error)
+	CreateFundingRoundBaseline(ctx context.Context, arg CreateFundingRoundBaselineParams) error
+	CreateGasSponsorship(ctx context.Context, arg CreateGasSponsorshipParams) (GasSponsorships, error)
+	CreateMatchingPool(ctx context.Context, arg CreateMatchingPoolParams) (MatchingPools, error)
+	CreateOnRampOrder(ctx context.Context, arg CreateOnRampOrderParams) (OnrampOrders, error)
+	CreatePriceSample(ctx context.Context, arg CreatePriceSampleParams) (PriceHistory, error)
+	CreateRecurringDonation(ctx context.Context, arg CreateRecurringDonationParams) (RecurringDonations, error)
+	CreateRewardClaim(ctx context.Context, arg CreateRewardClaimParams) (RewardClaims, error)
+	CreateRewardTier(ctx context.Context, arg CreateRewardTierParams) (RewardTiers, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (UserSession, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (Users, error)
 	CreateUserWallet(ctx context.Context, arg CreateUserWalletParams) (UserWalletAddresses, error)
+	DeleteBadgeMint(ctx context.Context, id int64) error
+	DeleteCampaignEscrow(ctx context.Context, arg DeleteCampaignEscrowParams) error
+	DeleteMatchingPoolMatch(ctx context.Context, id int64) error
+	DeleteMilestoneVotes(ctx context.Context, milestoneID int64) error
 	DeleteSession(ctx context.Context, id uuid.UUID) (UserSession, error)
 	DeleteUser(ctx context.Context, username string) (Users, error)
-	GetAllActiveDonations(ctx context.Context) ([]Donations, error)
+	EnsureBadge(ctx context.Context, arg EnsureBadgeParams) (Badges, error)
+	ExecuteCampaignProposal(ctx context.Context, id int64) (CampaignProposals, error)
+	ExhaustMatchingPool(ctx context.Context, id int64) (MatchingPools, error)
+	FailCampaignProposal(ctx context.Context, id int64) (CampaignProposals, error)
+	FailFaucetGrant(ctx context.Context, id int64) error
+	FailOnRampOrder(ctx context.Context, id int64) error
+	GetAllActiveDonations(ctx context.Context, arg GetAllActiveDonationsParams) ([]Donations, error)
 	GetAllCampaignType(ctx context.Context) ([]Campaigns, error)
+	GetBadge(ctx context.Context, id int64) (Badges, error)
+	GetBadgeSyncBlock(ctx context.Context, arg GetBadgeSyncBlockParams) (int64, error)
+	GetCampaignCurrency(ctx context.Context, arg GetCampaignCurrencyParams) (CampaignCurrencies, error)
+	GetCampaignEscrow(ctx context.Context, arg GetCampaignEscrowParams) (CampaignEscrows, error)
+	GetCampaignMilestone(ctx context.Context, id int64) (CampaignMilestones, error)
+	GetCampaignProposal(ctx context.Context, id int64) (CampaignProposals, error)
+	GetDonationReceipt(ctx context.Context, arg GetDonationReceiptParams) (GetDonationReceiptRow, error)
+	GetDonationRecordByIndex(ctx context.Context, arg GetDonationRecordByIndexParams) (DonationRecords, error)
+	GetDonorIdentity(ctx context.Context, address string) (GetDonorIdentityRow, error)
+	GetFundingRound(ctx context.Context, id int64) (FundingRounds, error)
+	GetGasBudget(ctx context.Context, arg GetGasBudgetParams) (GasBudgets, error)
+	GetIndexedCampaign(ctx context.Context, arg GetIndexedCampaignParams) (IndexedCampaigns, error)
+	GetLatestContractDeployment(ctx context.Context, chainID int64) (ContractDeployments, error)
+	GetMatchingPool(ctx context.Context, id int64) (MatchingPools, error)
+	GetOnRampOrderByProviderID(ctx context.Context, arg GetOnRampOrderByProviderIDParams) (OnrampOrders, error)
+	GetPriceAfter(ctx context.Context, arg GetPriceAfterParams) (PriceHistory, error)
+	GetPriceAtOrBefore(ctx context.Context, arg GetPriceAtOrBeforeParams) (PriceHistory, error)
+	GetRecurringDonation(ctx context.Context, id int64) (RecurringDonations, error)
+	GetRewardClaim(ctx context.Context, id int64) (RewardClaims, error)
+	GetRewardTier(ctx context.Context, id int64) (RewardTiers, error)
 	GetSession(ctx context.Context, id uuid.UUID) (UserSession, error)
 	GetUser(ctx context.Context, username string) (Users, error)
 	GetUserByAddress(ctx context.Context, address string) (Users, error)
@@ -29,9 +103,105 @@ type Querier interface {
 	GetWalletByAddress(ctx context.Context, arg GetWalletByAddressParams) (UserWalletAddresses, error)
 	GetWalletById(ctx context.Context, arg GetWalletByIdParams) (UserWalletAddresses, error)
 	HardDeleteUserWallet(ctx context.Context, arg HardDeleteUserWalletParams) (UserWalletAddresses, error)
+	LinkDonationRecordIndex(ctx context.Context, arg LinkDonationRecordIndexParams) (int64, error)
+	ListActiveMatchingPools(ctx context.Context) ([]MatchingPools, error)
+	ListActiveWalletAddresses(ctx context.Context, userID string) ([]string, error)
+	ListApprovedCampaignMilestones(ctx context.Context, arg ListApprovedCampaignMilestonesParams) ([]CampaignMilestones, error)
+	ListBadgeableDonations(ctx context.Context, arg ListBadgeableDonationsParams) ([]ListBadgeableDonationsRow, error)
+	ListCampaignCurrencies(ctx context.Context, arg ListCampaignCurrenciesParams) ([]CampaignCurrencies, error)
+	ListCampaignDeadlineChanges(ctx context.Context, arg ListCampaignDeadlineChangesParams) ([]CampaignDeadlineChanges, error)
+	ListCampaignMilestones(ctx context.Context, arg ListCampaignMilestonesParams) ([]CampaignMilestones, error)
+	ListCampaignProposals(ctx context.Context, arg ListCampaignProposalsParams) ([]CampaignProposals, error)
+	ListCampaignRewardClaims(ctx context.Context, arg ListCampaignRewardClaimsParams) ([]RewardClaims, error)
+	ListContractDeployments(ctx context.Context) ([]ContractDeployments, error)
+	ListDonationReceiptsInRange(ctx context.Context, arg ListDonationReceiptsInRangeParams) ([]ListDonationReceiptsInRangeRow, error)
+	ListDonationRecordsByDonors(ctx context.Context, arg ListDonationRecordsByDonorsParams) ([]ListDonationRecordsByDonorsRow, error)
+	ListEndedCampaignProposals(ctx context.Context, arg ListEndedCampaignProposalsParams) ([]CampaignProposals, error)
+	ListEndedCampaigns(ctx context.Context, arg ListEndedCampaignsParams) ([]IndexedCampaigns, error)
+	ListFundingRoundAllocations(ctx context.Context, roundID int64) ([]FundingRoundAllocations, error)
+	ListFundingRoundBaselines(ctx context.Context, arg ListFundingRoundBaselinesParams) ([]FundingRoundBaselines, error)
+	ListFundingRoundContributions(ctx context.Context, roundID int64) ([]FundingRoundContributions, error)
+	ListFundingRounds(ctx context.Context, arg ListFundingRoundsParams) ([]FundingRounds, error)
+	ListFundingRoundsByStatus(ctx context.Context, arg ListFundingRoundsByStatusParams) ([]FundingRounds, error)
+	ListIndexedCampaigns(ctx context.Context, arg ListIndexedCampaignsParams) ([]IndexedCampaigns, error)
+	ListMatchableDonations(ctx context.Context, arg ListMatchableDonationsParams) ([]DonationRecords, error)
+	ListMatchingPoolMatches(ctx context.Context, arg ListMatchingPoolMatchesParams) ([]MatchingPoolMatches, error)
+	ListMatchingPools(ctx context.Context, arg ListMatchingPoolsParams) ([]MatchingPools, error)
+	ListPassedCampaignProposals(ctx context.Context, arg ListPassedCampaignProposalsParams) ([]CampaignProposals, error)
+	ListPendingDonationRecords(ctx context.Context, limit int32) ([]DonationRecords, error)
+	ListPendingOnRampDonations(ctx context.Context, arg ListPendingOnRampDonationsParams) ([]OnrampOrders, error)
+	ListPriceHistory(ctx context.Context, arg ListPriceHistoryParams) ([]PriceHistory, error)
+	ListRecurringDonationsByUser(ctx context.Context, username string) ([]RecurringDonations, error)
+	ListRewardTiers(ctx context.Context, arg ListRewardTiersParams) ([]RewardTiers, error)
+	ListStaleBadgeMints(ctx context.Context, arg ListStaleBadgeMintsParams) ([]BadgeMints, error)
+	ListUndepositedCampaignEscrows(ctx context.Context, arg ListUndepositedCampaignEscrowsParams) ([]CampaignEscrows, error)
+	ListUnreconciledGasSponsorships(ctx context.Context, limit int32) ([]GasSponsorships, error)
+	ListUnvaluedDonationRecords(ctx context.Context, limit int32) ([]DonationRecords, error)
+	ListUserBadges(ctx context.Context, username string) ([]ListUserBadgesRow, error)
+	ListUserFaucetGrants(ctx context.Context, username string) ([]FaucetGrants, error)
+	ListUserOnRampOrders(ctx context.Context, username string) ([]OnrampOrders, error)
+	ListUserRewardClaims(ctx context.Context, arg ListUserRewardClaimsParams) ([]RewardClaims, error)
+	LockFaucetIP(ctx context.Context, ip string) error
+	MarkBadgeMintSent(ctx context.Context, arg MarkBadgeMintSentParams) (BadgeMints, error)
+	MarkDonationRecordChecked(ctx context.Context, id int64) error
+	MarkDonationRecordUnpriceable(ctx context.Context, id int64) error
+	MarkFaucetGrantSent(ctx context.Context, arg MarkFaucetGrantSentParams) error
+	MarkFundingRoundAllocationSent(ctx context.Context, arg MarkFundingRoundAllocationSentParams) (FundingRoundAllocations, error)
+	MarkFundingRoundCalculated(ctx context.Context, id int64) (FundingRounds, error)
+	MarkFundingRoundPaid(ctx context.Context, id int64) (FundingRounds, error)
+	MarkGasSponsorshipChecked(ctx context.Context, id int64) error
+	MarkGasSponsorshipSent(ctx context.Context, arg MarkGasSponsorshipSentParams) error
+	MarkMatchingPoolMatchSent(ctx context.Context, arg MarkMatchingPoolMatchSentParams) (MatchingPoolMatches, error)
+	OpenFundingRound(ctx context.Context, id int64) (FundingRounds, error)
+	ReconcileGasSponsorship(ctx context.Context, arg ReconcileGasSponsorshipParams) (GasSponsorships, error)
+	RecordRecurringDonationFailure(ctx context.Context, arg RecordRecurringDonationFailureParams) (RecurringDonations, error)
+	RecordRecurringDonationRun(ctx context.Context, arg RecordRecurringDonationRunParams) (RecurringDonations, error)
+	RefundGasBudget(ctx context.Context, arg RefundGasBudgetParams) error
+	RejectCampaignMilestone(ctx context.Context, arg RejectCampaignMilestoneParams) (CampaignMilestones, error)
+	ReleaseCampaignMilestone(ctx context.Context, arg ReleaseCampaignMilestoneParams) (CampaignMilestones, error)
+	ReleaseCampaignProposalExecution(ctx context.Context, id int64) error
+	ReleaseFundingRoundAllocation(ctx context.Context, arg ReleaseFundingRoundAllocationParams) (FundingRoundAllocations, error)
+	ReleaseGasBudget(ctx context.Context, arg ReleaseGasBudgetParams) error
+	ReleaseMatchingPoolFunds(ctx context.Context, arg ReleaseMatchingPoolFundsParams) (MatchingPools, error)
+	ReleaseRewardTier(ctx context.Context, id int64) (RewardTiers, error)
+	ReserveGasBudget(ctx context.Context, arg ReserveGasBudgetParams) (GasBudgets, error)
+	ReserveMatchingPoolFunds(ctx context.Context, arg ReserveMatchingPoolFundsParams) (MatchingPools, error)
+	ReserveRewardTier(ctx context.Context, id int64) (RewardTiers, error)
+	SearchCampaignsEndingSoon(ctx context.Context, arg SearchCampaignsEndingSoonParams) ([]IndexedCampaigns, error)
+	SearchCampaignsMostFunded(ctx context.Context, arg SearchCampaignsMostFundedParams) ([]IndexedCampaigns, error)
+	SearchCampaignsNewest(ctx context.Context, arg SearchCampaignsNewestParams) ([]IndexedCampaigns, error)
+	SetBadgeSyncBlock(ctx context.Context, arg SetBadgeSyncBlockParams) error
+	SetCampaignCurrency(ctx context.Context, arg SetCampaignCurrencyParams) (CampaignCurrencies, error)
+	SetCampaignEscrowDeposit(ctx context.Context, arg SetCampaignEscrowDepositParams) (CampaignEscrows, error)
+	SetCampaignProposalExecutionTx(ctx context.Context, arg SetCampaignProposalExecutionTxParams) error
+	SetDonationRecordFiat(ctx context.Context, arg SetDonationRecordFiatParams) error
+	SetGasSponsorshipAction(ctx context.Context, arg SetGasSponsorshipActionParams) error
+	SetMatchingPoolRefund(ctx context.Context, arg SetMatchingPoolRefundParams) (MatchingPools, error)
+	SetOnRampOrderCheckout(ctx context.Context, arg SetOnRampOrderCheckoutParams) (OnrampOrders, error)
 	SoftDeleteUserWallet(ctx context.Context, arg SoftDeleteUserWalletParams) (UserWalletAddresses, error)
+	SubmitCampaignMilestone(ctx context.Context, arg SubmitCampaignMilestoneParams) (CampaignMilestones, error)
+	SumCampaignDonationRecords(ctx context.Context, arg SumCampaignDonationRecordsParams) (SumCampaignDonationRecordsRow, error)
+	SumDonationRecordsByCampaign(ctx context.Context, donors []string) ([]SumDonationRecordsByCampaignRow, error)
+	SumDonorCampaignDonationRecords(ctx context.Context, arg SumDonorCampaignDonationRecordsParams) (string, error)
+	SumGasSponsored(ctx context.Context, arg SumGasSponsoredParams) (string, error)
+	SumMatchingPoolMatches(ctx context.Context, poolID int64) ([]SumMatchingPoolMatchesRow, error)
+	SumMilestoneVotes(ctx context.Context, milestoneID int64) (SumMilestoneVotesRow, error)
+	SumProposalVotes(ctx context.Context, proposalID int64) (SumProposalVotesRow, error)
+	SummarizeGasSponsorships(ctx context.Context, createdAt time.Time) ([]SummarizeGasSponsorshipsRow, error)
+	UpdateDonationRecordStatus(ctx context.Context, arg UpdateDonationRecordStatusParams) (DonationRecords, error)
+	UpdateGasSponsorshipStatus(ctx context.Context, arg UpdateGasSponsorshipStatusParams) error
+	UpdateIndexedCampaignDeadline(ctx context.Context, arg UpdateIndexedCampaignDeadlineParams) (IndexedCampaigns, error)
+	UpdateOnRampDonation(ctx context.Context, arg UpdateOnRampDonationParams) error
+	UpdateRecurringDonationStatus(ctx context.Context, arg UpdateRecurringDonationStatusParams) (RecurringDonations, error)
+	UpdateRewardClaimStatus(ctx context.Context, arg UpdateRewardClaimStatusParams) (RewardClaims, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (Users, error)
+	UpdateUserPreferredCurrency(ctx context.Context, arg UpdateUserPreferredCurrencyParams) (Users, error)
 	UpdateUserWalletStatus(ctx context.Context, arg UpdateUserWalletStatusParams) (UserWalletAddresses, error)
+	UpsertFundingRoundAllocation(ctx context.Context, arg UpsertFundingRoundAllocationParams) (FundingRoundAllocations, error)
+	UpsertFundingRoundContribution(ctx context.Context, arg UpsertFundingRoundContributionParams) error
+	UpsertIndexedCampaign(ctx context.Context, arg UpsertIndexedCampaignParams) (IndexedCampaigns, error)
+	UpsertMilestoneVote(ctx context.Context, arg UpsertMilestoneVoteParams) (MilestoneVotes, error)
+	UpsertProposalVote(ctx context.Context, arg UpsertProposalVoteParams) (ProposalVotes, error)
 }
 
 var _ Querier = (*Queries)(nil)
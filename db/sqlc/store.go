@@ -1,11 +1,19 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 )
 
+// ErrFaucetIPLimit is returned by ClaimFaucetGrantTx when the IP address has reached its limit of users
+var ErrFaucetIPLimit = errors.New("faucet IP limit reached")
+
 type Store interface {
 	Querier
+	ClaimFaucetGrantTx(ctx context.Context, arg ClaimFaucetGrantTxParams) (FaucetGrants, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
@@ -21,3 +29,58 @@ func NewStore(db *sql.DB) Store {
 		Queries: New(db),
 	}
 }
+
+// execTx runs fn within a database transaction, rolling it back when fn fails
+func (store SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(New(tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ClaimFaucetGrantTxParams is a grant claim and the limit on the users its IP address can have
+// granted since Since
+type ClaimFaucetGrantTxParams struct {
+	ClaimFaucetGrantParams
+	Since   time.Time
+	IPLimit int64
+}
+
+// ClaimFaucetGrantTx claims a grant unless its IP address already has IPLimit other users granted
+// since Since. Claims from one IP address hold a lock until they commit, so concurrent claims cannot
+// all pass the limit.
+func (store SQLStore) ClaimFaucetGrantTx(ctx context.Context, arg ClaimFaucetGrantTxParams) (FaucetGrants, error) {
+	var grant FaucetGrants
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if err := q.LockFaucetIP(ctx, arg.IP); err != nil {
+			return err
+		}
+
+		users, err := q.CountFaucetIPUsers(ctx, CountFaucetIPUsersParams{
+			IP:        arg.IP,
+			CreatedAt: arg.Since,
+			Username:  arg.Username,
+		})
+		if err != nil {
+			return err
+		}
+		if users >= arg.IPLimit {
+			return ErrFaucetIPLimit
+		}
+
+		grant, err = q.ClaimFaucetGrant(ctx, arg.ClaimFaucetGrantParams)
+		return err
+	})
+
+	return grant, err
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/demola234/defiraise/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimFaucetGrantTx(t *testing.T) {
+	store := NewStore(testDB)
+	ip := utils.RandomString(12)
+	limit := int64(3)
+
+	n := 5
+	users := make([]Users, n)
+	for i := range users {
+		users[i] = CreateRandomUser(t)
+	}
+
+	// every user signs up from the same IP address at once
+	errs := make(chan error)
+	for _, user := range users {
+		go func(user Users) {
+			_, err := store.ClaimFaucetGrantTx(context.Background(), ClaimFaucetGrantTxParams{
+				ClaimFaucetGrantParams: ClaimFaucetGrantParams{
+					Username: user.Username,
+					ChainID:  11155111,
+					Asset:    "native",
+					Address:  user.Address,
+					IP:       ip,
+					Amount:   "10000000000000000",
+				},
+				Since:   time.Now().Add(-24 * time.Hour),
+				IPLimit: limit,
+			})
+			errs <- err
+		}(user)
+	}
+
+	granted := 0
+	for i := 0; i < n; i++ {
+		err := <-errs
+		if errors.Is(err, ErrFaucetIPLimit) {
+			continue
+		}
+		require.NoError(t, err)
+		granted++
+	}
+	require.Equal(t, int(limit), granted)
+}
@@ -17,7 +17,7 @@ UPDATE users
 SET
     hashed_password = $2,
     password_changed_at = $3
-WHERE username = $1 RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at
+WHERE username = $1 RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at, preferred_currency
 `
 
 type ChangePasswordParams struct {
@@ -45,6 +45,7 @@ func (q *Queries) ChangePassword(ctx context.Context, arg ChangePasswordParams)
 		&i.IsFirstTime,
 		&i.CreatedAt,
 		&i.ExpiredAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
@@ -92,7 +93,7 @@ VALUES (
         $8,
         $9,
         $10
-    ) RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at
+    ) RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at, preferred_currency
 `
 
 type CreateUserParams struct {
@@ -138,13 +139,14 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (Users,
 		&i.IsFirstTime,
 		&i.CreatedAt,
 		&i.ExpiredAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
 
 const deleteUser = `-- name: DeleteUser :one
 
-DELETE FROM users WHERE username = $1 RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at
+DELETE FROM users WHERE username = $1 RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at, preferred_currency
 `
 
 func (q *Queries) DeleteUser(ctx context.Context, username string) (Users, error) {
@@ -166,13 +168,14 @@ func (q *Queries) DeleteUser(ctx context.Context, username string) (Users, error
 		&i.IsFirstTime,
 		&i.CreatedAt,
 		&i.ExpiredAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
 
-SELECT username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at FROM users WHERE username = $1 OR email = $1 OR address = $1 LIMIT 1
+SELECT username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at, preferred_currency FROM users WHERE username = $1 OR email = $1 OR address = $1 LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, username string) (Users, error) {
@@ -194,12 +197,13 @@ func (q *Queries) GetUser(ctx context.Context, username string) (Users, error) {
 		&i.IsFirstTime,
 		&i.CreatedAt,
 		&i.ExpiredAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
 
 const getUserByAddress = `-- name: GetUserByAddress :one
-SELECT username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at FROM users WHERE address = $1 LIMIT 1
+SELECT username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at, preferred_currency FROM users WHERE address = $1 LIMIT 1
 `
 
 func (q *Queries) GetUserByAddress(ctx context.Context, address string) (Users, error) {
@@ -221,6 +225,7 @@ func (q *Queries) GetUserByAddress(ctx context.Context, address string) (Users,
 		&i.IsFirstTime,
 		&i.CreatedAt,
 		&i.ExpiredAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
@@ -263,7 +268,7 @@ SET
         is_first_time
     )
 WHERE
-    username = $1 RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at
+    username = $1 RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at, preferred_currency
 `
 
 type UpdateUserParams struct {
@@ -313,6 +318,41 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (Users,
 		&i.IsFirstTime,
 		&i.CreatedAt,
 		&i.ExpiredAt,
+		&i.PreferredCurrency,
+	)
+	return i, err
+}
+
+const updateUserPreferredCurrency = `-- name: UpdateUserPreferredCurrency :one
+
+UPDATE users SET preferred_currency = $2 WHERE username = $1 RETURNING username, hashed_password, avatar, email, is_email_verified, password_changed_at, balance, biometrics, address, file_path, secret_code, is_used, is_first_time, created_at, expired_at, preferred_currency
+`
+
+type UpdateUserPreferredCurrencyParams struct {
+	Username          string `json:"username"`
+	PreferredCurrency string `json:"preferred_currency"`
+}
+
+func (q *Queries) UpdateUserPreferredCurrency(ctx context.Context, arg UpdateUserPreferredCurrencyParams) (Users, error) {
+	row := q.db.QueryRowContext(ctx, updateUserPreferredCurrency, arg.Username, arg.PreferredCurrency)
+	var i Users
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.Avatar,
+		&i.Email,
+		&i.IsEmailVerified,
+		&i.PasswordChangedAt,
+		&i.Balance,
+		&i.Biometrics,
+		&i.Address,
+		&i.FilePath,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.IsFirstTime,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+		&i.PreferredCurrency,
 	)
 	return i, err
 }
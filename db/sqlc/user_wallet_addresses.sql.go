@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const checkWalletExists = `-- name: CheckWalletExists :one
@@ -28,6 +29,18 @@ func (q *Queries) CheckWalletExists(ctx context.Context, arg CheckWalletExistsPa
 	return exists, err
 }
 
+const countUserWallets = `-- name: CountUserWallets :one
+
+SELECT count(*) FROM user_wallet_addresses WHERE user_id = $1
+`
+
+func (q *Queries) CountUserWallets(ctx context.Context, userID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserWallets, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createUserWallet = `-- name: CreateUserWallet :one
 
 INSERT INTO user_wallet_addresses (
@@ -71,18 +84,28 @@ const getUserWallets = `-- name: GetUserWallets :many
 
 SELECT id, user_id, wallet_address, chain, status, created_at, updated_at, deleted_at FROM user_wallet_addresses
 WHERE user_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+    AND (
+        $2::timestamptz IS NULL
+        OR (created_at, id) < ($2::timestamptz, $3::bigint)
+    )
+ORDER BY created_at DESC, id DESC
+LIMIT $4
 `
 
 type GetUserWalletsParams struct {
-	UserID string `json:"user_id"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	UserID          string        `json:"user_id"`
+	CursorCreatedAt sql.NullTime  `json:"cursor_created_at"`
+	CursorID        sql.NullInt64 `json:"cursor_id"`
+	Limit           int32         `json:"limit"`
 }
 
 func (q *Queries) GetUserWallets(ctx context.Context, arg GetUserWalletsParams) ([]UserWalletAddresses, error) {
-	rows, err := q.db.QueryContext(ctx, getUserWallets, arg.UserID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getUserWallets,
+		arg.UserID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +214,35 @@ func (q *Queries) HardDeleteUserWallet(ctx context.Context, arg HardDeleteUserWa
 	return i, err
 }
 
+const listActiveWalletAddresses = `-- name: ListActiveWalletAddresses :many
+
+SELECT wallet_address FROM user_wallet_addresses
+WHERE user_id = $1 AND status = 'active'
+`
+
+func (q *Queries) ListActiveWalletAddresses(ctx context.Context, userID string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveWalletAddresses, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var wallet_address string
+		if err := rows.Scan(&wallet_address); err != nil {
+			return nil, err
+		}
+		items = append(items, wallet_address)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDeleteUserWallet = `-- name: SoftDeleteUserWallet :one
 
 UPDATE user_wallet_addresses
@@ -0,0 +1,199 @@
+package defi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/demola234/defiraise/gen"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// ABICheckStrict refuses to start when a deployed contract is missing methods of the binding
+	ABICheckStrict = "strict"
+	// ABICheckWarn logs the missing methods and starts anyway
+	ABICheckWarn = "warn"
+	// ABICheckOff skips the check
+	ABICheckOff = "off"
+)
+
+// ABIDrift lists the methods of the gen binding that a deployed campaign contract does not implement
+type ABIDrift struct {
+	ChainID int64
+	Version int
+	Address string
+	// Code is the address whose bytecode was read: the implementation when Address is a proxy
+	Code    string
+	Missing []string
+}
+
+// BoundABI returns the ABI the gen binding was generated from
+func BoundABI() (*abi.ABI, error) {
+	return gen.GenMetaData.GetAbi()
+}
+
+// IsProxy reports whether the chain's contract version is an upgradeable proxy
+func (chain *Chain) IsProxy() bool {
+	for _, contract := range chain.Contracts {
+		if contract.Version == chain.Version {
+			return contract.Proxy
+		}
+	}
+	return false
+}
+
+// CheckABI compares the method selectors of the gen binding with the bytecode deployed at the chain's
+// contract, reading the implementation behind a proxy. Calls to a missing method revert or hit the
+// contract's fallback, so the binding only works when nothing is missing.
+func (chain *Chain) CheckABI(ctx context.Context) (*ABIDrift, error) {
+	bound, err := BoundABI()
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &ABIDrift{ChainID: chain.ID, Version: chain.Version, Address: chain.ContractAddress}
+	code, codeAddress, err := chain.deployedCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drift.Code = codeAddress
+
+	drift.Missing = missingMethods(*bound, codeSelectors(code))
+	return drift, nil
+}
+
+// deployedCode reads the bytecode of the chain's contract, or of the implementation behind a proxy,
+// and returns it with the address it was read from
+func (chain *Chain) deployedCode(ctx context.Context) ([]byte, string, error) {
+	address := chain.ContractAddress
+	if chain.IsProxy() {
+		implementation, err := chain.Implementation(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("cannot read implementation of proxy %s: %w", chain.ContractAddress, err)
+		}
+		address = implementation
+	}
+
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer client.Close()
+
+	code, err := client.CodeAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(code) == 0 {
+		return nil, "", fmt.Errorf("no contract deployed at %s", address)
+	}
+	return code, address, nil
+}
+
+// codeSelectors returns the values of the PUSH1 to PUSH4 instructions in EVM bytecode, left-padded to
+// four bytes. A contract's dispatcher pushes the selector of every external method it implements, with
+// leading zero bytes dropped by the optimizer, so every implemented selector is in the set.
+func codeSelectors(code []byte) map[[4]byte]bool {
+	const push1, push4, push32 = 0x60, 0x63, 0x7f
+
+	selectors := make(map[[4]byte]bool)
+	for i := 0; i < len(code); i++ {
+		op := code[i]
+		if op < push1 || op > push32 {
+			continue
+		}
+
+		size := int(op-push1) + 1
+		if op <= push4 && i+size < len(code) {
+			var selector [4]byte
+			copy(selector[4-size:], code[i+1:i+1+size])
+			selectors[selector] = true
+		}
+		i += size
+	}
+	return selectors
+}
+
+// missingMethods returns the sorted signatures of the methods of bound whose selectors are not in selectors
+func missingMethods(bound abi.ABI, selectors map[[4]byte]bool) []string {
+	var missing []string
+	for _, method := range bound.Methods {
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		if !selectors[selector] {
+			missing = append(missing, method.Sig)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// CompareABI returns the sorted signatures of the methods only in bound and those only in other, such
+// as the ABI compiled into build/ from the contract source
+func CompareABI(bound, other abi.ABI) (onlyBound, onlyOther []string) {
+	signatures := func(contract abi.ABI) map[string]bool {
+		methods := make(map[string]bool, len(contract.Methods))
+		for _, method := range contract.Methods {
+			methods[method.Sig] = true
+		}
+		return methods
+	}
+	boundMethods, otherMethods := signatures(bound), signatures(other)
+
+	for sig := range boundMethods {
+		if !otherMethods[sig] {
+			onlyBound = append(onlyBound, sig)
+		}
+	}
+	for sig := range otherMethods {
+		if !boundMethods[sig] {
+			onlyOther = append(onlyOther, sig)
+		}
+	}
+	sort.Strings(onlyBound)
+	sort.Strings(onlyOther)
+	return onlyBound, onlyOther
+}
+
+// CheckABIs checks every contract version of every chain against the gen binding, as ABI_CHECK
+// selects: strict returns an error when a contract is missing methods or cannot be read, such as an
+// address without code or a proxy with an empty implementation slot, warn only logs them.
+func CheckABIs(ctx context.Context, mode string, chains *ChainRegistry) error {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		mode = ABICheckWarn
+	}
+	if mode == ABICheckOff {
+		return nil
+	}
+	if mode != ABICheckStrict && mode != ABICheckWarn {
+		return fmt.Errorf("invalid ABI_CHECK %q, expected %s, %s or %s", mode, ABICheckStrict, ABICheckWarn, ABICheckOff)
+	}
+
+	drifted := 0
+	for _, chain := range chains.All() {
+		for _, version := range chain.Versions() {
+			logger := log.With().Int64("chain_id", version.ID).Int("version", version.Version).Str("address", version.ContractAddress).Logger()
+
+			drift, err := version.CheckABI(ctx)
+			if err != nil {
+				logger.Warn().Err(err).Msg("cannot check contract ABI")
+				drifted++
+				continue
+			}
+			if len(drift.Missing) > 0 {
+				logger.Warn().Str("code", drift.Code).Strs("missing", drift.Missing).Msg("contract is missing methods of the binding")
+				drifted++
+			}
+		}
+	}
+
+	if mode == ABICheckStrict && drifted > 0 {
+		return fmt.Errorf("%d contracts cannot be read or do not implement the binding, see the log or run check-abi", drifted)
+	}
+	return nil
+}
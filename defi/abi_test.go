@@ -0,0 +1,73 @@
+package defi
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/demola234/defiraise/gen"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeSelectors(t *testing.T) {
+	// PUSH4 0x12345678, PUSH3 0x00abcdef as pushed by the optimizer, PUSH32 data that must be skipped
+	code := append([]byte{0x63, 0x12, 0x34, 0x56, 0x78, 0x62, 0xab, 0xcd, 0xef, 0x7f}, make([]byte, 32)...)
+
+	selectors := codeSelectors(code)
+	require.True(t, selectors[[4]byte{0x12, 0x34, 0x56, 0x78}])
+	require.True(t, selectors[[4]byte{0x00, 0xab, 0xcd, 0xef}])
+	require.False(t, selectors[[4]byte{}])
+}
+
+func TestMissingMethods(t *testing.T) {
+	bound, err := BoundABI()
+	require.NoError(t, err)
+
+	// the creation code embeds the runtime code and so its dispatcher
+	require.Empty(t, missingMethods(*bound, codeSelectors(common.FromHex(gen.GenBin))))
+
+	donate := bound.Methods["donate"]
+	code := append([]byte{0x63}, donate.ID...)
+	missing := missingMethods(*bound, codeSelectors(code))
+	require.Len(t, missing, len(bound.Methods)-1)
+	require.NotContains(t, missing, donate.Sig)
+}
+
+func TestCompareABI(t *testing.T) {
+	bound, err := BoundABI()
+	require.NoError(t, err)
+
+	onlyBound, onlyOther := CompareABI(*bound, *bound)
+	require.Empty(t, onlyBound)
+	require.Empty(t, onlyOther)
+
+	data, err := os.ReadFile("../build/erc20.abi")
+	require.NoError(t, err)
+	erc20, err := abi.JSON(strings.NewReader(string(data)))
+	require.NoError(t, err)
+
+	onlyBound, onlyOther = CompareABI(*bound, erc20)
+	require.Contains(t, onlyBound, bound.Methods["donate"].Sig)
+	require.Contains(t, onlyOther, "balanceOf(address)")
+}
+
+func TestCheckABIsMode(t *testing.T) {
+	registry, err := NewChainRegistry([]Chain{
+		{ID: 1, RPCURLs: []string{"https://eth.example.com"}, ContractAddress: Address},
+	}, 0, RPCHealth{})
+	require.NoError(t, err)
+
+	require.NoError(t, CheckABIs(context.Background(), ABICheckOff, registry))
+	require.Error(t, CheckABIs(context.Background(), "sometimes", registry))
+
+	unreadable, err := NewChainRegistry([]Chain{
+		{ID: 1, RPCURLs: []string{"http://127.0.0.1:1"}, ContractAddress: Address},
+	}, 0, RPCHealth{})
+	require.NoError(t, err)
+
+	require.NoError(t, CheckABIs(context.Background(), ABICheckWarn, unreadable))
+	require.Error(t, CheckABIs(context.Background(), ABICheckStrict, unreadable))
+}
@@ -0,0 +1,174 @@
+package defi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/demola234/defiraise/gen"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrNoBadgeContract is returned for badge calls on a chain without a badge_contract
+var ErrNoBadgeContract = errors.New("chain has no badge contract")
+
+// BadgeMint is a BadgeMinted event of the badge contract
+type BadgeMint struct {
+	To          string
+	TokenID     int64
+	TxHash      string
+	BlockNumber uint64
+}
+
+// HasBadges reports whether supporter badges are minted on the chain
+func (chain *Chain) HasBadges() bool {
+	return chain.BadgeContract != ""
+}
+
+// MintBadge mints badge tokenID to an address from key's account, which must be the contract's
+// minter, and returns the transaction hash without waiting for it to be mined
+func (chain *Chain) MintBadge(ctx context.Context, to string, tokenID int64, key *ecdsa.PrivateKey) (string, error) {
+	if !chain.HasBadges() {
+		return "", ErrNoBadgeContract
+	}
+
+	client, err := chain.DialWriter(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	badges, err := gen.NewBadgesTransactor(common.HexToAddress(chain.BadgeContract), client)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(chain.ID))
+	if err != nil {
+		return "", err
+	}
+	auth.Context = ctx
+
+	// gas is estimated, so a badge the address already holds fails here instead of on chain
+	tx, err := badges.Mint(auth, common.HexToAddress(to), big.NewInt(tokenID))
+	if err != nil {
+		return "", err
+	}
+
+	return tx.Hash().Hex(), nil
+}
+
+// BadgeMinted reports whether an address has been minted badge tokenID
+func (chain *Chain) BadgeMinted(ctx context.Context, to string, tokenID int64) (bool, error) {
+	if !chain.HasBadges() {
+		return false, ErrNoBadgeContract
+	}
+
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer client.Close()
+
+	badges, err := gen.NewBadgesCaller(common.HexToAddress(chain.BadgeContract), client)
+	if err != nil {
+		return false, err
+	}
+
+	return badges.Minted(&bind.CallOpts{Context: ctx}, big.NewInt(tokenID), common.HexToAddress(to))
+}
+
+// BadgeMints returns the badges minted between two blocks, inclusive
+func (chain *Chain) BadgeMints(ctx context.Context, from uint64, to uint64) ([]BadgeMint, error) {
+	if !chain.HasBadges() {
+		return nil, ErrNoBadgeContract
+	}
+
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	badges, err := gen.NewBadgesFilterer(common.HexToAddress(chain.BadgeContract), client)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := badges.FilterBadgeMinted(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer events.Close()
+
+	var mints []BadgeMint
+	for events.Next() {
+		mints = append(mints, BadgeMint{
+			To:          events.Event.To.Hex(),
+			TokenID:     events.Event.Id.Int64(),
+			TxHash:      events.Event.Raw.TxHash.Hex(),
+			BlockNumber: events.Event.Raw.BlockNumber,
+		})
+	}
+
+	return mints, events.Error()
+}
+
+// BlockNumber returns the number of the chain's latest block
+func (chain *Chain) BlockNumber(ctx context.Context) (uint64, error) {
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	return client.BlockNumber(ctx)
+}
+
+// BlockAt returns the number of the last block mined at or before at, or the latest block when the
+// chain has not reached at yet
+func (chain *Chain) BlockAt(ctx context.Context, at time.Time) (*big.Int, error) {
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	latest, err := client.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	number, err := searchBlock(latest, uint64(at.Unix()), func(number uint64) (uint64, error) {
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return 0, err
+		}
+		return header.Time, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetUint64(number), nil
+}
+
+// searchBlock binary searches blocks 0 to latest for the last one whose time is at or before at.
+// Block 0 is returned when every block is later.
+func searchBlock(latest, at uint64, blockTime func(number uint64) (uint64, error)) (uint64, error) {
+	low, high := uint64(0), latest
+	for low < high {
+		mid := low + (high-low+1)/2
+		mined, err := blockTime(mid)
+		if err != nil {
+			return 0, err
+		}
+		if mined <= at {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low, nil
+}
@@ -0,0 +1,29 @@
+package defi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchBlock(t *testing.T) {
+	// blocks 0 to 10 mined every 12 seconds from 1000
+	blockTime := func(number uint64) (uint64, error) { return 1000 + number*12, nil }
+
+	for _, tc := range []struct {
+		at   uint64
+		want uint64
+	}{
+		{at: 1000, want: 0},
+		{at: 1011, want: 0},
+		{at: 1012, want: 1},
+		{at: 1065, want: 5},
+		{at: 1120, want: 10},
+		{at: 5000, want: 10},
+		{at: 900, want: 0},
+	} {
+		number, err := searchBlock(10, tc.at, blockTime)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, number, "at %d", tc.at)
+	}
+}
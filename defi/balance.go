@@ -8,7 +8,6 @@ import (
 	"github.com/demola234/defiraise/utils"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog/log"
 )
 
@@ -19,13 +18,8 @@ type Stablecoin struct {
 }
 
 // GetStablecoinBalances retrieves the balances of stablecoins for a given address
-func GetStablecoinBalances(userAddress string, stablecoins []Stablecoin) (map[string]string, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetStablecoinBalances(userAddress string, stablecoins []Stablecoin) (map[string]string, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -51,24 +45,22 @@ func GetStablecoinBalances(userAddress string, stablecoins []Stablecoin) (map[st
 			continue
 		}
 
-		// Convert from wei to eth
-		fbBalance := new(big.Float).SetInt(balance)
-		ethValue := new(big.Float).Quo(fbBalance, big.NewFloat(math.Pow10(18)))
+		// Tokens such as USDC use 6 decimals, so read them instead of assuming 18
+		decimals, err := token.Decimals(&bind.CallOpts{Context: context.Background()})
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to get decimals for %s", coin.Name)
+			continue
+		}
 
 		// Store the balance in the map
-		balances[coin.Name] = ethValue.String()
+		balances[coin.Name] = utils.FormatUnits(balance, decimals)
 	}
 
 	return balances, nil
 }
 
-func GetBalance(address string) (string, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetBalance(address string) (string, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		return "", err
 	}
@@ -86,3 +78,39 @@ func GetBalance(address string) (string, error) {
 	ethValue := new(big.Float).Quo(fbBalance, big.NewFloat(math.Pow10(18)))
 	return ethValue.String(), nil
 }
+
+// WalletBalances is the native and ERC-20 balances of one address
+type WalletBalances struct {
+	Address string
+	Native  *big.Int
+	Tokens  []TokenBalance
+}
+
+// GetPortfolio reads the native balance and the balance of every token registered for the chain of each address
+func (chain *Chain) GetPortfolio(addresses []string, registry *TokenRegistry) ([]WalletBalances, error) {
+	ctx := context.Background()
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	tokens := registry.Tokens(chain.ID)
+
+	wallets := make([]WalletBalances, len(addresses))
+	for i, address := range addresses {
+		native, err := client.BalanceAt(ctx, common.HexToAddress(address), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		balances, err := GetTokenBalances(ctx, client, address, tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		wallets[i] = WalletBalances{Address: address, Native: native, Tokens: balances}
+	}
+
+	return wallets, nil
+}
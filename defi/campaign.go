@@ -12,22 +12,16 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog/log"
 )
 
 var (
-	// DeployedContractAddress is the address of the deployed contract
+	// Address is the deployed Sepolia contract, used when no CHAINS_FILE is configured
 	Address = "0x1554d6aA4f1189A36De9b3B33564b10126Ac266d"
 )
 
-func CreateCampaign(title string, campaignType string, description string, goal float64, deadline time.Time, image string, privateKey *ecdsa.PrivateKey, address string) (string, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) CreateCampaign(title string, campaignType string, description string, goal float64, deadline time.Time, image string, privateKey *ecdsa.PrivateKey, address string) (string, error) {
+	client, err := chain.DialWriter(context.Background())
 	if err != nil {
 		return "", err
 	}
@@ -47,7 +41,7 @@ func CreateCampaign(title string, campaignType string, description string, goal
 		return "", err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGen(cAdd, client)
 	if err != nil {
@@ -59,11 +53,16 @@ func CreateCampaign(title string, campaignType string, description string, goal
 		return "", err
 	}
 
+	gas, err := chain.CreateCampaignGas(context.Background(), title, campaignType, description, goal, deadline, image, address)
+	if err != nil {
+		return "", err
+	}
+
 	auth.GasPrice = (gasPrice)
-	auth.GasLimit = uint64(3000000)
+	auth.GasLimit = gas
 	auth.Nonce = big.NewInt(int64(nonce))
 
-	goals := big.NewInt(int64(goal * 1e18))
+	goals := utils.EtherToWeiInt(goal)
 
 	tsx, err := tx.CreateCampaign(auth, campaignType, title, description, goals, big.NewInt(deadline.Unix()), image)
 	if err != nil {
@@ -79,19 +78,14 @@ func CreateCampaign(title string, campaignType string, description string, goal
 
 }
 
-func GetCampaign(id int) (*Campaign, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetCampaign(id int) (*Campaign, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return &Campaign{}, err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGen(cAdd, client)
 	if err != nil {
@@ -109,15 +103,16 @@ func GetCampaign(id int) (*Campaign, error) {
 	}
 
 	campaigns := Campaign{
-		Title:        campaign.Title,
-		CampaignType: campaign.CampaignType,
-		Description:  campaign.Description,
-		Goal:         campaign.Goal.Int64(),
-		Deadline:     campaign.Deadline.Int64(),
-		Image:        campaign.Image,
-		ID:           campaign.Id.Int64(),
-		TotalFunds:   campaign.TotalFunds.Int64(),
-		Owner:        campaign.Owner.Hex(),
+		Title:           campaign.Title,
+		CampaignType:    campaign.CampaignType,
+		Description:     campaign.Description,
+		Goal:            campaign.Goal,
+		Deadline:        campaign.Deadline.Int64(),
+		Image:           campaign.Image,
+		ID:              campaign.Id.Int64(),
+		TotalFunds:      campaign.TotalFunds,
+		Owner:           campaign.Owner.Hex(),
+		ContractVersion: chain.Version,
 	}
 
 	return &campaigns, nil
@@ -127,13 +122,14 @@ type Campaign struct {
 	Title                  string
 	CampaignType           string
 	Description            string
-	Goal                   int64
+	Goal                   *big.Int
 	Deadline               int64
 	Image                  string
 	ID                     int64
-	TotalFunds             int64
+	TotalFunds             *big.Int
 	Owner                  string
 	TotalNumberOfDonations int64
+	ContractVersion        int
 }
 
 type CampaignCategory struct {
@@ -143,19 +139,14 @@ type CampaignCategory struct {
 	ID          string
 }
 
-func GetCampaigns(address string) ([]Campaign, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetCampaigns(address string) ([]Campaign, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
@@ -173,15 +164,17 @@ func GetCampaigns(address string) ([]Campaign, error) {
 
 	for _, campaign := range campaigns {
 		campaigns := Campaign{
-			Title:        campaign.Title,
-			CampaignType: campaign.CampaignType,
-			Description:  campaign.Description,
-			Goal:         campaign.Goal.Int64(),
-			Deadline:     campaign.Deadline.Int64(),
-			Image:        campaign.Image,
-			TotalFunds:   campaign.TotalFunds.Int64(),
-			Owner:        campaign.Owner.Hex(),
-			ID:           campaign.Id.Int64(),
+			Title:                  campaign.Title,
+			CampaignType:           campaign.CampaignType,
+			Description:            campaign.Description,
+			Goal:                   campaign.Goal,
+			Deadline:               campaign.Deadline.Int64(),
+			Image:                  campaign.Image,
+			TotalFunds:             campaign.TotalFunds,
+			Owner:                  campaign.Owner.Hex(),
+			ContractVersion:        chain.Version,
+			ID:                     campaign.Id.Int64(),
+			TotalNumberOfDonations: campaign.TotalContributors.Int64(),
 		}
 
 		campaignList = append(campaignList, campaigns)
@@ -190,13 +183,20 @@ func GetCampaigns(address string) ([]Campaign, error) {
 	return campaignList, nil
 }
 
-func Donate(amount float64, id int, privateKey *ecdsa.PrivateKey, address string) (string, error) {
-	configs, err := utils.LoadConfig("./../")
+// Donate sends an amount of ether to a campaign and returns the transaction hash with the wei sent,
+// which is what a donation record must hold for the indexer to link it to the on-chain donation
+func (chain *Chain) Donate(amount float64, id int, privateKey *ecdsa.PrivateKey, address string) (string, *big.Int, error) {
+	wei := utils.EtherToWeiInt(amount)
+	hash, err := chain.DonateWei(wei, id, privateKey, address)
 	if err != nil {
-		log.Fatal().Msg("cannot load config")
+		return "", nil, err
 	}
+	return hash, wei, nil
+}
 
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+// DonateWei sends exactly wei to a campaign
+func (chain *Chain) DonateWei(wei *big.Int, id int, privateKey *ecdsa.PrivateKey, address string) (string, error) {
+	client, err := chain.DialWriter(context.Background())
 	if err != nil {
 		return "", err
 	}
@@ -216,7 +216,7 @@ func Donate(amount float64, id int, privateKey *ecdsa.PrivateKey, address string
 		return "", err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGenTransactor(cAdd, client)
 	if err != nil {
@@ -228,13 +228,15 @@ func Donate(amount float64, id int, privateKey *ecdsa.PrivateKey, address string
 		return "", err
 	}
 
-	// convert amount to wei
-	amount = amount * 1e18
+	gas, err := chain.DonateGas(context.Background(), wei, id, address)
+	if err != nil {
+		return "", err
+	}
 
 	auth.GasPrice = (gasPrice)
-	auth.GasLimit = uint64(3000000)
+	auth.GasLimit = gas
 	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(int64(amount))
+	auth.Value = new(big.Int).Set(wei)
 	auth.From = common.HexToAddress(address)
 
 	tsx, err := tx.Donate(auth, big.NewInt(int64(id)))
@@ -250,19 +252,14 @@ func Donate(amount float64, id int, privateKey *ecdsa.PrivateKey, address string
 	return tsx.Hash().Hex(), nil
 }
 
-func GetDonations(id int) ([]common.Address, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetDonations(id int) ([]common.Address, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
@@ -291,19 +288,14 @@ func GetDonations(id int) ([]common.Address, error) {
 	return donationList, nil
 }
 
-func GetDonorsAddressesAndAmounts(id int) ([]string, []int64, *big.Int, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetDonorsAddressesAndAmounts(id int) ([]string, []int64, *big.Int, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, nil, nil, err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
@@ -338,19 +330,14 @@ func GetDonorsAddressesAndAmounts(id int) ([]string, []int64, *big.Int, error) {
 	return donatorsList, amountsList, totalFunds, nil
 }
 
-func GetCampaignTypes(address string) ([]gen.CrowdFundingCampaign, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetCampaignTypes(address string) ([]gen.CrowdFundingCampaign, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
@@ -375,19 +362,14 @@ func GetCampaignTypes(address string) ([]gen.CrowdFundingCampaign, error) {
 	return campaignTypeList, nil
 }
 
-func GetCampaignsByOwner(address string) ([]Campaign, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetCampaignsByOwner(address string) ([]Campaign, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
@@ -407,15 +389,16 @@ func GetCampaignsByOwner(address string) ([]Campaign, error) {
 
 	for _, campaign := range campaigns {
 		campaigns := Campaign{
-			Title:        campaign.Title,
-			CampaignType: campaign.CampaignType,
-			Description:  campaign.Description,
-			Goal:         campaign.Goal.Int64(),
-			Deadline:     campaign.Deadline.Int64(),
-			Image:        campaign.Image,
-			TotalFunds:   campaign.TotalFunds.Int64(),
-			Owner:        campaign.Owner.Hex(),
-			ID:           campaign.Id.Int64(),
+			Title:           campaign.Title,
+			CampaignType:    campaign.CampaignType,
+			Description:     campaign.Description,
+			Goal:            campaign.Goal,
+			Deadline:        campaign.Deadline.Int64(),
+			Image:           campaign.Image,
+			TotalFunds:      campaign.TotalFunds,
+			Owner:           campaign.Owner.Hex(),
+			ContractVersion: chain.Version,
+			ID:              campaign.Id.Int64(),
 		}
 
 		campaignList = append(campaignList, campaigns)
@@ -424,17 +407,13 @@ func GetCampaignsByOwner(address string) ([]Campaign, error) {
 	return campaignList, nil
 }
 
-func GetTotalDonationsByCampaignId(id int) (*big.Int, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetTotalDonationsByCampaignId(id int) (*big.Int, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
 		log.Err(err)
@@ -450,19 +429,14 @@ func GetTotalDonationsByCampaignId(id int) (*big.Int, error) {
 	return totalDonations, nil
 }
 
-func PayOut(id int, address string, privateKey *ecdsa.PrivateKey) (string, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) PayOut(id int, address string, privateKey *ecdsa.PrivateKey) (string, error) {
+	client, err := chain.DialWriter(context.Background())
 	if err != nil {
 		log.Err(err)
 		return "", err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	nonce, err := client.PendingNonceAt(context.Background(), common.HexToAddress(address))
 	if err != nil {
@@ -485,9 +459,15 @@ func PayOut(id int, address string, privateKey *ecdsa.PrivateKey) (string, error
 		log.Err(err)
 		return "", err
 	}
+	gas, err := chain.PayOutGas(context.Background(), id, address)
+	if err != nil {
+		log.Err(err)
+		return "", err
+	}
+
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.Value = big.NewInt(0)
-	auth.GasLimit = uint64(1000000)
+	auth.GasLimit = gas
 	auth.GasPrice = gasPrice
 
 	tx, err := gen.NewGenTransactor(cAdd, client)
@@ -512,19 +492,14 @@ func PayOut(id int, address string, privateKey *ecdsa.PrivateKey) (string, error
 	return tsx.Hash().Hex(), nil
 }
 
-func SendBackDonations(id int, address string, privateKey *ecdsa.PrivateKey) (string, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) SendBackDonations(id int, address string, privateKey *ecdsa.PrivateKey) (string, error) {
+	client, err := chain.DialWriter(context.Background())
 	if err != nil {
 		log.Err(err)
 		return "", err
 	}
 
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 
 	nonce, err := client.PendingNonceAt(context.Background(), common.HexToAddress(address))
 	if err != nil {
@@ -574,12 +549,8 @@ func SendBackDonations(id int, address string, privateKey *ecdsa.PrivateKey) (st
 	return tsx.Hash().Hex(), nil
 }
 
-func CreateCategories(categoryName string, description string, image string, privateKey *ecdsa.PrivateKey, address string) (string, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) CreateCategories(categoryName string, description string, image string, privateKey *ecdsa.PrivateKey, address string) (string, error) {
+	client, err := chain.DialWriter(context.Background())
 	if err != nil {
 		return "", err
 	}
@@ -595,7 +566,7 @@ func CreateCategories(categoryName string, description string, image string, pri
 	if err != nil {
 		return "", err
 	}
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 	tx, err := gen.NewGenTransactor(cAdd, client)
 	if err != nil {
 		return "", err
@@ -618,17 +589,13 @@ func CreateCategories(categoryName string, description string, image string, pri
 	return tsx.Hash().Hex(), nil
 }
 
-func GetCategories() ([]CampaignCategory, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetCategories() ([]CampaignCategory, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
 		log.Err(err)
@@ -655,17 +622,13 @@ func GetCategories() ([]CampaignCategory, error) {
 	return categoryList, nil
 }
 
-func SearchCampaigns(name string) ([]Campaign, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) SearchCampaigns(name string) ([]Campaign, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
 		log.Err(err)
@@ -680,15 +643,16 @@ func SearchCampaigns(name string) ([]Campaign, error) {
 
 	for _, campaign := range campaigns {
 		campaigns := Campaign{
-			Title:        campaign.Title,
-			CampaignType: campaign.CampaignType,
-			Description:  campaign.Description,
-			Goal:         campaign.Goal.Int64(),
-			Deadline:     campaign.Deadline.Int64(),
-			Image:        campaign.Image,
-			TotalFunds:   campaign.TotalFunds.Int64(),
-			Owner:        campaign.Owner.Hex(),
-			ID:           campaign.Id.Int64(),
+			Title:           campaign.Title,
+			CampaignType:    campaign.CampaignType,
+			Description:     campaign.Description,
+			Goal:            campaign.Goal,
+			Deadline:        campaign.Deadline.Int64(),
+			Image:           campaign.Image,
+			TotalFunds:      campaign.TotalFunds,
+			Owner:           campaign.Owner.Hex(),
+			ContractVersion: chain.Version,
+			ID:              campaign.Id.Int64(),
 		}
 
 		campaignList = append(campaignList, campaigns)
@@ -697,17 +661,13 @@ func SearchCampaigns(name string) ([]Campaign, error) {
 	return campaignList, nil
 }
 
-func GetCampaignByCategory(categoryId int64) ([]Campaign, error) {
-	configs, err := utils.LoadConfig("./../")
-	if err != nil {
-		log.Fatal().Msg("cannot load config")
-	}
-	client, err := ethclient.DialContext(context.Background(), configs.CryptoDeployURL)
+func (chain *Chain) GetCampaignByCategory(categoryId int64) ([]Campaign, error) {
+	client, err := chain.Dial(context.Background())
 	if err != nil {
 		log.Err(err)
 		return nil, err
 	}
-	cAdd := common.HexToAddress(Address)
+	cAdd := chain.Contract()
 	tx, err := gen.NewGenCaller(cAdd, client)
 	if err != nil {
 		log.Err(err)
@@ -722,15 +682,16 @@ func GetCampaignByCategory(categoryId int64) ([]Campaign, error) {
 
 	for _, campaign := range campaigns {
 		campaigns := Campaign{
-			Title:        campaign.Title,
-			CampaignType: campaign.CampaignType,
-			Description:  campaign.Description,
-			Goal:         campaign.Goal.Int64(),
-			Deadline:     campaign.Deadline.Int64(),
-			Image:        campaign.Image,
-			TotalFunds:   campaign.TotalFunds.Int64(),
-			Owner:        campaign.Owner.Hex(),
-			ID:           campaign.Id.Int64(),
+			Title:           campaign.Title,
+			CampaignType:    campaign.CampaignType,
+			Description:     campaign.Description,
+			Goal:            campaign.Goal,
+			Deadline:        campaign.Deadline.Int64(),
+			Image:           campaign.Image,
+			TotalFunds:      campaign.TotalFunds,
+			Owner:           campaign.Owner.Hex(),
+			ContractVersion: chain.Version,
+			ID:              campaign.Id.Int64(),
 		}
 
 		campaignList = append(campaignList, campaigns)
@@ -738,3 +699,24 @@ func GetCampaignByCategory(categoryId int64) ([]Campaign, error) {
 
 	return campaignList, nil
 }
+
+// CampaignFunds returns a campaign's goal and the funds it still holds, in wei
+func (chain *Chain) CampaignFunds(id int64) (*big.Int, *big.Int, error) {
+	client, err := chain.Dial(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer client.Close()
+
+	caller, err := gen.NewGenCaller(chain.Contract(), client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	campaign, err := caller.GetCampaign(&bind.CallOpts{}, big.NewInt(id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return campaign.Goal, campaign.TotalFunds, nil
+}
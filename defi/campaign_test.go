@@ -33,7 +33,7 @@ func createCampaign(t *testing.T) (*ecdsa.PrivateKey, string, error) {
 	deadline := time.Now().AddDate(0, 0, 1)
 	campaignType := "Education"
 
-	campaign, err := CreateCampaign(title, campaignType, description, goal, deadline, image, private, "0x9616c35e6042a3c008c0f2badedcdc84fd7eb8b0")
+	campaign, err := testChain(t).CreateCampaign(title, campaignType, description, goal, deadline, image, private, "0x9616c35e6042a3c008c0f2badedcdc84fd7eb8b0")
 	if err != nil {
 		return nil, "", err
 	}
@@ -57,7 +57,7 @@ func TestGetCampaign(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	campaign, err := GetCampaign(0)
+	campaign, err := testChain(t).GetCampaign(0)
 	require.NoError(t, err)
 	require.NotEmpty(t, campaign)
 }
@@ -67,7 +67,7 @@ func TestGetCampaigns(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	campaigns, err := GetCampaigns("0x0e0c554d2b37105838b45e8b5a49d0edc9b00a8f")
+	campaigns, err := testChain(t).GetCampaigns("0x0e0c554d2b37105838b45e8b5a49d0edc9b00a8f")
 	require.NoError(t, err)
 	require.NotEmpty(t, campaigns)
 }
@@ -82,9 +82,10 @@ func TestDonate(t *testing.T) {
 	require.NotEmpty(t, private)
 	require.NotEmpty(t, tr)
 
-	donate, err := Donate(0.1, 1, private, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
+	donate, wei, err := testChain(t).Donate(0.1, 1, private, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
 	require.NoError(t, err)
 	require.NotEmpty(t, donate)
+	require.Equal(t, "100000000000000000", wei.String())
 }
 
 func TestGetDonations(t *testing.T) {
@@ -92,7 +93,7 @@ func TestGetDonations(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	donations, err := GetDonations(1)
+	donations, err := testChain(t).GetDonations(1)
 	t.Log(donations)
 	require.NoError(t, err)
 	require.NotEmpty(t, donations)
@@ -103,7 +104,7 @@ func TestGetDonorsAddressesAndAmounts(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	donors, amounts, total, err := GetDonorsAddressesAndAmounts(0)
+	donors, amounts, total, err := testChain(t).GetDonorsAddressesAndAmounts(0)
 	require.NoError(t, err)
 	require.NotEmpty(t, donors)
 	require.NotEmpty(t, amounts)
@@ -115,7 +116,7 @@ func TestGetCampaignTypes(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	types, err := GetCampaignTypes("0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
+	types, err := testChain(t).GetCampaignTypes("0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
 	require.NoError(t, err)
 	require.NotEmpty(t, types)
 }
@@ -125,7 +126,7 @@ func TestGetCampaignsByOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	campaigns, err := GetCampaignsByOwner("0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
+	campaigns, err := testChain(t).GetCampaignsByOwner("0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
 	require.NoError(t, err)
 	require.NotEmpty(t, campaigns)
 }
@@ -142,7 +143,7 @@ func TestPayOut(t *testing.T) {
 	require.NotEmpty(t, private)
 	require.NotEmpty(t, public)
 
-	payout, err := PayOut(1, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985", private)
+	payout, err := testChain(t).PayOut(1, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985", private)
 	require.NoError(t, err)
 	require.NotEmpty(t, payout)
 }
@@ -159,7 +160,7 @@ func TestSendBackDonations(t *testing.T) {
 	require.NotEmpty(t, private)
 	require.NotEmpty(t, public)
 
-	sendback, err := SendBackDonations(1, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985", private)
+	sendback, err := testChain(t).SendBackDonations(1, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985", private)
 	require.NoError(t, err)
 	require.NotEmpty(t, sendback)
 }
@@ -175,7 +176,7 @@ func TestCreateCategory(t *testing.T) {
 	require.NotEmpty(t, private)
 	require.NotEmpty(t, public)
 
-	category, err := CreateCategories("Education", "Donate to Sponsor a child Education", "", private, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
+	category, err := testChain(t).CreateCategories("Education", "Donate to Sponsor a child Education", "", private, "0xa487ff39ac2de30c0105b60dc3e51e377ae95985")
 	require.NoError(t, err)
 	require.NotEmpty(t, category)
 }
@@ -185,7 +186,7 @@ func TestGetCategories(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	categories, err := GetCategories()
+	categories, err := testChain(t).GetCategories()
 
 	t.Log(err)
 	// t.Log(categories)
@@ -198,7 +199,7 @@ func TestSearchCampaignByName(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, configs)
 
-	campaigns, err := SearchCampaigns("Test Campaign")
+	campaigns, err := testChain(t).SearchCampaigns("Test Campaign")
 	require.NoError(t, err)
 	require.NotEmpty(t, campaigns)
 }
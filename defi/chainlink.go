@@ -0,0 +1,301 @@
+package defi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/demola234/defiraise/price"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// aggregatorV3ABI is the subset of Chainlink's AggregatorV3Interface read by ChainlinkSource
+const aggregatorV3ABI = `[
+	{"inputs":[],"name":"decimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"latestRoundData","outputs":[
+		{"internalType":"uint80","name":"roundId","type":"uint80"},
+		{"internalType":"int256","name":"answer","type":"int256"},
+		{"internalType":"uint256","name":"startedAt","type":"uint256"},
+		{"internalType":"uint256","name":"updatedAt","type":"uint256"},
+		{"internalType":"uint80","name":"answeredInRound","type":"uint80"}
+	],"stateMutability":"view","type":"function"}
+]`
+
+const (
+	// ChainlinkPrimary reads Chainlink first and falls back to the HTTP sources
+	ChainlinkPrimary = "primary"
+	// ChainlinkFallback reads the HTTP sources first and falls back to Chainlink
+	ChainlinkFallback = "fallback"
+
+	// defaultHeartbeat is the maximum round age of feeds configured through CHAINLINK_FEEDS
+	defaultHeartbeat = time.Hour
+)
+
+var (
+	// ErrStaleRound is returned when a feed's latest round is incomplete or older than its heartbeat
+	ErrStaleRound = errors.New("chainlink round is stale")
+
+	aggregatorV3 = mustParseABI(aggregatorV3ABI)
+)
+
+// ChainlinkFeed is an AggregatorV3 proxy and the longest time it may go without a new round
+type ChainlinkFeed struct {
+	Address   common.Address
+	Heartbeat time.Duration
+}
+
+// UnmarshalJSON reads a chain's chainlink_feeds entry in CHAINS_FILE as {"address": "0x...", "heartbeat": "24h"}. The
+// heartbeat defaults to an hour.
+func (feed *ChainlinkFeed) UnmarshalJSON(data []byte) error {
+	var value struct {
+		Address   string `json:"address"`
+		Heartbeat string `json:"heartbeat"`
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if !common.IsHexAddress(value.Address) {
+		return fmt.Errorf("invalid chainlink feed address %q", value.Address)
+	}
+
+	feed.Address = common.HexToAddress(value.Address)
+	feed.Heartbeat = defaultHeartbeat
+	if value.Heartbeat != "" {
+		heartbeat, err := time.ParseDuration(value.Heartbeat)
+		if err != nil {
+			return fmt.Errorf("invalid chainlink feed heartbeat %q: %w", value.Heartbeat, err)
+		}
+		feed.Heartbeat = heartbeat
+	}
+	return nil
+}
+
+// SepoliaFeeds are the Chainlink proxies on Sepolia, used by the built-in Sepolia chain
+var SepoliaFeeds = map[string]ChainlinkFeed{
+	"ETH/USD":  {Address: common.HexToAddress("0x694AA1769357215DE4FAC081bf1f309aDC325306"), Heartbeat: time.Hour},
+	"BTC/USD":  {Address: common.HexToAddress("0x1b44F3514812d835EB1BDB0acB33d3fA3351Ee43"), Heartbeat: time.Hour},
+	"LINK/USD": {Address: common.HexToAddress("0xc59E3633BAAC79493d908e63626716e204A45EdF"), Heartbeat: time.Hour},
+	"DAI/USD":  {Address: common.HexToAddress("0x14866185B1962B63C3Ea9E03Bc1da838bab34C19"), Heartbeat: time.Hour},
+	"USDC/USD": {Address: common.HexToAddress("0xA2F78ab2355fe2f984D808B5CeE7FD0A93D5270E"), Heartbeat: 24 * time.Hour},
+	"EUR/USD":  {Address: common.HexToAddress("0x1a81afB8146aeFfCFc5E50e8479e826E7D55b910"), Heartbeat: 24 * time.Hour},
+}
+
+// ChainlinkSource reads prices from Chainlink AggregatorV3 feeds.
+// Pairs without a feed of their own are derived through USD when both legs have one, e.g. ETH/EUR = ETH/USD / EUR/USD.
+type ChainlinkSource struct {
+	caller   bind.ContractCaller
+	feeds    map[string]ChainlinkFeed
+	now      func() time.Time
+	mu       sync.Mutex
+	decimals map[common.Address]uint8
+}
+
+// NewChainlinkSource creates a source reading feeds through caller, keyed by "BASE/QUOTE"
+func NewChainlinkSource(caller bind.ContractCaller, feeds map[string]ChainlinkFeed) *ChainlinkSource {
+	normalized := make(map[string]ChainlinkFeed, len(feeds))
+	for pair, feed := range feeds {
+		normalized[strings.ToUpper(pair)] = feed
+	}
+
+	return &ChainlinkSource{
+		caller:   caller,
+		feeds:    normalized,
+		now:      time.Now,
+		decimals: make(map[common.Address]uint8),
+	}
+}
+
+func (source *ChainlinkSource) Name() string {
+	return "chainlink"
+}
+
+func (source *ChainlinkSource) GetPrice(ctx context.Context, base string, currency string) (price.Quote, error) {
+	base, currency = strings.ToUpper(base), strings.ToUpper(currency)
+
+	if feed, ok := source.feeds[base+"/"+currency]; ok {
+		return source.read(ctx, feed, base, currency)
+	}
+
+	// derive the pair through USD
+	baseFeed, ok := source.feeds[base+"/USD"]
+	if !ok {
+		return price.Quote{}, price.ErrUnsupportedPair
+	}
+	currencyFeed, ok := source.feeds[currency+"/USD"]
+	if !ok {
+		return price.Quote{}, price.ErrUnsupportedPair
+	}
+
+	baseQuote, err := source.read(ctx, baseFeed, base, "USD")
+	if err != nil {
+		return price.Quote{}, err
+	}
+	currencyQuote, err := source.read(ctx, currencyFeed, currency, "USD")
+	if err != nil {
+		return price.Quote{}, err
+	}
+
+	updatedAt := baseQuote.UpdatedAt
+	if currencyQuote.UpdatedAt.Before(updatedAt) {
+		updatedAt = currencyQuote.UpdatedAt
+	}
+
+	return price.Quote{
+		Base:      base,
+		Currency:  currency,
+		Price:     baseQuote.Price / currencyQuote.Price,
+		Source:    source.Name(),
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// read returns the latest answer of a feed scaled by its decimals
+func (source *ChainlinkSource) read(ctx context.Context, feed ChainlinkFeed, base string, currency string) (price.Quote, error) {
+	contract := bind.NewBoundContract(feed.Address, aggregatorV3, source.caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+
+	decimals, err := source.feedDecimals(opts, contract, feed.Address)
+	if err != nil {
+		return price.Quote{}, err
+	}
+
+	var out []interface{}
+	if err := contract.Call(opts, &out, "latestRoundData"); err != nil {
+		return price.Quote{}, err
+	}
+
+	roundID := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	answer := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	updatedAt := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	answeredInRound := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	if answer.Sign() <= 0 {
+		return price.Quote{}, fmt.Errorf("chainlink %s/%s returned a non-positive answer %s", base, currency, answer)
+	}
+	if updatedAt.Sign() == 0 || answeredInRound.Cmp(roundID) < 0 {
+		return price.Quote{}, fmt.Errorf("%w: %s/%s round %s is incomplete", ErrStaleRound, base, currency, roundID)
+	}
+
+	updated := time.Unix(updatedAt.Int64(), 0)
+	heartbeat := feed.Heartbeat
+	if heartbeat <= 0 {
+		heartbeat = defaultHeartbeat
+	}
+	if source.now().Sub(updated) > heartbeat {
+		return price.Quote{}, fmt.Errorf("%w: %s/%s was last updated at %s", ErrStaleRound, base, currency, updated.UTC().Format(time.RFC3339))
+	}
+
+	value, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(answer),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)),
+	).Float64()
+
+	return price.Quote{
+		Base:      base,
+		Currency:  currency,
+		Price:     value,
+		Source:    source.Name(),
+		UpdatedAt: updated,
+	}, nil
+}
+
+// feedDecimals reads a feed's decimals once, they never change for a deployed aggregator
+func (source *ChainlinkSource) feedDecimals(opts *bind.CallOpts, contract *bind.BoundContract, address common.Address) (uint8, error) {
+	source.mu.Lock()
+	decimals, ok := source.decimals[address]
+	source.mu.Unlock()
+	if ok {
+		return decimals, nil
+	}
+
+	var out []interface{}
+	if err := contract.Call(opts, &out, "decimals"); err != nil {
+		return 0, err
+	}
+	decimals = *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	source.mu.Lock()
+	source.decimals[address] = decimals
+	source.mu.Unlock()
+
+	return decimals, nil
+}
+
+// ParseChainlinkFeeds reads CHAINLINK_FEEDS, a comma separated list of BASE/QUOTE=0xaddress entries
+func ParseChainlinkFeeds(value string) (map[string]ChainlinkFeed, error) {
+	feeds := make(map[string]ChainlinkFeed)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		pair, address, ok := strings.Cut(entry, "=")
+		if !ok || !strings.Contains(pair, "/") || !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("invalid chainlink feed %q, expected BASE/QUOTE=0xaddress", entry)
+		}
+		feeds[strings.ToUpper(strings.TrimSpace(pair))] = ChainlinkFeed{
+			Address:   common.HexToAddress(address),
+			Heartbeat: defaultHeartbeat,
+		}
+	}
+
+	return feeds, nil
+}
+
+// NewPriceProvider creates the price provider used by the API and indexer: the HTTP source oracle,
+// combined with Chainlink as primary or fallback source when PRICE_CHAINLINK is set. The feeds are
+// those of the default chain, read through its RPC pool; CHAINLINK_FEEDS replaces them.
+func NewPriceProvider(configs utils.Config, chains *ChainRegistry) (price.PriceProvider, error) {
+	oracle, err := price.NewOracleFromConfig(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := strings.ToLower(strings.TrimSpace(configs.PriceChainlink))
+	if mode == "" {
+		return oracle, nil
+	}
+	if mode != ChainlinkPrimary && mode != ChainlinkFallback {
+		return nil, fmt.Errorf("invalid PRICE_CHAINLINK %q, expected %s or %s", configs.PriceChainlink, ChainlinkPrimary, ChainlinkFallback)
+	}
+
+	chain := chains.Default()
+	feeds := chain.ChainlinkFeeds
+	if configs.ChainlinkFeeds != "" {
+		feeds, err = ParseChainlinkFeeds(configs.ChainlinkFeeds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(feeds) == 0 {
+		return nil, fmt.Errorf("PRICE_CHAINLINK is set but chain %d has no chainlink_feeds", chain.ID)
+	}
+
+	client, err := chain.Dial(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	chainlink := NewChainlinkSource(client, feeds)
+
+	if mode == ChainlinkPrimary {
+		return price.NewFallback(chainlink, oracle), nil
+	}
+	return price.NewFallback(oracle, chainlink), nil
+}
+
+func mustParseABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -0,0 +1,145 @@
+package defi
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/demola234/defiraise/price"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRound struct {
+	decimals        uint8
+	roundID         int64
+	answer          int64
+	updatedAt       time.Time
+	answeredInRound int64
+}
+
+// fakeAggregators answers AggregatorV3 calls from fixed rounds
+type fakeAggregators map[common.Address]fakeRound
+
+func (feeds fakeAggregators) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (feeds fakeAggregators) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	round := feeds[*call.To]
+
+	method, err := aggregatorV3.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "decimals" {
+		return method.Outputs.Pack(round.decimals)
+	}
+
+	return method.Outputs.Pack(
+		big.NewInt(round.roundID),
+		big.NewInt(round.answer),
+		big.NewInt(round.updatedAt.Unix()),
+		big.NewInt(round.updatedAt.Unix()),
+		big.NewInt(round.answeredInRound),
+	)
+}
+
+func TestChainlinkSource(t *testing.T) {
+	now := time.Now()
+	ethFeed := common.HexToAddress("0x01")
+	eurFeed := common.HexToAddress("0x02")
+	usdcFeed := common.HexToAddress("0x03")
+
+	feeds := map[string]ChainlinkFeed{
+		"ETH/USD":  {Address: ethFeed, Heartbeat: time.Hour},
+		"EUR/USD":  {Address: eurFeed, Heartbeat: 24 * time.Hour},
+		"USDC/USD": {Address: usdcFeed, Heartbeat: time.Hour},
+	}
+	caller := fakeAggregators{
+		ethFeed:  {decimals: 8, roundID: 10, answer: 300000000000, updatedAt: now.Add(-time.Minute), answeredInRound: 10},
+		eurFeed:  {decimals: 8, roundID: 5, answer: 125000000, updatedAt: now.Add(-12 * time.Hour), answeredInRound: 5},
+		usdcFeed: {decimals: 8, roundID: 7, answer: 100000000, updatedAt: now.Add(-2 * time.Hour), answeredInRound: 7},
+	}
+	source := NewChainlinkSource(caller, feeds)
+
+	quote, err := source.GetPrice(context.Background(), "eth", "usd")
+	require.NoError(t, err)
+	require.Equal(t, 3000.0, quote.Price)
+	require.Equal(t, now.Add(-time.Minute).Unix(), quote.UpdatedAt.Unix())
+
+	// ETH/EUR is derived from ETH/USD and EUR/USD and carries the older timestamp
+	quote, err = source.GetPrice(context.Background(), "ETH", "EUR")
+	require.NoError(t, err)
+	require.Equal(t, 2400.0, quote.Price)
+	require.Equal(t, now.Add(-12*time.Hour).Unix(), quote.UpdatedAt.Unix())
+
+	_, err = source.GetPrice(context.Background(), "USDC", "USD")
+	require.ErrorIs(t, err, ErrStaleRound)
+
+	_, err = source.GetPrice(context.Background(), "ETH", "NGN")
+	require.ErrorIs(t, err, price.ErrUnsupportedPair)
+}
+
+func TestChainlinkSourceIncompleteRound(t *testing.T) {
+	feed := common.HexToAddress("0x01")
+	caller := fakeAggregators{
+		feed: {decimals: 18, roundID: 11, answer: 1, updatedAt: time.Now(), answeredInRound: 10},
+	}
+
+	_, err := NewChainlinkSource(caller, map[string]ChainlinkFeed{"ETH/USD": {Address: feed}}).GetPrice(context.Background(), "ETH", "USD")
+	require.ErrorIs(t, err, ErrStaleRound)
+}
+
+func TestParseChainlinkFeeds(t *testing.T) {
+	feeds, err := ParseChainlinkFeeds("eth/usd=0x694AA1769357215DE4FAC081bf1f309aDC325306, ")
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0x694AA1769357215DE4FAC081bf1f309aDC325306"), feeds["ETH/USD"].Address)
+
+	_, err = ParseChainlinkFeeds("ETHUSD=0x01")
+	require.Error(t, err)
+}
+
+func TestChainlinkFeedJSON(t *testing.T) {
+	var feeds map[string]ChainlinkFeed
+	err := json.Unmarshal([]byte(`{
+		"ETH/USD": {"address": "0x694AA1769357215DE4FAC081bf1f309aDC325306"},
+		"USDC/USD": {"address": "0xA2F78ab2355fe2f984D808B5CeE7FD0A93D5270E", "heartbeat": "24h"}
+	}`), &feeds)
+	require.NoError(t, err)
+	require.Equal(t, ChainlinkFeed{Address: common.HexToAddress("0x694AA1769357215DE4FAC081bf1f309aDC325306"), Heartbeat: time.Hour}, feeds["ETH/USD"])
+	require.Equal(t, 24*time.Hour, feeds["USDC/USD"].Heartbeat)
+
+	require.Error(t, json.Unmarshal([]byte(`{"address": "0x01"}`), new(ChainlinkFeed)))
+	require.Error(t, json.Unmarshal([]byte(`{"address": "0x694AA1769357215DE4FAC081bf1f309aDC325306", "heartbeat": "daily"}`), new(ChainlinkFeed)))
+}
+
+func TestNewPriceProvider(t *testing.T) {
+	feeds := map[string]ChainlinkFeed{"ETH/USD": {Address: common.HexToAddress("0x694AA1769357215DE4FAC081bf1f309aDC325306")}}
+	registry, err := NewChainRegistry([]Chain{
+		{ID: 1, RPCURLs: []string{"https://eth.example.com"}, ContractAddress: Address},
+		{ID: 8453, RPCURLs: []string{"https://base.example.com"}, ContractAddress: Address, ChainlinkFeeds: feeds},
+	}, 8453, RPCHealth{})
+	require.NoError(t, err)
+
+	configs := utils.Config{PriceSources: "coinbase", PriceChainlink: ChainlinkPrimary}
+	_, err = NewPriceProvider(configs, registry)
+	require.NoError(t, err)
+
+	// the default chain has no feeds of its own
+	registry, err = NewChainRegistry([]Chain{
+		{ID: 1, RPCURLs: []string{"https://eth.example.com"}, ContractAddress: Address},
+	}, 0, RPCHealth{})
+	require.NoError(t, err)
+
+	_, err = NewPriceProvider(configs, registry)
+	require.Error(t, err)
+
+	configs.ChainlinkFeeds = "eth/usd=0x694AA1769357215DE4FAC081bf1f309aDC325306"
+	_, err = NewPriceProvider(configs, registry)
+	require.NoError(t, err)
+}
@@ -0,0 +1,377 @@
+package defi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrUnknownChain is returned when a chain ID is not in the registry
+	ErrUnknownChain = errors.New("unknown chain")
+	// ErrUnknownContractVersion is returned when a chain has no contract with the requested version
+	ErrUnknownContractVersion = errors.New("unknown contract version")
+)
+
+// implementationSlot is the EIP-1967 storage slot holding a proxy's implementation address
+var implementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a9e7ab1a5c2bab8b9b")
+
+// ContractVersion is one campaign contract deployed to a chain. Campaign IDs are only unique within
+// a contract. A proxy keeps its address and campaigns across implementation upgrades, so it stays
+// one version.
+type ContractVersion struct {
+	Version int    `json:"version"`
+	Address string `json:"address"`
+	Proxy   bool   `json:"proxy"`
+}
+
+// Chain describes a network the campaign contract is deployed to. A Chain routes contract calls to
+// one contract version, the current one unless it was returned by At.
+type Chain struct {
+	ID      int64    `json:"chain_id"`
+	Name    string   `json:"name"`
+	RPCURLs []string `json:"rpc_urls"`
+	// ContractAddress is shorthand for a chain with a single contract in CHAINS_FILE. Once loaded it
+	// is the address of the contract calls are routed to.
+	ContractAddress string            `json:"contract_address"`
+	Contracts       []ContractVersion `json:"contracts"`
+	ExplorerURL     string            `json:"explorer_url"`
+	NativeSymbol    string            `json:"native_symbol"`
+	// BadgeContract is the address of the chain's supporter badge contract, deployed from
+	// contract/badges.sol. Badges are not minted on a chain without one.
+	BadgeContract string `json:"badge_contract"`
+	// Testnet marks a test network, where new users are sent an onboarding grant by the faucet
+	Testnet bool `json:"testnet"`
+	// ChainlinkFeeds are the chain's Chainlink price feeds keyed by "BASE/QUOTE". The default chain's
+	// feeds are read when PRICE_CHAINLINK is set.
+	ChainlinkFeeds map[string]ChainlinkFeed `json:"chainlink_feeds"`
+	Version        int                      `json:"-"`
+
+	pool *RPCPool
+}
+
+// CurrentVersion returns the version new campaigns are created on, or zero when the chain has no contract
+func (chain *Chain) CurrentVersion() int {
+	if len(chain.Contracts) == 0 {
+		return 0
+	}
+	return chain.Contracts[len(chain.Contracts)-1].Version
+}
+
+// At returns the chain with contract calls routed to the given contract version. Zero selects the current one.
+func (chain *Chain) At(version int) (*Chain, error) {
+	if version == 0 {
+		version = chain.CurrentVersion()
+	}
+
+	for _, contract := range chain.Contracts {
+		if contract.Version == version {
+			view := *chain
+			view.Version = contract.Version
+			view.ContractAddress = contract.Address
+			return &view, nil
+		}
+	}
+	return nil, fmt.Errorf("chain %d version %d: %w", chain.ID, version, ErrUnknownContractVersion)
+}
+
+// Versions returns the chain at each of its contract versions, oldest first
+func (chain *Chain) Versions() []*Chain {
+	versions := make([]*Chain, len(chain.Contracts))
+	for i, contract := range chain.Contracts {
+		versions[i], _ = chain.At(contract.Version)
+	}
+	return versions
+}
+
+// Implementation returns the implementation address behind the chain's contract when it is an EIP-1967 proxy
+func (chain *Chain) Implementation(ctx context.Context) (string, error) {
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	slot, err := client.StorageAt(ctx, chain.Contract(), implementationSlot, nil)
+	if err != nil {
+		return "", err
+	}
+	return common.BytesToAddress(slot).Hex(), nil
+}
+
+// useCurrent routes the chain's calls to its latest contract version
+func (chain *Chain) useCurrent() {
+	sort.Slice(chain.Contracts, func(i, j int) bool {
+		return chain.Contracts[i].Version < chain.Contracts[j].Version
+	})
+
+	chain.Version = chain.CurrentVersion()
+	chain.ContractAddress = ""
+	if chain.Version > 0 {
+		chain.ContractAddress = chain.Contracts[len(chain.Contracts)-1].Address
+	}
+}
+
+// Dial returns a client for reading from the chain, failing over between its RPC providers
+func (chain *Chain) Dial(ctx context.Context) (*Client, error) {
+	if chain.pool == nil {
+		return nil, ErrNoProvider
+	}
+	return chain.pool.Client(), nil
+}
+
+// DialWriter returns a client pinned to one RPC provider, so a transaction's nonce, gas price and send agree
+func (chain *Chain) DialWriter(ctx context.Context) (*Client, error) {
+	if chain.pool == nil {
+		return nil, ErrNoProvider
+	}
+	return chain.pool.Writer()
+}
+
+// Providers returns the health and request metrics of the chain's RPC providers
+func (chain *Chain) Providers() []ProviderStatus {
+	if chain.pool == nil {
+		return nil
+	}
+	return chain.pool.Status()
+}
+
+// Contract returns the address of the campaign contract on the chain
+func (chain *Chain) Contract() common.Address {
+	return common.HexToAddress(chain.ContractAddress)
+}
+
+// TxURL links to a transaction on the chain's block explorer, or is empty when the chain has no explorer
+func (chain *Chain) TxURL(hash string) string {
+	if chain.ExplorerURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(chain.ExplorerURL, "/") + "/tx/" + hash
+}
+
+// ChainRegistry holds the chains the API and indexer can route contract calls to
+type ChainRegistry struct {
+	chains         map[int64]*Chain
+	ordered        []*Chain
+	defaultID      int64
+	healthInterval time.Duration
+}
+
+// NewChainRegistry creates a registry from chains, with a pool over each chain's RPC URLs.
+// defaultID selects the chain used when a request does not name one; zero selects the first chain.
+func NewChainRegistry(chains []Chain, defaultID int64, health RPCHealth) (*ChainRegistry, error) {
+	if len(chains) == 0 {
+		return nil, errors.New("at least one chain is required")
+	}
+
+	registry := &ChainRegistry{chains: make(map[int64]*Chain, len(chains))}
+	for i := range chains {
+		chain := chains[i]
+		if chain.ID <= 0 || len(chain.RPCURLs) == 0 {
+			return nil, fmt.Errorf("invalid chain %d: chain_id and rpc_urls are required", chain.ID)
+		}
+		if chain.ContractAddress != "" && len(chain.Contracts) > 0 {
+			return nil, fmt.Errorf("invalid chain %d: set contract_address or contracts, not both", chain.ID)
+		}
+		if chain.ContractAddress != "" {
+			chain.Contracts = []ContractVersion{{Address: chain.ContractAddress}}
+		}
+
+		chain.Contracts = append([]ContractVersion{}, chain.Contracts...)
+		versions := make(map[int]bool, len(chain.Contracts))
+		for i := range chain.Contracts {
+			contract := &chain.Contracts[i]
+			if contract.Version == 0 {
+				contract.Version = i + 1
+			}
+			if contract.Version < 0 || versions[contract.Version] || !common.IsHexAddress(contract.Address) {
+				return nil, fmt.Errorf("invalid chain %d: contract %d needs a unique version and an address", chain.ID, i)
+			}
+			versions[contract.Version] = true
+		}
+		chain.useCurrent()
+		if _, ok := registry.chains[chain.ID]; ok {
+			return nil, fmt.Errorf("chain %d is listed twice", chain.ID)
+		}
+		if chain.BadgeContract != "" && !common.IsHexAddress(chain.BadgeContract) {
+			return nil, fmt.Errorf("invalid chain %d: badge_contract is not an address", chain.ID)
+		}
+		if chain.NativeSymbol == "" {
+			chain.NativeSymbol = NativeToken
+		}
+		chain.NativeSymbol = strings.ToUpper(chain.NativeSymbol)
+
+		pool, err := NewRPCPool(chain.RPCURLs, health)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain %d: %w", chain.ID, err)
+		}
+		chain.pool = pool
+
+		registry.chains[chain.ID] = &chain
+		registry.ordered = append(registry.ordered, &chain)
+	}
+
+	if defaultID == 0 {
+		defaultID = registry.ordered[0].ID
+	}
+	if _, ok := registry.chains[defaultID]; !ok {
+		return nil, fmt.Errorf("default chain %d: %w", defaultID, ErrUnknownChain)
+	}
+	registry.defaultID = defaultID
+	registry.healthInterval = DefaultHealthInterval
+
+	return registry, nil
+}
+
+// AddContract adds a deployed contract version to a chain, replacing a configured contract with the
+// same version. New campaigns go to the latest version. It is not safe to call once the registry is
+// serving requests.
+func (registry *ChainRegistry) AddContract(chainID int64, contract ContractVersion) error {
+	chain, err := registry.Get(chainID)
+	if err != nil {
+		return err
+	}
+	if contract.Version <= 0 || !common.IsHexAddress(contract.Address) {
+		return fmt.Errorf("chain %d: contract version %d at %q is not valid", chainID, contract.Version, contract.Address)
+	}
+
+	replaced := false
+	for i := range chain.Contracts {
+		if chain.Contracts[i].Version == contract.Version {
+			chain.Contracts[i] = contract
+			replaced = true
+		}
+	}
+	if !replaced {
+		chain.Contracts = append(chain.Contracts, contract)
+	}
+
+	chain.useCurrent()
+	return nil
+}
+
+// RequireContracts returns an error naming the first chain with no campaign contract
+func (registry *ChainRegistry) RequireContracts() error {
+	for _, chain := range registry.ordered {
+		if len(chain.Contracts) == 0 {
+			return fmt.Errorf("chain %d has no contracts and no recorded deployment", chain.ID)
+		}
+	}
+	return nil
+}
+
+// LoadChainRegistry creates a registry from the JSON array of chains in CHAINS_FILE. Without one the
+// registry holds a single Sepolia chain reached over CRYPT_DEPLOY_URL.
+func LoadChainRegistry(configs utils.Config) (*ChainRegistry, error) {
+	chains, err := loadChains(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := NewChainRegistry(chains, configs.DefaultChainID, RPCHealth{
+		MaxBlockLag: configs.RPCMaxBlockLag,
+		MaxLatency:  configs.RPCMaxLatency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if configs.RPCHealthInterval > 0 {
+		registry.healthInterval = configs.RPCHealthInterval
+	}
+
+	return registry, nil
+}
+
+func loadChains(configs utils.Config) ([]Chain, error) {
+	if configs.ChainsFile == "" {
+		contract := Address
+		if common.IsHexAddress(configs.ContractAddress) {
+			contract = configs.ContractAddress
+		}
+
+		return []Chain{{
+			ID:              SepoliaChainID,
+			Name:            "Sepolia",
+			RPCURLs:         []string{configs.CryptoDeployURL},
+			ContractAddress: contract,
+			ExplorerURL:     "https://sepolia.etherscan.io",
+			NativeSymbol:    NativeToken,
+			Testnet:         true,
+			ChainlinkFeeds:  SepoliaFeeds,
+		}}, nil
+	}
+
+	data, err := os.ReadFile(configs.ChainsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var chains []Chain
+	if err := json.Unmarshal(data, &chains); err != nil {
+		return nil, fmt.Errorf("cannot read chains %s: %w", configs.ChainsFile, err)
+	}
+
+	return chains, nil
+}
+
+// Get returns a chain by ID. Zero returns the default chain.
+func (registry *ChainRegistry) Get(id int64) (*Chain, error) {
+	if id == 0 {
+		return registry.Default(), nil
+	}
+
+	chain, ok := registry.chains[id]
+	if !ok {
+		return nil, fmt.Errorf("chain %d: %w", id, ErrUnknownChain)
+	}
+	return chain, nil
+}
+
+// Default returns the chain used when a request does not name one
+func (registry *ChainRegistry) Default() *Chain {
+	return registry.chains[registry.defaultID]
+}
+
+// All returns every chain in the order they were configured
+func (registry *ChainRegistry) All() []*Chain {
+	return append([]*Chain{}, registry.ordered...)
+}
+
+// CheckHealth health checks the RPC providers of every chain
+func (registry *ChainRegistry) CheckHealth(ctx context.Context) {
+	for _, chain := range registry.ordered {
+		chain.pool.CheckHealth(ctx)
+
+		for _, status := range chain.pool.Status() {
+			if !status.Healthy {
+				log.Warn().Int64("chain_id", chain.ID).Str("host", status.Host).Str("error", status.LastError).
+					Uint64("block_lag", status.BlockLag).Dur("latency", status.Latency).Msg("RPC provider unhealthy")
+			}
+		}
+	}
+}
+
+// StartHealthChecks health checks the RPC providers straight away and then on every interval until ctx is cancelled
+func (registry *ChainRegistry) StartHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(registry.healthInterval)
+	defer ticker.Stop()
+
+	for {
+		registry.CheckHealth(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
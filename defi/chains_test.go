@@ -0,0 +1,151 @@
+package defi
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/demola234/defiraise/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// testChain returns the default chain of the config in the repository root
+func testChain(t *testing.T) *Chain {
+	configs, err := utils.LoadConfig("./../")
+	require.NoError(t, err)
+
+	registry, err := LoadChainRegistry(configs)
+	require.NoError(t, err)
+	return registry.Default()
+}
+
+func TestLoadChainRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	err := os.WriteFile(path, []byte(`[
+		{"chain_id": 1, "name": "Ethereum", "rpc_urls": ["https://eth.example.com"], "contract_address": "0x0000000000000000000000000000000000000001", "explorer_url": "https://etherscan.io/"},
+		{"chain_id": 8453, "name": "Base", "rpc_urls": ["https://base.example.com"], "contract_address": "0x0000000000000000000000000000000000000002", "native_symbol": "eth"}
+	]`), 0o600)
+	require.NoError(t, err)
+
+	registry, err := LoadChainRegistry(utils.Config{ChainsFile: path, DefaultChainID: 8453})
+	require.NoError(t, err)
+	require.Len(t, registry.All(), 2)
+	require.Equal(t, int64(8453), registry.Default().ID)
+
+	chain, err := registry.Get(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(8453), chain.ID)
+
+	mainnet, err := registry.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, "ETH", mainnet.NativeSymbol)
+	require.Equal(t, "https://etherscan.io/tx/0xabc", mainnet.TxURL("0xabc"))
+
+	_, err = registry.Get(10)
+	require.ErrorIs(t, err, ErrUnknownChain)
+
+	_, err = LoadChainRegistry(utils.Config{ChainsFile: path, DefaultChainID: 10})
+	require.ErrorIs(t, err, ErrUnknownChain)
+}
+
+func TestLoadDefaultChainRegistry(t *testing.T) {
+	registry, err := LoadChainRegistry(utils.Config{CryptoDeployURL: "https://sepolia.example.com", ContractAddress: "key"})
+	require.NoError(t, err)
+
+	chain := registry.Default()
+	require.Equal(t, SepoliaChainID, chain.ID)
+	require.Equal(t, []string{"https://sepolia.example.com"}, chain.RPCURLs)
+	require.Equal(t, Address, chain.ContractAddress)
+
+	_, err = NewChainRegistry([]Chain{
+		{ID: 1, RPCURLs: []string{"https://eth.example.com"}, ContractAddress: Address},
+		{ID: 1, RPCURLs: []string{"https://eth.example.com"}, ContractAddress: Address},
+	}, 0, RPCHealth{})
+	require.Error(t, err)
+
+	_, err = NewChainRegistry([]Chain{{ID: 1, ContractAddress: Address}}, 0, RPCHealth{})
+	require.Error(t, err)
+}
+
+func TestChainRegistryContractVersions(t *testing.T) {
+	registry, err := NewChainRegistry([]Chain{
+		{ID: 1, RPCURLs: []string{"https://eth.example.com"}, ContractAddress: Address},
+		{ID: 8453, RPCURLs: []string{"https://base.example.com"}},
+	}, 0, RPCHealth{})
+	require.NoError(t, err)
+	require.Error(t, registry.RequireContracts())
+
+	mainnet, err := registry.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, 1, mainnet.Version)
+
+	deployed := "0x0000000000000000000000000000000000000002"
+	require.NoError(t, registry.AddContract(8453, ContractVersion{Version: 1, Address: deployed}))
+	require.NoError(t, registry.RequireContracts())
+
+	proxy := "0x0000000000000000000000000000000000000003"
+	require.NoError(t, registry.AddContract(1, ContractVersion{Version: 2, Address: proxy, Proxy: true}))
+	require.Equal(t, 2, mainnet.CurrentVersion())
+	require.Equal(t, proxy, mainnet.ContractAddress)
+
+	old, err := mainnet.At(1)
+	require.NoError(t, err)
+	require.Equal(t, Address, old.ContractAddress)
+	require.Equal(t, proxy, mainnet.ContractAddress)
+
+	current, err := old.At(0)
+	require.NoError(t, err)
+	require.Equal(t, 2, current.Version)
+
+	versions := mainnet.Versions()
+	require.Len(t, versions, 2)
+	require.Equal(t, Address, versions[0].ContractAddress)
+	require.Equal(t, proxy, versions[1].ContractAddress)
+
+	_, err = mainnet.At(3)
+	require.ErrorIs(t, err, ErrUnknownContractVersion)
+
+	require.ErrorIs(t, registry.AddContract(10, ContractVersion{Version: 1, Address: deployed}), ErrUnknownChain)
+	require.Error(t, registry.AddContract(1, ContractVersion{Version: 3, Address: "key"}))
+}
+
+func TestChainImplementation(t *testing.T) {
+	node := newFakeNode(t, 100)
+
+	registry, err := NewChainRegistry([]Chain{
+		{ID: 1, RPCURLs: []string{node.server.URL}, Contracts: []ContractVersion{{Address: Address, Proxy: true}}},
+	}, 0, RPCHealth{})
+	require.NoError(t, err)
+
+	implementation, err := registry.Default().Implementation(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "0x000000000000000000000000000000000000bEEF", implementation)
+}
+
+func TestLoadChainContracts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	err := os.WriteFile(path, []byte(`[
+		{"chain_id": 1, "rpc_urls": ["https://eth.example.com"], "contracts": [
+			{"address": "0x0000000000000000000000000000000000000001"},
+			{"address": "0x0000000000000000000000000000000000000002", "proxy": true}
+		]}
+	]`), 0o600)
+	require.NoError(t, err)
+
+	registry, err := LoadChainRegistry(utils.Config{ChainsFile: path})
+	require.NoError(t, err)
+
+	chain := registry.Default()
+	require.Equal(t, 2, chain.Version)
+	require.Equal(t, "0x0000000000000000000000000000000000000002", chain.ContractAddress)
+	require.True(t, chain.Contracts[1].Proxy)
+
+	_, err = NewChainRegistry([]Chain{{
+		ID:              1,
+		RPCURLs:         []string{"https://eth.example.com"},
+		ContractAddress: Address,
+		Contracts:       []ContractVersion{{Address: Address}},
+	}}, 0, RPCHealth{})
+	require.Error(t, err)
+}
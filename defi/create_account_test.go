@@ -26,7 +26,7 @@ func TestGenerateAccountKeyStone(t *testing.T) {
 	require.NotEmpty(t, public)
 
 	// Check balance
-	balance, err := GetBalance(address)
+	balance, err := testChain(t).GetBalance(address)
 	require.NoError(t, err)
 	require.NotEmpty(t, balance)
 }
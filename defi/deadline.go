@@ -0,0 +1,91 @@
+package defi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// ErrDeadlineChangesUnsupported is returned for a contract version deployed without extendDeadline and
+// closeCampaign, which includes every version built from the gen binding
+var ErrDeadlineChangesUnsupported = errors.New("contract does not support deadline changes, deploy a version with extendDeadline and closeCampaign")
+
+// deadlineABI declares the owner-only deadline methods of contract/defi.sol. The gen binding predates
+// them, so they are called through this ABI on versions whose bytecode implements them.
+const deadlineABI = `[
+	{"inputs":[{"internalType":"uint256","name":"_campaignId","type":"uint256"},{"internalType":"uint256","name":"_newDeadline","type":"uint256"}],"name":"extendDeadline","outputs":[],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"internalType":"uint256","name":"_campaignId","type":"uint256"}],"name":"closeCampaign","outputs":[],"stateMutability":"nonpayable","type":"function"}
+]`
+
+// SupportsDeadlineChanges reports whether the chain's contract, or the implementation behind its proxy,
+// implements extendDeadline and closeCampaign
+func (chain *Chain) SupportsDeadlineChanges(ctx context.Context) (bool, error) {
+	parsed, err := abi.JSON(strings.NewReader(deadlineABI))
+	if err != nil {
+		return false, err
+	}
+
+	code, _, err := chain.deployedCode(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(missingMethods(parsed, codeSelectors(code))) == 0, nil
+}
+
+// ExtendDeadline moves a campaign's deadline later. Only the campaign owner can sign it, and only
+// while the campaign is active.
+func (chain *Chain) ExtendDeadline(ctx context.Context, id int64, deadline time.Time, key *ecdsa.PrivateKey) (string, error) {
+	return chain.transactDeadline(ctx, key, "extendDeadline", big.NewInt(id), big.NewInt(deadline.Unix()))
+}
+
+// CloseCampaign ends an active campaign now, after which it settles like any campaign past its deadline.
+// Only the campaign owner can sign it.
+func (chain *Chain) CloseCampaign(ctx context.Context, id int64, key *ecdsa.PrivateKey) (string, error) {
+	return chain.transactDeadline(ctx, key, "closeCampaign", big.NewInt(id))
+}
+
+func (chain *Chain) transactDeadline(ctx context.Context, key *ecdsa.PrivateKey, method string, params ...interface{}) (string, error) {
+	supported, err := chain.SupportsDeadlineChanges(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !supported {
+		return "", ErrDeadlineChangesUnsupported
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(deadlineABI))
+	if err != nil {
+		return "", err
+	}
+
+	client, err := chain.DialWriter(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(chain.ID))
+	if err != nil {
+		return "", err
+	}
+	auth.Context = ctx
+
+	// a call the contract would revert fails the estimate instead of going on chain
+	auth.GasLimit, err = chain.callGas(ctx, &bind.MetaData{ABI: deadlineABI}, auth.From.Hex(), nil, method, params...)
+	if err != nil {
+		return "", err
+	}
+
+	contract := bind.NewBoundContract(chain.Contract(), parsed, nil, client, nil)
+	tx, err := contract.Transact(auth, method, params...)
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash().Hex(), nil
+}
@@ -0,0 +1,27 @@
+package defi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/demola234/defiraise/gen"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadlineABI(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(deadlineABI))
+	require.NoError(t, err)
+	require.Equal(t, "extendDeadline(uint256,uint256)", parsed.Methods["extendDeadline"].Sig)
+	require.Equal(t, "closeCampaign(uint256)", parsed.Methods["closeCampaign"].Sig)
+
+	// the gen binding's contract predates the deadline methods
+	require.Len(t, missingMethods(parsed, codeSelectors(common.FromHex(gen.GenBin))), 2)
+
+	var code []byte
+	for _, method := range parsed.Methods {
+		code = append(append(code, 0x63), method.ID...)
+	}
+	require.Empty(t, missingMethods(parsed, codeSelectors(code)))
+}
@@ -2,68 +2,109 @@ package defi
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/demola234/defiraise/gen"
-	"github.com/demola234/defiraise/utils"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
-func Deploy() (string, error) {
-	configs, err := utils.LoadConfig(".")
+// Deployment is a campaign contract deployed to a chain
+type Deployment struct {
+	ChainID     int64
+	Address     string
+	TxHash      string
+	BlockNumber uint64
+	Deployer    string
+}
+
+// Deploy deploys the campaign contract from key's account and waits for the deployment to be mined
+func (chain *Chain) Deploy(ctx context.Context, key *ecdsa.PrivateKey) (*Deployment, error) {
+	client, err := chain.DialWriter(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	defer client.Close()
+
+	if err := chain.checkChainID(ctx, client); err != nil {
+		return nil, err
 	}
 
-	client, err := ethclient.Dial(configs.CryptoDeployURL)
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(chain.ID))
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	auth.Context = ctx
 
+	_, tx, _, err := gen.DeployGen(auth, client)
+	if err != nil {
+		return nil, err
 	}
-	defer client.Close()
-	account := common.HexToAddress(configs.DeployAddress)
 
-	nonce, err := client.PendingNonceAt(context.Background(), account)
+	receipt, err := bind.WaitMined(ctx, client, tx)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("deployment %s reverted", tx.Hash().Hex())
 	}
 
-	// gasPrice, err := client.SuggestGasPrice(context.Background())
-	// if err != nil {
-	// 	return "", err
-	// }
+	return &Deployment{
+		ChainID:     chain.ID,
+		Address:     receipt.ContractAddress.Hex(),
+		TxHash:      tx.Hash().Hex(),
+		BlockNumber: receipt.BlockNumber.Uint64(),
+		Deployer:    crypto.PubkeyToAddress(key.PublicKey).Hex(),
+	}, nil
+}
 
-	chainID, err := client.NetworkID(context.Background())
-	if err != nil {
-		return "", err
+// VerifyDeployment checks that the chain's RPC providers serve the configured chain and that
+// the campaign contract is deployed at its address and answers calls
+func (chain *Chain) VerifyDeployment(ctx context.Context) error {
+	if chain.ContractAddress == "" {
+		return errors.New("no contract address")
 	}
 
-	key, err := crypto.HexToECDSA(configs.DeployKey)
+	client, err := chain.Dial(ctx)
 	if err != nil {
-		return "", err
+		return err
+	}
+	defer client.Close()
+
+	if err := chain.checkChainID(ctx, client); err != nil {
+		return err
 	}
 
-	auth, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	code, err := client.CodeAt(ctx, chain.Contract(), nil)
 	if err != nil {
-		return "", err
+		return err
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("no contract deployed at %s", chain.ContractAddress)
 	}
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(0)      // in wei
-	auth.GasLimit = uint64(3000000) // in units
-	auth.GasPrice = big.NewInt(1000000)
 
-	a, ts, _, err := gen.DeployGen(auth, client)
+	caller, err := gen.NewGenCaller(chain.Contract(), client)
 	if err != nil {
-		return "", err
+		return err
+	}
+	if _, err := caller.GetCampaignsCount(&bind.CallOpts{Context: ctx}); err != nil {
+		return fmt.Errorf("contract at %s does not answer campaign calls: %w", chain.ContractAddress, err)
 	}
 
-	fmt.Println("-----------------------------------")
-	fmt.Println(a.Hex())
-	fmt.Println(ts.Hash().Hex())
-	fmt.Println("-----------------------------------")
-	return a.Hex(), nil
+	return nil
+}
+
+func (chain *Chain) checkChainID(ctx context.Context, client *Client) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if chainID.Int64() != chain.ID {
+		return fmt.Errorf("RPC provider serves chain %s, not chain %d", chainID, chain.ID)
+	}
+	return nil
 }
@@ -1,16 +1,24 @@
 package defi
 
 import (
+	"context"
 	"testing"
 
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
 func TestDeploy(t *testing.T) {
-	address, err := Deploy()
+	configs, err := utils.LoadConfig("./../")
 	require.NoError(t, err)
-	require.NotEmpty(t, address)
-	if err != nil {
-		require.Error(t, err)
-	}
+
+	key, err := crypto.HexToECDSA(configs.DeployKey)
+	require.NoError(t, err)
+
+	chain := testChain(t)
+	deployment, err := chain.Deploy(context.Background(), key)
+	require.NoError(t, err)
+	require.NotEmpty(t, deployment.Address)
+	require.Equal(t, chain.ID, deployment.ChainID)
 }
@@ -0,0 +1,109 @@
+package defi
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/demola234/defiraise/gen"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// NativeToken is the symbol recorded for donations made in the chain's native currency
+const NativeToken = "ETH"
+
+// ErrTransactionPending is returned while a transaction has not been mined yet
+var ErrTransactionPending = errors.New("transaction is still pending")
+
+// DonationEntry is one element of a campaign's on-chain donor list
+type DonationEntry struct {
+	Donor  string
+	Amount *big.Int
+}
+
+// TransactionStatus describes a mined transaction
+type TransactionStatus struct {
+	BlockNumber uint64
+	BlockTime   time.Time
+	Success     bool
+	// GasCost is the wei the transaction paid for gas, or nil when the provider does not report its
+	// effective gas price
+	GasCost *big.Int
+}
+
+// GetCampaignDonationEntries returns the contract's donor list for a campaign in donation order.
+// Unlike GetDonorsAddressesAndAmounts the amounts are kept as wei so large donations do not overflow.
+func (chain *Chain) GetCampaignDonationEntries(id int) ([]DonationEntry, error) {
+	return chain.GetCampaignDonationEntriesAt(context.Background(), id, nil)
+}
+
+// GetCampaignDonationEntriesAt returns the donor list as it was at block, or at the latest block when
+// block is nil. Older blocks need a provider that keeps historical state, such as an archive node.
+func (chain *Chain) GetCampaignDonationEntriesAt(ctx context.Context, id int, block *big.Int) ([]DonationEntry, error) {
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	cAdd := chain.Contract()
+
+	tx, err := gen.NewGenCaller(cAdd, client)
+	if err != nil {
+		return nil, err
+	}
+
+	donators, amounts, _, err := tx.GetDonorsAddressesAndAmounts(&bind.CallOpts{Context: ctx, BlockNumber: block},
+		big.NewInt(int64(id)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]DonationEntry, len(donators))
+	for i, donator := range donators {
+		entries[i] = DonationEntry{
+			Donor:  donator.Hex(),
+			Amount: amounts[i],
+		}
+	}
+
+	return entries, nil
+}
+
+// GetTransactionStatus looks up the receipt and block of a transaction.
+// It returns ErrTransactionPending if the transaction has not been mined.
+func (chain *Chain) GetTransactionStatus(hash string) (*TransactionStatus, error) {
+	client, err := chain.Dial(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	receipt, err := client.TransactionReceipt(context.Background(), common.HexToHash(hash))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, ErrTransactionPending
+		}
+		return nil, err
+	}
+
+	header, err := client.HeaderByNumber(context.Background(), receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &TransactionStatus{
+		BlockNumber: receipt.BlockNumber.Uint64(),
+		BlockTime:   time.Unix(int64(header.Time), 0),
+		Success:     receipt.Status == types.ReceiptStatusSuccessful,
+	}
+	if receipt.EffectiveGasPrice != nil {
+		status.GasCost = new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	}
+	return status, nil
+}
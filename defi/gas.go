@@ -0,0 +1,133 @@
+package defi
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/demola234/defiraise/gen"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// gasMargin is the percentage added to a call's estimated gas for its gas limit, so the limit still
+// covers the call when the contract's state changes before it is mined
+const gasMargin = 20
+
+// GasPrice returns the chain's suggested gas price in wei
+func (chain *Chain) GasPrice(ctx context.Context) (*big.Int, error) {
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return client.SuggestGasPrice(ctx)
+}
+
+// NativeBalance returns an address's balance of the chain's native currency in wei
+func (chain *Chain) NativeBalance(ctx context.Context, address string) (*big.Int, error) {
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return client.BalanceAt(ctx, common.HexToAddress(address), nil)
+}
+
+// WaitForTransaction checks a transaction's receipt every interval until it is mined and reports
+// whether it succeeded. It gives up with ctx's error when ctx is done first.
+func (chain *Chain) WaitForTransaction(ctx context.Context, hash string, interval time.Duration) (bool, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := chain.GetTransactionStatus(hash)
+		if err == nil {
+			return status.Success, nil
+		}
+		if !errors.Is(err, ErrTransactionPending) {
+			return false, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DonateGas returns the gas limit DonateWei sends a donation of wei from address to campaign id with
+func (chain *Chain) DonateGas(ctx context.Context, wei *big.Int, id int, address string) (uint64, error) {
+	return chain.callGas(ctx, gen.GenMetaData, address, wei, "donate", big.NewInt(int64(id)))
+}
+
+// CreateCampaignGas returns the gas limit CreateCampaign sends the campaign with
+func (chain *Chain) CreateCampaignGas(ctx context.Context, title string, campaignType string, description string, goal float64, deadline time.Time, image string, address string) (uint64, error) {
+	goals := utils.EtherToWeiInt(goal)
+	return chain.callGas(ctx, gen.GenMetaData, address, nil, "createCampaign", campaignType, title, description, goals, big.NewInt(deadline.Unix()), image)
+}
+
+// PayOutGas returns the gas limit PayOut sends the payout of campaign id from address with
+func (chain *Chain) PayOutGas(ctx context.Context, id int, address string) (uint64, error) {
+	return chain.callGas(ctx, gen.GenMetaData, address, nil, "payOut", big.NewInt(int64(id)))
+}
+
+// ExtendDeadlineGas returns the gas limit ExtendDeadline sends the new deadline of campaign id with
+func (chain *Chain) ExtendDeadlineGas(ctx context.Context, id int64, deadline time.Time, address string) (uint64, error) {
+	return chain.deadlineGas(ctx, address, "extendDeadline", big.NewInt(id), big.NewInt(deadline.Unix()))
+}
+
+// CloseCampaignGas returns the gas limit CloseCampaign closes campaign id with
+func (chain *Chain) CloseCampaignGas(ctx context.Context, id int64, address string) (uint64, error) {
+	return chain.deadlineGas(ctx, address, "closeCampaign", big.NewInt(id))
+}
+
+func (chain *Chain) deadlineGas(ctx context.Context, address string, method string, params ...interface{}) (uint64, error) {
+	supported, err := chain.SupportsDeadlineChanges(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !supported {
+		return 0, ErrDeadlineChangesUnsupported
+	}
+	return chain.callGas(ctx, &bind.MetaData{ABI: deadlineABI}, address, nil, method, params...)
+}
+
+// callGas estimates the gas of calling method on the chain's contract from address with value and adds
+// gasMargin. A call the contract would revert fails here.
+func (chain *Chain) callGas(ctx context.Context, metadata *bind.MetaData, address string, value *big.Int, method string, params ...interface{}) (uint64, error) {
+	parsed, err := abi.JSON(strings.NewReader(metadata.ABI))
+	if err != nil {
+		return 0, err
+	}
+	data, err := parsed.Pack(method, params...)
+	if err != nil {
+		return 0, err
+	}
+
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	contract := chain.Contract()
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  common.HexToAddress(address),
+		To:    &contract,
+		Value: value,
+		Data:  data,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return gas + gas*gasMargin/100, nil
+}
@@ -1,32 +1,18 @@
 package defi
 
 import (
-	"io/ioutil"
-	"net/http"
+	"context"
+	"strconv"
 
-	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/price"
 )
 
+// GetEthPrice returns the Coinbase ETH-USD spot price
 func GetEthPrice() (string, error) {
-	//? Get Price from api
-	resp, err := http.Get("https://api.coinbase.com/v2/prices/ETH-USD/spot")
+	quote, err := price.NewCoinbaseSource("", nil).GetPrice(context.Background(), NativeToken, "USD")
 	if err != nil {
 		return "", err
 	}
 
-	//? Read the response body
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	price, err := interfaces.UnmarshalCurrentPrice(body)
-	if err != nil {
-		return "", err
-	}
-
-	amount := price.Data.Amount
-
-	return amount, nil
-
+	return strconv.FormatFloat(quote.Price, 'f', -1, 64), nil
 }
@@ -0,0 +1,383 @@
+package defi
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// DefaultMaxBlockLag is how many blocks a provider may trail the highest one and stay healthy
+	DefaultMaxBlockLag = 5
+	// DefaultMaxLatency is the slowest health check response a healthy provider may give
+	DefaultMaxLatency = 5 * time.Second
+	// DefaultHealthInterval is how often providers are health checked
+	DefaultHealthInterval = 30 * time.Second
+)
+
+// ErrNoProvider is returned when a chain has no RPC provider to send a request to
+var ErrNoProvider = errors.New("no RPC provider available")
+
+// RPCHealth sets when a provider counts as healthy
+type RPCHealth struct {
+	MaxBlockLag uint64
+	MaxLatency  time.Duration
+}
+
+// ProviderStatus is the health and request metrics of one RPC provider
+type ProviderStatus struct {
+	Host        string        `json:"host"`
+	Healthy     bool          `json:"healthy"`
+	BlockNumber uint64        `json:"block_number"`
+	BlockLag    uint64        `json:"block_lag"`
+	Latency     time.Duration `json:"latency"`
+	CheckedAt   time.Time     `json:"checked_at"`
+	LastError   string        `json:"last_error,omitempty"`
+	Requests    uint64        `json:"requests"`
+	Failures    uint64        `json:"failures"`
+}
+
+type rpcProvider struct {
+	url    string
+	client *ethclient.Client
+
+	requests atomic.Uint64
+	failures atomic.Uint64
+
+	mu          sync.RWMutex
+	healthy     bool
+	blockNumber uint64
+	blockLag    uint64
+	latency     time.Duration
+	checkedAt   time.Time
+	lastError   string
+}
+
+func (provider *rpcProvider) isHealthy() bool {
+	provider.mu.RLock()
+	defer provider.mu.RUnlock()
+	return provider.healthy
+}
+
+// markFailed takes a provider out of rotation until its next successful health check
+func (provider *rpcProvider) markFailed(err error) {
+	provider.failures.Add(1)
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	provider.healthy = false
+	provider.lastError = err.Error()
+}
+
+// RPCPool spreads a chain's requests over several RPC providers. Reads fail over to the next
+// healthy provider; writes are pinned to one provider so nonces and sends stay consistent.
+type RPCPool struct {
+	providers []*rpcProvider
+	health    RPCHealth
+}
+
+// NewRPCPool creates a pool over urls, in order of preference. Providers count as healthy until
+// their first health check. HTTP providers are not contacted until the first request.
+func NewRPCPool(urls []string, health RPCHealth) (*RPCPool, error) {
+	if health.MaxBlockLag == 0 {
+		health.MaxBlockLag = DefaultMaxBlockLag
+	}
+	if health.MaxLatency <= 0 {
+		health.MaxLatency = DefaultMaxLatency
+	}
+
+	pool := &RPCPool{health: health}
+	for _, rawURL := range urls {
+		client, err := ethclient.Dial(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		pool.providers = append(pool.providers, &rpcProvider{url: rawURL, client: client, healthy: true})
+	}
+
+	return pool, nil
+}
+
+// CheckHealth fetches the block height of every provider. A provider is unhealthy when the
+// request fails, takes longer than MaxLatency or trails the highest block by more than MaxBlockLag.
+func (pool *RPCPool) CheckHealth(ctx context.Context) {
+	heights := make([]uint64, len(pool.providers))
+	latencies := make([]time.Duration, len(pool.providers))
+	errs := make([]error, len(pool.providers))
+
+	var wg sync.WaitGroup
+	for i, provider := range pool.providers {
+		wg.Add(1)
+		go func(i int, provider *rpcProvider) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, pool.health.MaxLatency)
+			defer cancel()
+
+			start := time.Now()
+			heights[i], errs[i] = provider.client.BlockNumber(checkCtx)
+			latencies[i] = time.Since(start)
+		}(i, provider)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for i, height := range heights {
+		if errs[i] == nil && height > highest {
+			highest = height
+		}
+	}
+
+	now := time.Now()
+	for i, provider := range pool.providers {
+		provider.mu.Lock()
+		provider.checkedAt = now
+		provider.latency = latencies[i]
+		provider.lastError = ""
+
+		switch {
+		case errs[i] != nil:
+			provider.healthy = false
+			provider.lastError = errs[i].Error()
+		default:
+			provider.blockNumber = heights[i]
+			provider.blockLag = highest - heights[i]
+			provider.healthy = provider.blockLag <= pool.health.MaxBlockLag && latencies[i] <= pool.health.MaxLatency
+		}
+		provider.mu.Unlock()
+	}
+}
+
+// Status returns the health and request metrics of every provider. Hosts are reported without
+// paths or credentials, since providers embed API keys in their URLs.
+func (pool *RPCPool) Status() []ProviderStatus {
+	statuses := make([]ProviderStatus, len(pool.providers))
+	for i, provider := range pool.providers {
+		provider.mu.RLock()
+		statuses[i] = ProviderStatus{
+			Host:        providerHost(provider.url),
+			Healthy:     provider.healthy,
+			BlockNumber: provider.blockNumber,
+			BlockLag:    provider.blockLag,
+			Latency:     provider.latency,
+			CheckedAt:   provider.checkedAt,
+			LastError:   provider.lastError,
+			Requests:    provider.requests.Load(),
+			Failures:    provider.failures.Load(),
+		}
+		provider.mu.RUnlock()
+	}
+
+	return statuses
+}
+
+// candidates orders the providers to try: healthy ones first, each group in order of preference
+func (pool *RPCPool) candidates() []*rpcProvider {
+	healthy := make([]*rpcProvider, 0, len(pool.providers))
+	var unhealthy []*rpcProvider
+	for _, provider := range pool.providers {
+		if provider.isHealthy() {
+			healthy = append(healthy, provider)
+		} else {
+			unhealthy = append(unhealthy, provider)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// Client returns a client that fails reads over between providers
+func (pool *RPCPool) Client() *Client {
+	return &Client{pool: pool}
+}
+
+// Writer returns a client pinned to the preferred healthy provider, for sending transactions
+func (pool *RPCPool) Writer() (*Client, error) {
+	candidates := pool.candidates()
+	if len(candidates) == 0 {
+		return nil, ErrNoProvider
+	}
+
+	return &Client{pool: pool, pinned: candidates[0]}, nil
+}
+
+func providerHost(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return "unknown"
+	}
+	return parsed.Scheme + "://" + parsed.Host
+}
+
+// isProviderFailure reports whether err means the provider could not serve the request, as
+// opposed to the node answering with an error such as a revert or a missing receipt
+func isProviderFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// call runs fn on each candidate provider until one serves it
+func call[T any](ctx context.Context, client *Client, fn func(*ethclient.Client) (T, error)) (T, error) {
+	var zero T
+
+	candidates := []*rpcProvider{client.pinned}
+	if client.pinned == nil {
+		candidates = client.pool.candidates()
+	}
+	if len(candidates) == 0 {
+		return zero, ErrNoProvider
+	}
+
+	var err error
+	for _, provider := range candidates {
+		provider.requests.Add(1)
+
+		var result T
+		result, err = fn(provider.client)
+		if err == nil || !isProviderFailure(ctx, err) {
+			return result, err
+		}
+
+		provider.markFailed(err)
+	}
+
+	return zero, err
+}
+
+// Client is a bind.ContractBackend over an RPCPool. A pinned client sends every request to one provider.
+type Client struct {
+	pool   *RPCPool
+	pinned *rpcProvider
+}
+
+// Close is a no-op; connections belong to the pool and are reused across requests
+func (client *Client) Close() {}
+
+func (client *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	return call(ctx, client, func(c *ethclient.Client) (uint64, error) {
+		return c.BlockNumber(ctx)
+	})
+}
+
+func (client *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	return call(ctx, client, func(c *ethclient.Client) (*big.Int, error) {
+		return c.ChainID(ctx)
+	})
+}
+
+func (client *Client) NetworkID(ctx context.Context) (*big.Int, error) {
+	return call(ctx, client, func(c *ethclient.Client) (*big.Int, error) {
+		return c.NetworkID(ctx)
+	})
+}
+
+func (client *Client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return call(ctx, client, func(c *ethclient.Client) (*big.Int, error) {
+		return c.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+func (client *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return call(ctx, client, func(c *ethclient.Client) (*types.Receipt, error) {
+		return c.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (client *Client) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, client, func(c *ethclient.Client) ([]byte, error) {
+		return c.StorageAt(ctx, account, key, blockNumber)
+	})
+}
+
+func (client *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return call(ctx, client, func(c *ethclient.Client) (*types.Header, error) {
+		return c.HeaderByNumber(ctx, number)
+	})
+}
+
+func (client *Client) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, client, func(c *ethclient.Client) ([]byte, error) {
+		return c.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (client *Client) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, client, func(c *ethclient.Client) ([]byte, error) {
+		return c.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+func (client *Client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return call(ctx, client, func(c *ethclient.Client) ([]byte, error) {
+		return c.PendingCodeAt(ctx, account)
+	})
+}
+
+func (client *Client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return call(ctx, client, func(c *ethclient.Client) (uint64, error) {
+		return c.PendingNonceAt(ctx, account)
+	})
+}
+
+func (client *Client) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return call(ctx, client, func(c *ethclient.Client) (*big.Int, error) {
+		return c.SuggestGasPrice(ctx)
+	})
+}
+
+func (client *Client) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return call(ctx, client, func(c *ethclient.Client) (*big.Int, error) {
+		return c.SuggestGasTipCap(ctx)
+	})
+}
+
+func (client *Client) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return call(ctx, client, func(c *ethclient.Client) (uint64, error) {
+		return c.EstimateGas(ctx, msg)
+	})
+}
+
+// SendTransaction is never retried on another provider, since a failed send may still have been accepted
+func (client *Client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	provider := client.pinned
+	if provider == nil {
+		candidates := client.pool.candidates()
+		if len(candidates) == 0 {
+			return ErrNoProvider
+		}
+		provider = candidates[0]
+	}
+
+	provider.requests.Add(1)
+	err := provider.client.SendTransaction(ctx, tx)
+	if err != nil && isProviderFailure(ctx, err) {
+		provider.markFailed(err)
+	}
+	return err
+}
+
+func (client *Client) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return call(ctx, client, func(c *ethclient.Client) ([]types.Log, error) {
+		return c.FilterLogs(ctx, query)
+	})
+}
+
+func (client *Client) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return call(ctx, client, func(c *ethclient.Client) (ethereum.Subscription, error) {
+		return c.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
@@ -0,0 +1,167 @@
+package defi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode is a JSON-RPC endpoint answering eth_blockNumber, eth_getBalance and eth_getStorageAt
+type fakeNode struct {
+	server   *httptest.Server
+	height   atomic.Uint64
+	down     atomic.Bool
+	requests atomic.Uint64
+}
+
+func newFakeNode(t *testing.T, height uint64) *fakeNode {
+	node := &fakeNode{}
+	node.height.Store(height)
+	node.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		node.requests.Add(1)
+		if node.down.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var result string
+		switch req.Method {
+		case "eth_blockNumber":
+			result = fmt.Sprintf("0x%x", node.height.Load())
+		case "eth_getBalance":
+			result = "0x64"
+		case "eth_getStorageAt":
+			result = "0x000000000000000000000000000000000000000000000000000000000000beef"
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"method not found"}}`, req.ID)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%q}`, req.ID, result)
+	}))
+	t.Cleanup(node.server.Close)
+
+	return node
+}
+
+func TestRPCPoolHealth(t *testing.T) {
+	synced := newFakeNode(t, 100)
+	lagging := newFakeNode(t, 90)
+	down := newFakeNode(t, 100)
+	down.down.Store(true)
+
+	pool, err := NewRPCPool([]string{lagging.server.URL, down.server.URL, synced.server.URL}, RPCHealth{MaxBlockLag: 5})
+	require.NoError(t, err)
+
+	pool.CheckHealth(context.Background())
+
+	status := pool.Status()
+	require.Len(t, status, 3)
+	require.False(t, status[0].Healthy)
+	require.Equal(t, uint64(10), status[0].BlockLag)
+	require.False(t, status[1].Healthy)
+	require.NotEmpty(t, status[1].LastError)
+	require.True(t, status[2].Healthy)
+	require.Equal(t, uint64(100), status[2].BlockNumber)
+
+	// healthy providers are tried first
+	candidates := pool.candidates()
+	require.Equal(t, synced.server.URL, candidates[0].url)
+
+	lagging.height.Store(100)
+	pool.CheckHealth(context.Background())
+	require.True(t, pool.Status()[0].Healthy)
+}
+
+func TestRPCPoolFailover(t *testing.T) {
+	primary := newFakeNode(t, 100)
+	secondary := newFakeNode(t, 100)
+
+	pool, err := NewRPCPool([]string{primary.server.URL, secondary.server.URL}, RPCHealth{})
+	require.NoError(t, err)
+
+	client := pool.Client()
+	balance, err := client.BalanceAt(context.Background(), common.HexToAddress(Address), nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), balance.Int64())
+	require.Equal(t, uint64(1), primary.requests.Load())
+	require.Equal(t, uint64(0), secondary.requests.Load())
+
+	primary.down.Store(true)
+	balance, err = client.BalanceAt(context.Background(), common.HexToAddress(Address), nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), balance.Int64())
+	require.Equal(t, uint64(1), secondary.requests.Load())
+
+	status := pool.Status()
+	require.False(t, status[0].Healthy)
+	require.Equal(t, uint64(2), status[0].Requests)
+	require.Equal(t, uint64(1), status[0].Failures)
+	require.True(t, status[1].Healthy)
+
+	// the failed provider is now tried last
+	_, err = client.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), primary.requests.Load())
+	require.Equal(t, uint64(2), secondary.requests.Load())
+
+	// the node answering with an error does not take it out of rotation
+	_, err = client.SuggestGasTipCap(context.Background())
+	require.Error(t, err)
+	require.True(t, pool.Status()[1].Healthy)
+
+	secondary.down.Store(true)
+	_, err = client.BlockNumber(context.Background())
+	require.Error(t, err)
+}
+
+func TestRPCPoolWriter(t *testing.T) {
+	primary := newFakeNode(t, 100)
+	secondary := newFakeNode(t, 100)
+
+	pool, err := NewRPCPool([]string{primary.server.URL, secondary.server.URL}, RPCHealth{})
+	require.NoError(t, err)
+
+	writer, err := pool.Writer()
+	require.NoError(t, err)
+
+	_, err = writer.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), primary.requests.Load())
+
+	// a pinned client does not fail over, so a transaction never mixes providers
+	primary.down.Store(true)
+	_, err = writer.BlockNumber(context.Background())
+	require.Error(t, err)
+	require.Equal(t, uint64(0), secondary.requests.Load())
+
+	// new writers pin to a healthy provider
+	writer, err = pool.Writer()
+	require.NoError(t, err)
+	_, err = writer.BlockNumber(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), secondary.requests.Load())
+}
+
+func TestProviderHost(t *testing.T) {
+	require.Equal(t, "https://eth-sepolia.g.alchemy.com", providerHost("https://eth-sepolia.g.alchemy.com/v2/secret-key"))
+	require.Equal(t, "unknown", providerHost("not a url"))
+}
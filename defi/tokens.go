@@ -0,0 +1,142 @@
+package defi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MainnetChainID is Ethereum mainnet
+	MainnetChainID int64 = 1
+	// SepoliaChainID is the Sepolia testnet the campaign contract is deployed to
+	SepoliaChainID int64 = 11155111
+)
+
+// TokenInfo describes an ERC-20 token on one chain
+type TokenInfo struct {
+	ChainID  int64  `json:"chain_id"`
+	Address  string `json:"address"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+	Logo     string `json:"logo"`
+}
+
+// DefaultTokens are the tokens known without a TOKEN_REGISTRY file
+var DefaultTokens = []TokenInfo{
+	{ChainID: MainnetChainID, Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Symbol: "USDC", Decimals: 6},
+	{ChainID: MainnetChainID, Address: "0xdAC17F958D2ee523a2206206994597C13D831ec7", Symbol: "USDT", Decimals: 6},
+	{ChainID: MainnetChainID, Address: "0x6B175474E89094C44Da98b954EedeAC495271d0F", Symbol: "DAI", Decimals: 18},
+	{ChainID: MainnetChainID, Address: "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", Symbol: "WETH", Decimals: 18},
+	{ChainID: MainnetChainID, Address: "0x514910771AF9Ca656af840dff83E8264EcF986CA", Symbol: "LINK", Decimals: 18},
+	{ChainID: SepoliaChainID, Address: "0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238", Symbol: "USDC", Decimals: 6},
+	{ChainID: SepoliaChainID, Address: "0x779877A7B0D9E8603169DdbD7836e478b4624789", Symbol: "LINK", Decimals: 18},
+}
+
+// TokenRegistry holds the ERC-20 tokens known on each chain
+type TokenRegistry struct {
+	tokens map[int64][]TokenInfo
+}
+
+// NewTokenRegistry creates a registry from tokens. A later token replaces an earlier one with the same chain and symbol.
+func NewTokenRegistry(tokens []TokenInfo) (*TokenRegistry, error) {
+	registry := &TokenRegistry{tokens: make(map[int64][]TokenInfo)}
+	for _, token := range tokens {
+		if err := registry.add(token); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// LoadTokenRegistry creates a registry from DefaultTokens and, when path is set, a JSON array of TokenInfo
+func LoadTokenRegistry(path string) (*TokenRegistry, error) {
+	tokens := append([]TokenInfo{}, DefaultTokens...)
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var configured []TokenInfo
+		if err := json.Unmarshal(data, &configured); err != nil {
+			return nil, fmt.Errorf("cannot read token registry %s: %w", path, err)
+		}
+		tokens = append(tokens, configured...)
+	}
+
+	return NewTokenRegistry(tokens)
+}
+
+func (registry *TokenRegistry) add(token TokenInfo) error {
+	if token.ChainID <= 0 || token.Symbol == "" || !common.IsHexAddress(token.Address) {
+		return fmt.Errorf("invalid token %q on chain %d: chain_id, symbol and address are required", token.Symbol, token.ChainID)
+	}
+	token.Symbol = strings.ToUpper(token.Symbol)
+	token.Address = common.HexToAddress(token.Address).Hex()
+
+	tokens := registry.tokens[token.ChainID]
+	for i, existing := range tokens {
+		if existing.Symbol == token.Symbol {
+			tokens[i] = token
+			return nil
+		}
+	}
+
+	tokens = append(tokens, token)
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Symbol < tokens[j].Symbol })
+	registry.tokens[token.ChainID] = tokens
+	return nil
+}
+
+// Tokens returns the tokens on a chain ordered by symbol
+func (registry *TokenRegistry) Tokens(chainID int64) []TokenInfo {
+	return append([]TokenInfo{}, registry.tokens[chainID]...)
+}
+
+// Lookup finds a token on a chain by symbol or address
+func (registry *TokenRegistry) Lookup(chainID int64, symbolOrAddress string) (TokenInfo, bool) {
+	for _, token := range registry.tokens[chainID] {
+		if strings.EqualFold(token.Symbol, symbolOrAddress) || strings.EqualFold(token.Address, symbolOrAddress) {
+			return token, true
+		}
+	}
+	return TokenInfo{}, false
+}
+
+// TokenBalance is the balance of one token held by an address
+type TokenBalance struct {
+	Token  TokenInfo
+	Amount *big.Int
+}
+
+// GetTokenBalances reads the balance of every token held by owner, using each token's registered decimals
+func GetTokenBalances(ctx context.Context, caller bind.ContractCaller, owner string, tokens []TokenInfo) ([]TokenBalance, error) {
+	account := common.HexToAddress(owner)
+	balances := make([]TokenBalance, 0, len(tokens))
+
+	for _, token := range tokens {
+		contract, err := NewDefiCaller(common.HexToAddress(token.Address), caller)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := contract.BalanceOf(&bind.CallOpts{Context: ctx}, account)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read %s balance: %w", token.Symbol, err)
+		}
+
+		balances = append(balances, TokenBalance{Token: token, Amount: amount})
+	}
+
+	return balances, nil
+}
@@ -0,0 +1,75 @@
+package defi
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeERC20 answers balanceOf with a fixed balance per token
+type fakeERC20 map[common.Address]*big.Int
+
+func (balances fakeERC20) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (balances fakeERC20) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := DefiMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(balances[*call.To])
+}
+
+func TestLoadTokenRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	err := os.WriteFile(path, []byte(`[
+		{"chain_id": 11155111, "address": "0x1c7d4b196cb0c7b01d743fbc6116a902379c7238", "symbol": "usdc", "decimals": 6, "logo": "https://example.com/usdc.png"},
+		{"chain_id": 8453, "address": "0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913", "symbol": "USDC", "decimals": 6}
+	]`), 0o600)
+	require.NoError(t, err)
+
+	registry, err := LoadTokenRegistry(path)
+	require.NoError(t, err)
+
+	usdc, ok := registry.Lookup(SepoliaChainID, "USDC")
+	require.True(t, ok)
+	require.Equal(t, "https://example.com/usdc.png", usdc.Logo)
+	require.Equal(t, "0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238", usdc.Address)
+	require.Len(t, registry.Tokens(SepoliaChainID), 2)
+
+	_, ok = registry.Lookup(8453, "0x833589fcd6edb6e08f4c7c32d4f71b54bda02913")
+	require.True(t, ok)
+	require.Empty(t, registry.Tokens(10))
+
+	_, err = NewTokenRegistry([]TokenInfo{{ChainID: 1, Symbol: "BAD", Address: "not-an-address"}})
+	require.Error(t, err)
+}
+
+func TestGetRegisteredTokenBalances(t *testing.T) {
+	usdc := TokenInfo{ChainID: SepoliaChainID, Address: "0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238", Symbol: "USDC", Decimals: 6}
+	link := TokenInfo{ChainID: SepoliaChainID, Address: "0x779877A7B0D9E8603169DdbD7836e478b4624789", Symbol: "LINK", Decimals: 18}
+
+	caller := fakeERC20{
+		common.HexToAddress(usdc.Address): big.NewInt(2500000),
+		common.HexToAddress(link.Address): big.NewInt(0),
+	}
+
+	balances, err := GetTokenBalances(context.Background(), caller, "0x574bc33136180f0734fc3fa55379e9e28701395e", []TokenInfo{usdc, link})
+	require.NoError(t, err)
+	require.Len(t, balances, 2)
+	require.Equal(t, "USDC", balances[0].Token.Symbol)
+	require.Equal(t, big.NewInt(2500000), balances[0].Amount)
+	require.Equal(t, 0, balances[1].Amount.Sign())
+}
@@ -0,0 +1,139 @@
+package defi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// transferGas is the gas of a plain value transfer to an account without code
+const transferGas = 21000
+
+// ErrNothingToSweep is returned by Sweep when the balance does not cover the transfer's gas
+var ErrNothingToSweep = errors.New("balance does not cover the transfer fee")
+
+// TransferCost returns the wei a plain value transfer pays for gas at gasPrice
+func TransferCost(gasPrice *big.Int) *big.Int {
+	return new(big.Int).Mul(gasPrice, big.NewInt(transferGas))
+}
+
+// Transfer sends amount wei of the chain's native currency from key's account to an address and
+// returns the transaction hash without waiting for it to be mined
+func (chain *Chain) Transfer(ctx context.Context, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error) {
+	client, err := chain.DialWriter(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	nonce, err := client.PendingNonceAt(ctx, crypto.PubkeyToAddress(key.PublicKey))
+	if err != nil {
+		return "", err
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return chain.sendValue(ctx, client, nonce, to, amount, gasPrice, key)
+}
+
+// Sweep sends the whole native balance of key's account, less the transfer's gas, to an address and
+// returns the transaction hash and the amount sent in wei
+func (chain *Chain) Sweep(ctx context.Context, to string, key *ecdsa.PrivateKey) (string, *big.Int, error) {
+	client, err := chain.DialWriter(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	defer client.Close()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return "", nil, err
+	}
+
+	balance, err := client.BalanceAt(ctx, from, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	amount := new(big.Int).Sub(balance, new(big.Int).Mul(gasPrice, big.NewInt(transferGas)))
+	if amount.Sign() <= 0 {
+		return "", nil, ErrNothingToSweep
+	}
+
+	hash, err := chain.sendValue(ctx, client, nonce, to, amount, gasPrice, key)
+	if err != nil {
+		return "", nil, err
+	}
+	return hash, amount, nil
+}
+
+// sendValue signs and sends a plain value transfer
+func (chain *Chain) sendValue(ctx context.Context, client *Client, nonce uint64, to string, amount *big.Int, gasPrice *big.Int, key *ecdsa.PrivateKey) (string, error) {
+	tx := types.NewTransaction(nonce, common.HexToAddress(to), amount, transferGas, gasPrice, nil)
+	signed, err := types.SignTx(tx, types.NewEIP155Signer(big.NewInt(chain.ID)), key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := client.SendTransaction(ctx, signed); err != nil {
+		return "", err
+	}
+	return signed.Hash().Hex(), nil
+}
+
+// TransferToken sends amount base units of an ERC-20 token from key's account to an address and
+// returns the transaction hash without waiting for it to be mined
+func (chain *Chain) TransferToken(ctx context.Context, token string, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error) {
+	client, err := chain.DialWriter(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	contract, err := NewDefiTransactor(common.HexToAddress(token), client)
+	if err != nil {
+		return "", err
+	}
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(chain.ID))
+	if err != nil {
+		return "", err
+	}
+	auth.Context = ctx
+
+	tx, err := contract.Transfer(auth, common.HexToAddress(to), amount)
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash().Hex(), nil
+}
+
+// TokenBalance returns an address's balance of an ERC-20 token in base units
+func (chain *Chain) TokenBalance(ctx context.Context, token string, owner string) (*big.Int, error) {
+	client, err := chain.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	contract, err := NewDefiCaller(common.HexToAddress(token), client)
+	if err != nil {
+		return nil, err
+	}
+	return contract.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(owner))
+}
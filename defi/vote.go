@@ -0,0 +1,127 @@
+package defi
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ErrInvalidSignature is returned when a vote signature is malformed or not signed by the voter
+var ErrInvalidSignature = errors.New("invalid vote signature")
+
+// voteDomainName and voteDomainVersion identify the EIP-712 domain of proposal votes. The domain is
+// bound to the chain and campaign contract, so a vote cannot be replayed on another version.
+const (
+	voteDomainName    = "DefiRaise"
+	voteDomainVersion = "1"
+)
+
+// ProposalVote is the EIP-712 message a donor signs to vote on a campaign proposal
+type ProposalVote struct {
+	ProposalID int64
+	CampaignID int64
+	Support    bool
+	Voter      string
+}
+
+// VoteTypedData returns the EIP-712 typed data of a vote, as passed to eth_signTypedData_v4
+func (chain *Chain) VoteTypedData(vote ProposalVote) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Vote": {
+				{Name: "proposalId", Type: "uint256"},
+				{Name: "campaignId", Type: "uint256"},
+				{Name: "support", Type: "bool"},
+				{Name: "voter", Type: "address"},
+			},
+		},
+		PrimaryType: "Vote",
+		Domain: apitypes.TypedDataDomain{
+			Name:              voteDomainName,
+			Version:           voteDomainVersion,
+			ChainId:           math.NewHexOrDecimal256(chain.ID),
+			VerifyingContract: chain.ContractAddress,
+		},
+		Message: apitypes.TypedDataMessage{
+			"proposalId": strconv.FormatInt(vote.ProposalID, 10),
+			"campaignId": strconv.FormatInt(vote.CampaignID, 10),
+			"support":    vote.Support,
+			"voter":      common.HexToAddress(vote.Voter).Hex(),
+		},
+	}
+}
+
+// VerifyVote checks that a hex-encoded 65-byte signature of the vote's typed data was made by its voter
+func (chain *Chain) VerifyVote(vote ProposalVote, signature string) error {
+	sig := common.FromHex(signature)
+	if len(sig) != crypto.SignatureLength || !common.IsHexAddress(vote.Voter) {
+		return ErrInvalidSignature
+	}
+	// wallets sign with a recovery id of 27 or 28
+	sig = append([]byte(nil), sig...)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(chain.VoteTypedData(vote))
+	if err != nil {
+		return err
+	}
+	key, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if crypto.PubkeyToAddress(*key) != common.HexToAddress(vote.Voter) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// DonorContributions sums a campaign's on-chain donations per lower-cased donor address and in total, in wei
+func (chain *Chain) DonorContributions(campaignID int64) (map[string]*big.Int, *big.Int, error) {
+	return chain.DonorContributionsAt(context.Background(), campaignID, nil)
+}
+
+// DonorContributionsAt is DonorContributions as of block, or the latest block when block is nil
+func (chain *Chain) DonorContributionsAt(ctx context.Context, campaignID int64, block *big.Int) (map[string]*big.Int, *big.Int, error) {
+	entries, err := chain.GetCampaignDonationEntriesAt(ctx, int(campaignID), block)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contributions := make(map[string]*big.Int)
+	total := new(big.Int)
+	for _, entry := range entries {
+		donor := strings.ToLower(entry.Donor)
+		if contributions[donor] == nil {
+			contributions[donor] = new(big.Int)
+		}
+		contributions[donor].Add(contributions[donor], entry.Amount)
+		total.Add(total, entry.Amount)
+	}
+	return contributions, total, nil
+}
+
+// ProposalPasses decides a closed vote, all weights in wei: the votes must add up to at least quorum
+// percent of the campaign's donations, and more weight must be for the proposal than against it
+func ProposalPasses(forWeight, againstWeight, total *big.Int, quorum int32) bool {
+	if total.Sign() <= 0 || forWeight.Cmp(againstWeight) <= 0 {
+		return false
+	}
+	cast := new(big.Int).Add(forWeight, againstWeight)
+	required := new(big.Int).Mul(total, big.NewInt(int64(quorum)))
+	return cast.Mul(cast, big.NewInt(100)).Cmp(required) >= 0
+}
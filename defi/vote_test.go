@@ -0,0 +1,69 @@
+package defi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyVote(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	chain := &Chain{ID: SepoliaChainID, ContractAddress: "0x1000000000000000000000000000000000000001"}
+	vote := ProposalVote{ProposalID: 4, CampaignID: 7, Support: true, Voter: crypto.PubkeyToAddress(key.PublicKey).Hex()}
+
+	sign := func(chain *Chain, vote ProposalVote) string {
+		hash, _, err := apitypes.TypedDataAndHash(chain.VoteTypedData(vote))
+		require.NoError(t, err)
+		sig, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+		sig[crypto.RecoveryIDOffset] += 27
+		return hexutil.Encode(sig)
+	}
+
+	require.NoError(t, chain.VerifyVote(vote, sign(chain, vote)))
+
+	// the signature covers the choice
+	against := vote
+	against.Support = false
+	require.ErrorIs(t, chain.VerifyVote(against, sign(chain, vote)), ErrInvalidSignature)
+
+	// and the contract it was cast on
+	other := &Chain{ID: SepoliaChainID, ContractAddress: "0x2000000000000000000000000000000000000002"}
+	require.ErrorIs(t, chain.VerifyVote(vote, sign(other, vote)), ErrInvalidSignature)
+
+	// a vote signed by someone else does not count for the voter
+	impostor := vote
+	impostor.Voter = "0x3000000000000000000000000000000000000003"
+	require.ErrorIs(t, chain.VerifyVote(impostor, sign(chain, impostor)), ErrInvalidSignature)
+
+	require.ErrorIs(t, chain.VerifyVote(vote, "0x1234"), ErrInvalidSignature)
+}
+
+func TestProposalPasses(t *testing.T) {
+	testCases := []struct {
+		name               string
+		forWeight, against int64
+		total              int64
+		quorum             int32
+		passes             bool
+	}{
+		{name: "Passes", forWeight: 15, against: 5, total: 100, quorum: 20, passes: true},
+		{name: "BelowQuorum", forWeight: 15, against: 4, total: 100, quorum: 20, passes: false},
+		{name: "Tie", forWeight: 30, against: 30, total: 100, quorum: 20, passes: false},
+		{name: "Rejected", forWeight: 10, against: 40, total: 100, quorum: 20, passes: false},
+		{name: "NoDonations", forWeight: 0, against: 0, total: 0, quorum: 20, passes: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			passes := ProposalPasses(big.NewInt(tc.forWeight), big.NewInt(tc.against), big.NewInt(tc.total), tc.quorum)
+			require.Equal(t, tc.passes, passes)
+		})
+	}
+}
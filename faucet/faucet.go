@@ -0,0 +1,308 @@
+package faucet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+// AssetNative is the asset of a grant in the chain's native currency. Token grants use the token's
+// lower-cased address.
+const AssetNative = "native"
+
+const (
+	// defaultIPLimit is the number of users one IP address can have granted per day when FAUCET_IP_LIMIT is not configured
+	defaultIPLimit = 3
+	// defaultAlertGrants is used when FAUCET_ALERT_GRANTS is not configured
+	defaultAlertGrants = 20
+	// ipWindow is the period the IP limit covers
+	ipWindow = 24 * time.Hour
+	// alertInterval is how often a low treasury is reported for the same chain and asset
+	alertInterval = 6 * time.Hour
+)
+
+var (
+	// ErrNoFaucetKey is returned by NewFaucet when FAUCET_PRIVATE_KEY is not set
+	ErrNoFaucetKey    = errors.New("FAUCET_PRIVATE_KEY is not set")
+	ErrNotTestnet     = errors.New("the onboarding grant is only sent on test networks")
+	ErrUnverified     = errors.New("verify your email to receive the onboarding grant")
+	ErrAlreadyGranted = errors.New("you have already received the onboarding grant on this network")
+	ErrIPLimit        = errors.New("too many accounts have received the onboarding grant from your network today, try again tomorrow")
+)
+
+// Notifier tells the operators that a faucet treasury is running low
+type Notifier func(subject string, details string) error
+
+// EmailNotifier sends alerts to address with the treasury alert email template
+func EmailNotifier(address string) Notifier {
+	return func(subject string, details string) error {
+		email := utils.EmailInfo{
+			Name:     "team",
+			Details:  details,
+			Subject:  subject,
+			Template: "treasury_alert.html",
+		}
+		_, err := utils.SendEmail(address, "team", email, "./utils")
+		return err
+	}
+}
+
+// treasury is the part of a chain the faucet sends grants on
+type treasury interface {
+	NativeBalance(ctx context.Context, address string) (*big.Int, error)
+	TokenBalance(ctx context.Context, token string, owner string) (*big.Int, error)
+	Transfer(ctx context.Context, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error)
+	TransferToken(ctx context.Context, token string, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error)
+}
+
+// grant is one asset sent to a new user on a chain
+type grant struct {
+	asset  string
+	token  string
+	symbol string
+	amount *big.Int
+}
+
+// Faucet sends new users an onboarding grant on test networks, so their custodial wallet can donate
+// without being funded by hand. Each verified user gets the native amount and, when configured, an
+// amount of a registry token once per chain from the treasury wallet of FAUCET_PRIVATE_KEY, with a
+// limit on the users one IP address can have granted per day. The operators are alerted when the
+// treasury cannot cover FAUCET_ALERT_GRANTS more grants.
+type Faucet struct {
+	store       db.Store
+	chains      *defi.ChainRegistry
+	tokens      *defi.TokenRegistry
+	key         *ecdsa.PrivateKey
+	address     string
+	native      *big.Int
+	token       string
+	tokenAmount float64
+	ipLimit     int64
+	alertGrants int64
+	notify      Notifier
+
+	mu      sync.Mutex
+	alerted map[string]time.Time
+}
+
+// NewFaucet creates a faucet that pays from FAUCET_PRIVATE_KEY's wallet. notify may be nil to email
+// FAUCET_ALERT_EMAIL, or only log when it is not set.
+func NewFaucet(store db.Store, chains *defi.ChainRegistry, tokens *defi.TokenRegistry, configs utils.Config, notify Notifier) (*Faucet, error) {
+	if configs.FaucetKey == "" {
+		return nil, ErrNoFaucetKey
+	}
+	key, err := crypto.HexToECDSA(configs.FaucetKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FAUCET_PRIVATE_KEY: %w", err)
+	}
+	if configs.FaucetAmount <= 0 && (configs.FaucetToken == "" || configs.FaucetTokenAmount <= 0) {
+		return nil, errors.New("the faucet needs FAUCET_AMOUNT or FAUCET_TOKEN and FAUCET_TOKEN_AMOUNT")
+	}
+
+	faucet := &Faucet{
+		store:       store,
+		chains:      chains,
+		tokens:      tokens,
+		key:         key,
+		address:     crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		ipLimit:     configs.FaucetIPLimit,
+		alertGrants: configs.FaucetAlertGrants,
+		notify:      notify,
+		alerted:     make(map[string]time.Time),
+	}
+	if configs.FaucetAmount > 0 {
+		faucet.native = toUnits(configs.FaucetAmount, 18)
+	}
+	if configs.FaucetToken != "" && configs.FaucetTokenAmount > 0 {
+		faucet.token = configs.FaucetToken
+		faucet.tokenAmount = configs.FaucetTokenAmount
+	}
+	if faucet.ipLimit <= 0 {
+		faucet.ipLimit = defaultIPLimit
+	}
+	if faucet.alertGrants <= 0 {
+		faucet.alertGrants = defaultAlertGrants
+	}
+	if faucet.notify == nil && configs.FaucetAlertEmail != "" {
+		faucet.notify = EmailNotifier(configs.FaucetAlertEmail)
+	}
+
+	return faucet, nil
+}
+
+// Address returns the treasury wallet's address
+func (faucet *Faucet) Address() string {
+	return faucet.address
+}
+
+// Onboard sends a newly verified user the onboarding grant on every test network, logging the
+// networks it cannot be sent on
+func (faucet *Faucet) Onboard(ctx context.Context, user db.Users, ip string) {
+	for _, chain := range faucet.chains.All() {
+		if !chain.Testnet {
+			continue
+		}
+		if _, err := faucet.Grant(ctx, chain, user, ip); err != nil {
+			log.Warn().Err(err).Int64("chain_id", chain.ID).Str("username", user.Username).Msg("cannot send onboarding grant")
+		}
+	}
+}
+
+// Grant sends a verified user the onboarding grant on a test network and returns the grants sent.
+// An asset whose grant failed earlier is sent again.
+func (faucet *Faucet) Grant(ctx context.Context, chain *defi.Chain, user db.Users, ip string) ([]db.FaucetGrants, error) {
+	if !chain.Testnet {
+		return nil, ErrNotTestnet
+	}
+	return faucet.grant(ctx, chain.ID, chain.NativeSymbol, chain, user, ip)
+}
+
+func (faucet *Faucet) grant(ctx context.Context, chainID int64, nativeSymbol string, chain treasury, user db.Users, ip string) ([]db.FaucetGrants, error) {
+	if !user.IsEmailVerified {
+		return nil, ErrUnverified
+	}
+	grants := faucet.grants(chainID, nativeSymbol)
+	if len(grants) == 0 {
+		return nil, fmt.Errorf("no onboarding grant is configured on chain %d", chainID)
+	}
+
+	var sent []db.FaucetGrants
+	for _, grant := range grants {
+		// the IP limit is checked in the claim's transaction, so concurrent sign-ups cannot exceed it
+		record, err := faucet.store.ClaimFaucetGrantTx(ctx, db.ClaimFaucetGrantTxParams{
+			ClaimFaucetGrantParams: db.ClaimFaucetGrantParams{
+				Username: user.Username,
+				ChainID:  chainID,
+				Asset:    grant.asset,
+				Address:  user.Address,
+				IP:       ip,
+				Amount:   grant.amount.String(),
+			},
+			Since:   time.Now().Add(-ipWindow),
+			IPLimit: faucet.ipLimit,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			// already granted, or being sent by a concurrent request
+			continue
+		}
+		if errors.Is(err, db.ErrFaucetIPLimit) {
+			log.Warn().Str("ip", ip).Int64("limit", faucet.ipLimit).Msg("faucet IP limit reached")
+			return sent, ErrIPLimit
+		}
+		if err != nil {
+			return sent, err
+		}
+
+		var hash string
+		if grant.asset == AssetNative {
+			hash, err = chain.Transfer(ctx, user.Address, grant.amount, faucet.key)
+		} else {
+			hash, err = chain.TransferToken(ctx, grant.token, user.Address, grant.amount, faucet.key)
+		}
+		if err != nil {
+			if err := faucet.store.FailFaucetGrant(ctx, record.ID); err != nil {
+				log.Error().Err(err).Int64("grant_id", record.ID).Msg("cannot record failed onboarding grant")
+			}
+			faucet.checkTreasury(ctx, chainID, chain, grant)
+			return sent, fmt.Errorf("cannot send onboarding %s: %w", grant.symbol, err)
+		}
+
+		record.Status = "sent"
+		record.TxHash = sql.NullString{String: hash, Valid: true}
+		if err := faucet.store.MarkFaucetGrantSent(ctx, db.MarkFaucetGrantSentParams{ID: record.ID, TxHash: record.TxHash}); err != nil {
+			log.Error().Err(err).Int64("grant_id", record.ID).Str("tx_hash", hash).Msg("cannot record onboarding grant")
+		}
+		sent = append(sent, record)
+		faucet.checkTreasury(ctx, chainID, chain, grant)
+	}
+
+	if len(sent) == 0 {
+		return nil, ErrAlreadyGranted
+	}
+	return sent, nil
+}
+
+// grants returns the assets granted on a chain. The token is skipped on chains the registry does not
+// know it on.
+func (faucet *Faucet) grants(chainID int64, nativeSymbol string) []grant {
+	var grants []grant
+	if faucet.native != nil {
+		grants = append(grants, grant{asset: AssetNative, symbol: nativeSymbol, amount: faucet.native})
+	}
+	if faucet.token != "" && faucet.tokens != nil {
+		if token, ok := faucet.tokens.Lookup(chainID, faucet.token); ok {
+			grants = append(grants, grant{
+				asset:  strings.ToLower(token.Address),
+				token:  token.Address,
+				symbol: token.Symbol,
+				amount: toUnits(faucet.tokenAmount, token.Decimals),
+			})
+		}
+	}
+	return grants
+}
+
+// checkTreasury alerts the operators when the treasury cannot cover alertGrants more grants of an
+// asset, at most once every alertInterval per chain and asset
+func (faucet *Faucet) checkTreasury(ctx context.Context, chainID int64, chain treasury, grant grant) {
+	var balance *big.Int
+	var err error
+	if grant.asset == AssetNative {
+		balance, err = chain.NativeBalance(ctx, faucet.address)
+	} else {
+		balance, err = chain.TokenBalance(ctx, grant.token, faucet.address)
+	}
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", chainID).Str("asset", grant.asset).Msg("cannot read faucet treasury balance")
+		return
+	}
+
+	threshold := new(big.Int).Mul(grant.amount, big.NewInt(faucet.alertGrants))
+	if balance.Cmp(threshold) >= 0 {
+		return
+	}
+
+	key := fmt.Sprintf("%d/%s", chainID, grant.asset)
+	faucet.mu.Lock()
+	if last, ok := faucet.alerted[key]; ok && time.Since(last) < alertInterval {
+		faucet.mu.Unlock()
+		return
+	}
+	faucet.alerted[key] = time.Now()
+	faucet.mu.Unlock()
+
+	grantsLeft := new(big.Int).Quo(balance, grant.amount)
+	log.Warn().Int64("chain_id", chainID).Str("asset", grant.asset).Str("balance", balance.String()).
+		Str("treasury", faucet.address).Msg("faucet treasury is running low")
+	if faucet.notify == nil {
+		return
+	}
+
+	subject := fmt.Sprintf("Faucet treasury low on chain %d", chainID)
+	details := fmt.Sprintf("The faucet treasury %s on chain %d can only cover %s more onboarding grants of %s.",
+		faucet.address, chainID, grantsLeft.String(), grant.symbol)
+	if err := faucet.notify(subject, details); err != nil {
+		log.Error().Err(err).Int64("chain_id", chainID).Msg("cannot send faucet treasury alert")
+	}
+}
+
+// toUnits converts a configured amount to the base units of an asset with decimals from its decimal
+// text, so 0.01 ether is exactly 1e16 wei
+func toUnits(amount float64, decimals uint8) *big.Int {
+	units, _ := new(big.Rat).SetString(strconv.FormatFloat(amount, 'f', -1, 64))
+	units.Mul(units, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	return new(big.Int).Quo(units.Num(), units.Denom())
+}
@@ -0,0 +1,255 @@
+package faucet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const usdc = "0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238"
+
+type fakeChain struct {
+	balance      *big.Int
+	tokenBalance *big.Int
+	sendErr      error
+	sent         []string
+}
+
+func (chain *fakeChain) NativeBalance(ctx context.Context, address string) (*big.Int, error) {
+	return chain.balance, nil
+}
+
+func (chain *fakeChain) TokenBalance(ctx context.Context, token string, owner string) (*big.Int, error) {
+	return chain.tokenBalance, nil
+}
+
+func (chain *fakeChain) Transfer(ctx context.Context, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error) {
+	if chain.sendErr != nil {
+		return "", chain.sendErr
+	}
+	chain.sent = append(chain.sent, "native:"+amount.String())
+	return "0xnative", nil
+}
+
+func (chain *fakeChain) TransferToken(ctx context.Context, token string, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error) {
+	if chain.sendErr != nil {
+		return "", chain.sendErr
+	}
+	chain.sent = append(chain.sent, token+":"+amount.String())
+	return "0xtoken", nil
+}
+
+func TestNewFaucet(t *testing.T) {
+	_, err := NewFaucet(nil, nil, nil, utils.Config{}, nil)
+	require.ErrorIs(t, err, ErrNoFaucetKey)
+
+	_, err = NewFaucet(nil, nil, nil, utils.Config{FaucetKey: "not a key", FaucetAmount: 0.01}, nil)
+	require.Error(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, err = NewFaucet(nil, nil, nil, utils.Config{FaucetKey: hexKey(key), FaucetToken: "USDC"}, nil)
+	require.Error(t, err)
+
+	faucet, err := NewFaucet(nil, nil, nil, utils.Config{FaucetKey: hexKey(key), FaucetAmount: 0.01}, nil)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey).Hex(), faucet.Address())
+	require.Equal(t, "10000000000000000", faucet.native.String())
+	require.Equal(t, int64(defaultIPLimit), faucet.ipLimit)
+	require.Nil(t, faucet.notify)
+}
+
+func TestGrant(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tokens, err := defi.NewTokenRegistry(defi.DefaultTokens)
+	require.NoError(t, err)
+
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001", IsEmailVerified: true}
+	ip := "203.0.113.7"
+	native := "10000000000000000"
+	// 25 USDC with 6 decimals
+	token := "25000000"
+	plenty := big.NewInt(1e18)
+	claim := func(store *mockdb.MockStore, asset string, amount string, id int64) {
+		store.EXPECT().
+			ClaimFaucetGrantTx(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, arg db.ClaimFaucetGrantTxParams) (db.FaucetGrants, error) {
+				require.Equal(t, db.ClaimFaucetGrantParams{
+					Username: user.Username,
+					ChainID:  defi.SepoliaChainID,
+					Asset:    asset,
+					Address:  user.Address,
+					IP:       ip,
+					Amount:   amount,
+				}, arg.ClaimFaucetGrantParams)
+				require.Equal(t, int64(defaultIPLimit), arg.IPLimit)
+				require.WithinDuration(t, time.Now().Add(-ipWindow), arg.Since, time.Minute)
+				return db.FaucetGrants{ID: id, Asset: asset, Amount: amount, Status: "pending"}, nil
+			})
+	}
+
+	testCases := []struct {
+		name       string
+		user       db.Users
+		chain      *fakeChain
+		buildStubs func(store *mockdb.MockStore)
+		check      func(t *testing.T, chain *fakeChain, grants []db.FaucetGrants, alerts []string, err error)
+	}{
+		{
+			name:  "OK",
+			user:  user,
+			chain: &fakeChain{balance: plenty, tokenBalance: plenty},
+			buildStubs: func(store *mockdb.MockStore) {
+				claim(store, AssetNative, native, 1)
+				claim(store, strings.ToLower(usdc), token, 2)
+				store.EXPECT().
+					MarkFaucetGrantSent(gomock.Any(), gomock.Eq(db.MarkFaucetGrantSentParams{ID: 1, TxHash: sql.NullString{String: "0xnative", Valid: true}})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MarkFaucetGrantSent(gomock.Any(), gomock.Eq(db.MarkFaucetGrantSentParams{ID: 2, TxHash: sql.NullString{String: "0xtoken", Valid: true}})).
+					Times(1).
+					Return(nil)
+			},
+			check: func(t *testing.T, chain *fakeChain, grants []db.FaucetGrants, alerts []string, err error) {
+				require.NoError(t, err)
+				require.Len(t, grants, 2)
+				require.Equal(t, "sent", grants[0].Status)
+				require.Equal(t, []string{"native:" + native, usdc + ":" + token}, chain.sent)
+				require.Empty(t, alerts)
+			},
+		},
+		{
+			name:  "AlreadyGranted",
+			user:  user,
+			chain: &fakeChain{balance: plenty, tokenBalance: plenty},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimFaucetGrantTx(gomock.Any(), gomock.Any()).Times(2).Return(db.FaucetGrants{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, chain *fakeChain, grants []db.FaucetGrants, alerts []string, err error) {
+				require.ErrorIs(t, err, ErrAlreadyGranted)
+				require.Empty(t, chain.sent)
+			},
+		},
+		{
+			name:  "IPLimit",
+			user:  user,
+			chain: &fakeChain{balance: plenty, tokenBalance: plenty},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ClaimFaucetGrantTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ClaimFaucetGrantTxParams) (db.FaucetGrants, error) {
+						require.Equal(t, ip, arg.IP)
+						require.Equal(t, user.Username, arg.Username)
+						return db.FaucetGrants{}, db.ErrFaucetIPLimit
+					})
+			},
+			check: func(t *testing.T, chain *fakeChain, grants []db.FaucetGrants, alerts []string, err error) {
+				require.ErrorIs(t, err, ErrIPLimit)
+				require.Empty(t, chain.sent)
+			},
+		},
+		{
+			name:  "Unverified",
+			user:  db.Users{Username: user.Username, Address: user.Address},
+			chain: &fakeChain{balance: plenty, tokenBalance: plenty},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimFaucetGrantTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, grants []db.FaucetGrants, alerts []string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name:  "SendFails",
+			user:  user,
+			chain: &fakeChain{balance: big.NewInt(0), tokenBalance: plenty, sendErr: errors.New("insufficient funds")},
+			buildStubs: func(store *mockdb.MockStore) {
+				claim(store, AssetNative, native, 1)
+				store.EXPECT().FailFaucetGrant(gomock.Any(), gomock.Eq(int64(1))).Times(1).Return(nil)
+				store.EXPECT().MarkFaucetGrantSent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, grants []db.FaucetGrants, alerts []string, err error) {
+				require.Error(t, err)
+				require.Empty(t, grants)
+				require.Len(t, alerts, 1)
+			},
+		},
+		{
+			name: "LowTreasury",
+			user: user,
+			// enough for 5 more native grants and plenty of tokens
+			chain: &fakeChain{balance: big.NewInt(5e16), tokenBalance: plenty},
+			buildStubs: func(store *mockdb.MockStore) {
+				claim(store, AssetNative, native, 1)
+				claim(store, strings.ToLower(usdc), token, 2)
+				store.EXPECT().MarkFaucetGrantSent(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			},
+			check: func(t *testing.T, chain *fakeChain, grants []db.FaucetGrants, alerts []string, err error) {
+				require.NoError(t, err)
+				require.Len(t, grants, 2)
+				require.Len(t, alerts, 1)
+				require.Contains(t, alerts[0], "5 more onboarding grants of ETH")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			var alerts []string
+			notify := func(subject string, details string) error {
+				alerts = append(alerts, details)
+				return nil
+			}
+			configs := utils.Config{FaucetKey: hexKey(key), FaucetAmount: 0.01, FaucetToken: "usdc", FaucetTokenAmount: 25}
+			faucet, err := NewFaucet(store, nil, tokens, configs, notify)
+			require.NoError(t, err)
+
+			grants, err := faucet.grant(context.Background(), defi.SepoliaChainID, defi.NativeToken, tc.chain, tc.user, ip)
+			tc.check(t, tc.chain, grants, alerts, err)
+		})
+	}
+}
+
+func TestGrantNotTestnet(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	faucet, err := NewFaucet(nil, nil, nil, utils.Config{FaucetKey: hexKey(key), FaucetAmount: 0.01}, nil)
+	require.NoError(t, err)
+
+	_, err = faucet.Grant(context.Background(), &defi.Chain{ID: defi.MainnetChainID}, db.Users{IsEmailVerified: true}, "203.0.113.7")
+	require.ErrorIs(t, err, ErrNotTestnet)
+}
+
+func TestToUnits(t *testing.T) {
+	require.Equal(t, "10000000000000000", toUnits(0.01, 18).String())
+	require.Equal(t, "1500000", toUnits(1.5, 6).String())
+	require.Equal(t, "0", toUnits(0, 18).String())
+}
+
+func hexKey(key *ecdsa.PrivateKey) string {
+	return hex.EncodeToString(crypto.FromECDSA(key))
+}
@@ -0,0 +1,204 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// pendingBatchSize bounds how many pending donation transactions are checked per run
+	pendingBatchSize = 100
+	// pendingTimeout is how long a donation transaction may go unmined before its record is failed. A
+	// transaction that is dropped from the mempool is never mined.
+	pendingTimeout = 24 * time.Hour
+)
+
+const (
+	donationStatusConfirmed = "confirmed"
+	donationStatusFailed    = "failed"
+)
+
+// SentDonation is a donation transaction the server sent from one of its wallets
+type SentDonation struct {
+	ChainID         int64
+	ContractVersion int32
+	CampaignID      int64
+	DonorAddress    string
+	// Amount is the exact wei the transaction sent, which the indexer matches against the donor list
+	Amount *big.Int
+	Token  string
+	TxHash string
+}
+
+// RecordSentDonation records a sent donation as pending until the indexer sees its transaction mined.
+// The fiat value is filled in by the indexer from the price at the donation's block time. The
+// transaction is already sent, so a failed insert is only logged: the indexer recovers the donation
+// from the campaign's donor list.
+func RecordSentDonation(ctx context.Context, store db.Store, donation SentDonation) (db.DonationRecords, error) {
+	record, err := store.CreateDonationRecord(ctx, db.CreateDonationRecordParams{
+		ChainID:         donation.ChainID,
+		ContractVersion: donation.ContractVersion,
+		CampaignID:      donation.CampaignID,
+		DonorAddress:    donation.DonorAddress,
+		Amount:          donation.Amount.String(),
+		Token:           donation.Token,
+		TxHash:          sql.NullString{String: donation.TxHash, Valid: true},
+		FiatCurrency:    FiatCurrency,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("tx_hash", donation.TxHash).Msg("cannot record donation")
+	}
+	return record, err
+}
+
+// SyncDonations reconciles the recorded donations with the on-chain donor list of each campaign on one contract version
+func (indexer *Indexer) SyncDonations(ctx context.Context, chain *defi.Chain, campaignIDs []int64) error {
+	for _, campaignID := range campaignIDs {
+		if err := indexer.reconcileCampaignDonations(ctx, chain, campaignID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// confirmPendingDonations sets the status of donation transactions sent through the API once they are
+// mined, and fails those still unmined after pendingTimeout. The least recently checked records are
+// checked first, so records whose receipt cannot be found do not hold up the rest.
+func (indexer *Indexer) confirmPendingDonations(ctx context.Context) error {
+	pending, err := indexer.store.ListPendingDonationRecords(ctx, pendingBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range pending {
+		if err := indexer.store.MarkDonationRecordChecked(ctx, record.ID); err != nil {
+			return err
+		}
+
+		chain, err := indexer.chains.Get(record.ChainID)
+		if err != nil {
+			log.Error().Err(err).Str("tx_hash", record.TxHash.String).Msg("cannot confirm donation")
+			continue
+		}
+
+		params := db.UpdateDonationRecordStatusParams{ID: record.ID, DonatedAt: record.DonatedAt}
+		status, err := chain.GetTransactionStatus(record.TxHash.String)
+		switch {
+		case errors.Is(err, defi.ErrTransactionPending):
+			if time.Since(record.CreatedAt) < pendingTimeout {
+				continue
+			}
+			log.Warn().Str("tx_hash", record.TxHash.String).Msg("donation transaction was never mined")
+			params.Status = donationStatusFailed
+		case err != nil:
+			log.Error().Err(err).Str("tx_hash", record.TxHash.String).Msg("cannot fetch donation receipt")
+			continue
+		default:
+			params.Status = donationStatusConfirmed
+			if !status.Success {
+				params.Status = donationStatusFailed
+			}
+			params.BlockNumber = sql.NullInt64{Int64: int64(status.BlockNumber), Valid: true}
+			params.DonatedAt = status.BlockTime
+		}
+
+		if _, err := indexer.store.UpdateDonationRecordStatus(ctx, params); err != nil {
+			return err
+		}
+
+		if params.Status == donationStatusFailed {
+			if err := indexer.cancelRewardClaim(ctx, record.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// cancelRewardClaim cancels the reward claim made with a donation whose transaction failed and gives
+// its slot back to the tier
+func (indexer *Indexer) cancelRewardClaim(ctx context.Context, recordID int64) error {
+	claim, err := indexer.store.CancelDonationRewardClaim(ctx, db.CancelDonationRewardClaimParams{
+		DonationRecordID: sql.NullInt64{Int64: recordID, Valid: true},
+		Note:             "the donation transaction failed",
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = indexer.store.ReleaseRewardTier(ctx, claim.TierID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	log.Info().Int64("claim_id", claim.ID).Int64("tier_id", claim.TierID).Str("tx_hash", claim.TxHash).Msg("reward claim of failed donation cancelled")
+	return nil
+}
+
+// reconcileCampaignDonations walks the contract's donor list. Entries that match a
+// recorded transaction are linked to it; anything else was made outside the API
+// and is recorded without a tx hash.
+func (indexer *Indexer) reconcileCampaignDonations(ctx context.Context, chain *defi.Chain, campaignID int64) error {
+	entries, err := chain.GetCampaignDonationEntries(int(campaignID))
+	if err != nil {
+		return err
+	}
+
+	for i, entry := range entries {
+		donorIndex := sql.NullInt32{Int32: int32(i), Valid: true}
+
+		_, err := indexer.store.GetDonationRecordByIndex(ctx, db.GetDonationRecordByIndexParams{
+			ChainID:         chain.ID,
+			ContractVersion: int32(chain.Version),
+			CampaignID:      campaignID,
+			DonorIndex:      donorIndex,
+		})
+		if err == nil {
+			continue
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		linked, err := indexer.store.LinkDonationRecordIndex(ctx, db.LinkDonationRecordIndexParams{
+			DonorIndex:      donorIndex,
+			ChainID:         chain.ID,
+			ContractVersion: int32(chain.Version),
+			CampaignID:      campaignID,
+			DonorAddress:    entry.Donor,
+			Amount:          entry.Amount.String(),
+		})
+		if err != nil {
+			return err
+		}
+		if linked > 0 {
+			continue
+		}
+
+		_, err = indexer.store.CreateChainDonationRecord(ctx, db.CreateChainDonationRecordParams{
+			ChainID:         chain.ID,
+			ContractVersion: int32(chain.Version),
+			CampaignID:      campaignID,
+			DonorAddress:    entry.Donor,
+			Amount:          entry.Amount.String(),
+			Token:           chain.NativeSymbol,
+			DonorIndex:      donorIndex,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/price"
+	"github.com/demola234/defiraise/relayer"
+	"github.com/rs/zerolog/log"
+)
+
+// defaultInterval is used when INDEXER_INTERVAL is not configured
+const defaultInterval = time.Minute
+
+// Indexer mirrors the contract's campaigns into Postgres so they can be
+// searched, filtered and paginated without walking the chain on every request
+type Indexer struct {
+	store         db.Store
+	chains        *defi.ChainRegistry
+	interval      time.Duration
+	priceInterval time.Duration
+	prices        price.PriceProvider
+}
+
+// NewIndexer creates a new indexer for the campaigns on every chain. prices may be nil to sample Coinbase alone.
+func NewIndexer(store db.Store, chains *defi.ChainRegistry, interval time.Duration, priceInterval time.Duration, prices price.PriceProvider) *Indexer {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if priceInterval <= 0 {
+		priceInterval = defaultPriceInterval
+	}
+	if prices == nil {
+		prices = price.NewCoinbaseSource("", nil)
+	}
+
+	return &Indexer{
+		store:         store,
+		chains:        chains,
+		interval:      interval,
+		priceInterval: priceInterval,
+		prices:        prices,
+	}
+}
+
+// Start syncs campaigns straight away and then on every interval until ctx is cancelled
+func (indexer *Indexer) Start(ctx context.Context) {
+	ticker := time.NewTicker(indexer.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := indexer.confirmPendingDonations(ctx); err != nil {
+			log.Error().Err(err).Msg("cannot confirm donations")
+		}
+		if err := relayer.ReconcileSponsorships(ctx, indexer.store, indexer.chains); err != nil {
+			log.Error().Err(err).Msg("cannot reconcile gas top-ups")
+		}
+
+		for _, chain := range indexer.chains.All() {
+			for _, version := range chain.Versions() {
+				campaignIDs, err := indexer.SyncCampaigns(ctx, version)
+				if err != nil {
+					log.Error().Err(err).Int64("chain_id", version.ID).Int("version", version.Version).Msg("cannot sync campaigns")
+					continue
+				}
+				log.Info().Int64("chain_id", version.ID).Int("version", version.Version).Msgf("indexed %d campaigns", len(campaignIDs))
+
+				if err := indexer.SyncDonations(ctx, version, campaignIDs); err != nil {
+					log.Error().Err(err).Int64("chain_id", version.ID).Int("version", version.Version).Msg("cannot sync donations")
+				}
+			}
+		}
+
+		if err := indexer.ValueDonations(ctx); err != nil {
+			log.Error().Err(err).Msg("cannot value donations")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncCampaigns upserts every campaign on one contract version and returns the IDs that were written
+func (indexer *Indexer) SyncCampaigns(ctx context.Context, chain *defi.Chain) ([]int64, error) {
+	campaigns, err := chain.GetCampaigns("")
+	if err != nil {
+		return nil, err
+	}
+
+	campaignIDs := make([]int64, 0, len(campaigns))
+
+	for _, campaign := range campaigns {
+		_, err := indexer.store.UpsertIndexedCampaign(ctx, db.UpsertIndexedCampaignParams{
+			ChainID:           chain.ID,
+			ContractVersion:   int32(chain.Version),
+			CampaignID:        campaign.ID,
+			Owner:             campaign.Owner,
+			Title:             campaign.Title,
+			Description:       campaign.Description,
+			Category:          campaign.CampaignType,
+			Image:             campaign.Image,
+			Goal:              campaign.Goal.String(),
+			TotalFunds:        campaign.TotalFunds.String(),
+			TotalContributors: campaign.TotalNumberOfDonations,
+			Deadline:          time.Unix(campaign.Deadline, 0),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		campaignIDs = append(campaignIDs, campaign.ID)
+	}
+
+	return campaignIDs, nil
+}
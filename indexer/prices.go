@@ -0,0 +1,158 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// FiatCurrency is the currency prices are recorded and donations valued in
+	FiatCurrency = "USD"
+
+	// defaultPriceInterval is used when PRICE_INTERVAL is not configured
+	defaultPriceInterval = 5 * time.Minute
+
+	// maxPriceDistance is how far a sample may be from a donation and still value it
+	maxPriceDistance = 24 * time.Hour
+
+	valuationBatchSize = 500
+)
+
+// StartPriceRecorder samples the spot price straight away and then on every price interval until ctx is cancelled
+func (indexer *Indexer) StartPriceRecorder(ctx context.Context) {
+	ticker := time.NewTicker(indexer.priceInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := indexer.RecordPrice(ctx); err != nil {
+			log.Error().Err(err).Msg("cannot record price")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecordPrice stores the current price of the native token of every chain
+func (indexer *Indexer) RecordPrice(ctx context.Context) error {
+	recorded := make(map[string]bool)
+	for _, chain := range indexer.chains.All() {
+		if recorded[chain.NativeSymbol] {
+			continue
+		}
+		recorded[chain.NativeSymbol] = true
+
+		quote, err := indexer.prices.GetPrice(ctx, chain.NativeSymbol, FiatCurrency)
+		if err != nil {
+			return err
+		}
+
+		_, err = indexer.store.CreatePriceSample(ctx, db.CreatePriceSampleParams{
+			Token:      quote.Base,
+			Currency:   quote.Currency,
+			Price:      strconv.FormatFloat(quote.Price, 'f', 8, 64),
+			Source:     quote.Source,
+			RecordedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValueDonations sets the fiat value of confirmed donations from the price closest to their block time.
+// Donations recovered from the donor list have no transaction, so their block time is unknown and they
+// are left unvalued rather than priced at the time they were indexed. A donation with no sample within
+// maxPriceDistance of it once that much time has passed, or one that cannot be valued, is marked
+// unpriceable so it is not looked up again.
+func (indexer *Indexer) ValueDonations(ctx context.Context) error {
+	records, err := indexer.store.ListUnvaluedDonationRecords(ctx, valuationBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		price, err := indexer.priceAt(ctx, record.Token, record.DonatedAt)
+		if err == sql.ErrNoRows {
+			// a sample recorded later than maxPriceDistance after the donation is too far to value it
+			if time.Since(record.DonatedAt) <= maxPriceDistance {
+				continue
+			}
+			if err := indexer.store.MarkDonationRecordUnpriceable(ctx, record.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		value, err := utils.WeiToFiat(record.Amount, price.Price)
+		if err != nil {
+			log.Error().Err(err).Int64("donation_id", record.ID).Msg("cannot value donation")
+			if err := indexer.store.MarkDonationRecordUnpriceable(ctx, record.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = indexer.store.SetDonationRecordFiat(ctx, db.SetDonationRecordFiatParams{
+			ID:           record.ID,
+			FiatAmount:   sql.NullString{String: value, Valid: true},
+			FiatCurrency: price.Currency,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// priceAt returns the sample nearest to at, or sql.ErrNoRows if none is within maxPriceDistance
+func (indexer *Indexer) priceAt(ctx context.Context, token string, at time.Time) (db.PriceHistory, error) {
+	var best db.PriceHistory
+	found := false
+
+	before, err := indexer.store.GetPriceAtOrBefore(ctx, db.GetPriceAtOrBeforeParams{
+		Token:      token,
+		Currency:   FiatCurrency,
+		RecordedAt: at,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return db.PriceHistory{}, err
+	}
+	if err == nil && at.Sub(before.RecordedAt) <= maxPriceDistance {
+		best, found = before, true
+	}
+
+	after, err := indexer.store.GetPriceAfter(ctx, db.GetPriceAfterParams{
+		Token:      token,
+		Currency:   FiatCurrency,
+		RecordedAt: at,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return db.PriceHistory{}, err
+	}
+	if err == nil && after.RecordedAt.Sub(at) <= maxPriceDistance {
+		if !found || after.RecordedAt.Sub(at) < at.Sub(best.RecordedAt) {
+			best, found = after, true
+		}
+	}
+
+	if !found {
+		return db.PriceHistory{}, sql.ErrNoRows
+	}
+	return best, nil
+}
@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceAt(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	sample := func(id int64, offset time.Duration) db.PriceHistory {
+		return db.PriceHistory{ID: id, Token: "ETH", Currency: FiatCurrency, Price: "3000", RecordedAt: at.Add(offset)}
+	}
+
+	testCases := []struct {
+		name      string
+		before    *db.PriceHistory
+		after     *db.PriceHistory
+		expectID  int64
+		expectErr error
+	}{
+		{
+			name:     "ClosestIsBefore",
+			before:   ptr(sample(1, -time.Minute)),
+			after:    ptr(sample(2, time.Hour)),
+			expectID: 1,
+		},
+		{
+			name:     "ClosestIsAfter",
+			before:   ptr(sample(1, -time.Hour)),
+			after:    ptr(sample(2, time.Minute)),
+			expectID: 2,
+		},
+		{
+			name:     "OnlyAfter",
+			after:    ptr(sample(2, time.Hour)),
+			expectID: 2,
+		},
+		{
+			name:      "TooFarAway",
+			before:    ptr(sample(1, -48*time.Hour)),
+			after:     ptr(sample(2, 48*time.Hour)),
+			expectErr: sql.ErrNoRows,
+		},
+		{
+			name:      "NoSamples",
+			expectErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().GetPriceAtOrBefore(gomock.Any(), gomock.Any()).Return(result(tc.before))
+			store.EXPECT().GetPriceAfter(gomock.Any(), gomock.Any()).Return(result(tc.after))
+
+			indexer := NewIndexer(store, nil, 0, 0, nil)
+			price, err := indexer.priceAt(context.Background(), "ETH", at)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectID, price.ID)
+		})
+	}
+}
+
+func TestValueDonations(t *testing.T) {
+	record := db.DonationRecords{ID: 5, Token: "ETH", Amount: "2000000000000000000", Status: "confirmed"}
+
+	testCases := []struct {
+		name       string
+		donatedAt  time.Time
+		sample     *db.PriceHistory
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name:      "Priced",
+			donatedAt: time.Now().Add(-time.Hour),
+			sample:    &db.PriceHistory{ID: 1, Price: "3000", Currency: FiatCurrency, RecordedAt: time.Now().Add(-time.Hour)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SetDonationRecordFiat(gomock.Any(), gomock.Eq(db.SetDonationRecordFiatParams{
+						ID:           record.ID,
+						FiatAmount:   sql.NullString{String: "6000.00", Valid: true},
+						FiatCurrency: FiatCurrency,
+					})).
+					Times(1)
+				store.EXPECT().MarkDonationRecordUnpriceable(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			// a sample may still be recorded close enough after the donation
+			name:      "SampleMayCome",
+			donatedAt: time.Now().Add(-time.Hour),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetDonationRecordFiat(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().MarkDonationRecordUnpriceable(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:      "Unpriceable",
+			donatedAt: time.Now().Add(-maxPriceDistance - time.Hour),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetDonationRecordFiat(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().MarkDonationRecordUnpriceable(gomock.Any(), gomock.Eq(record.ID)).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			record := record
+			record.DonatedAt = tc.donatedAt
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().ListUnvaluedDonationRecords(gomock.Any(), gomock.Any()).Times(1).Return([]db.DonationRecords{record}, nil)
+			store.EXPECT().GetPriceAtOrBefore(gomock.Any(), gomock.Any()).Return(result(tc.sample))
+			store.EXPECT().GetPriceAfter(gomock.Any(), gomock.Any()).Return(result(nil))
+			tc.buildStubs(store)
+
+			indexer := NewIndexer(store, nil, 0, 0, nil)
+			require.NoError(t, indexer.ValueDonations(context.Background()))
+		})
+	}
+}
+
+func ptr(sample db.PriceHistory) *db.PriceHistory {
+	return &sample
+}
+
+func result(sample *db.PriceHistory) (db.PriceHistory, error) {
+	if sample == nil {
+		return db.PriceHistory{}, sql.ErrNoRows
+	}
+	return *sample, nil
+}
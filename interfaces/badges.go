@@ -0,0 +1,93 @@
+package interfaces
+
+import (
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+)
+
+const (
+	BadgeMintPending   = "pending"
+	BadgeMintSent      = "sent"
+	BadgeMintConfirmed = "confirmed"
+)
+
+// Badge is a supporter badge minted, or being minted, to one of the user's addresses
+type Badge struct {
+	ID              int64  `json:"id"`
+	ChainID         int64  `json:"chain_id"`
+	ContractVersion int32  `json:"contract_version"`
+	CampaignID      int64  `json:"campaign_id"`
+	TierID          int64  `json:"tier_id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Image           string `json:"image"`
+	Recipient       string `json:"recipient"`
+	Status          string `json:"status"`
+	TxHash          string `json:"tx_hash"`
+	// MintedAt is when the mint was confirmed on chain, or nil while it is being sent
+	MintedAt *time.Time `json:"minted_at"`
+}
+
+// NewBadge maps a user's stored badge to the API shape
+func NewBadge(badge db.ListUserBadgesRow) Badge {
+	rsp := Badge{
+		ID:              badge.ID,
+		ChainID:         badge.ChainID,
+		ContractVersion: badge.ContractVersion,
+		CampaignID:      badge.CampaignID,
+		TierID:          badge.TierID,
+		Name:            badge.Name,
+		Description:     badge.Description,
+		Image:           badge.Image,
+		Recipient:       badge.Recipient,
+		Status:          badge.Status,
+		TxHash:          badge.TxHash.String,
+	}
+	if badge.MintedAt.Valid {
+		rsp.MintedAt = &badge.MintedAt.Time
+	}
+	return rsp
+}
+
+// BadgeMetadata is the ERC-1155 metadata JSON of a badge, which wallets and marketplaces read from the
+// contract's uri
+type BadgeMetadata struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Image       string           `json:"image"`
+	Attributes  []BadgeAttribute `json:"attributes"`
+}
+
+type BadgeAttribute struct {
+	TraitType string      `json:"trait_type"`
+	Value     interface{} `json:"value"`
+}
+
+// NewBadgeMetadata maps a stored badge to its token metadata
+func NewBadgeMetadata(badge db.Badges) BadgeMetadata {
+	attributes := []BadgeAttribute{
+		{TraitType: "chain_id", Value: badge.ChainID},
+		{TraitType: "campaign_id", Value: badge.CampaignID},
+	}
+	if badge.TierID != 0 {
+		attributes = append(attributes, BadgeAttribute{TraitType: "reward_tier_id", Value: badge.TierID})
+	}
+
+	return BadgeMetadata{
+		Name:        badge.Name,
+		Description: badge.Description,
+		Image:       badge.Image,
+		Attributes:  attributes,
+	}
+}
+
+// BadgeTokenID parses the decimal token ID wallets put in a metadata request. ERC-1155 clients may
+// also substitute the ID as 64 hex digits, which is accepted too.
+func BadgeTokenID(id string) (int64, error) {
+	if len(id) == 64 {
+		return strconv.ParseInt(id, 16, 64)
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
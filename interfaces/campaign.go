@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
 )
 
 
@@ -41,10 +44,64 @@ type Campaigns struct {
 	TotalNumber        int64              `json:"total_number"`
 	User               []UserResponseInfo `json:"user"`
 	Donations          []DonorDetails     `json:"donations"`
+	DisplayCurrency    string             `json:"display_currency"`
+	Amounts            CampaignAmounts    `json:"amounts"`
+	ChainID            int64              `json:"chain_id"`
+	ContractVersion    int                `json:"contract_version"`
 }
 
+const (
+	SortNewest     = "newest"
+	SortEndingSoon = "ending_soon"
+	SortMostFunded = "most_funded"
+)
+
 type SearchCampaignRequest struct {
-	Name string `json:"name"`
+	Query            string   `form:"query"`
+	Status           string   `form:"status" binding:"omitempty,oneof=active expired funded"`
+	MinGoal          *float64 `form:"min_goal" binding:"omitempty,min=0"`
+	MaxGoal          *float64 `form:"max_goal" binding:"omitempty,min=0"`
+	MinPercentFunded *float64 `form:"min_percent_funded" binding:"omitempty,min=0"`
+	MaxPercentFunded *float64 `form:"max_percent_funded" binding:"omitempty,min=0"`
+	Sort             string   `form:"sort" binding:"omitempty,oneof=newest ending_soon most_funded"`
+	CurrencyRequest
+	ChainRequest
+	PageRequest
+}
+
+// ListCampaignRequest is the query of the campaign list routes
+type ListCampaignRequest struct {
+	CurrencyRequest
+	ChainRequest
+	PageRequest
+}
+
+// NewIndexedCampaign maps an indexed campaign row and its owner to the API shape. token is the symbol of the chain's native currency.
+func NewIndexedCampaign(campaign db.IndexedCampaigns, owner db.Users, token string) Campaigns {
+	return Campaigns{
+		CampaignType:       campaign.Category,
+		Title:              campaign.Title,
+		Description:        campaign.Description,
+		Goal:               utils.WeiToEther(campaign.Goal),
+		Deadline:           campaign.Deadline,
+		TotalAmountDonated: utils.WeiToEther(campaign.TotalFunds),
+		ID:                 int(campaign.CampaignID),
+		Image:              campaign.Image,
+		Owner:              campaign.Owner,
+		TotalNumber:        campaign.TotalContributors,
+		User: []UserResponseInfo{
+			{
+				Username: owner.Username,
+				Email:    owner.Email,
+				Address:  owner.Address,
+				Avatar:   owner.Avatar,
+			},
+		},
+		Donations:       []DonorDetails{},
+		Amounts:         NewCampaignAmounts(token, campaign.Goal, campaign.TotalFunds),
+		ChainID:         campaign.ChainID,
+		ContractVersion: int(campaign.ContractVersion),
+	}
 }
 
 type DonorDetails struct {
@@ -57,10 +114,14 @@ type DonorDetails struct {
 type Donation struct {
 	Amount     string `json:"amount"`
 	CampaignId string     `json:"campaign_id"`
+	// RewardTierID optionally claims one of the campaign's reward tiers with the donation
+	RewardTierID int64 `json:"reward_tier_id" binding:"omitempty,min=1"`
+	ChainRequest
 }
 
 type Withdraw struct {
 	CampaignId int `json:"campaign_id"`
+	ChainRequest
 }
 
 func UnmarshalCurrentPrice(data []byte) (CurrentPrice, error) {
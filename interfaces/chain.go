@@ -0,0 +1,39 @@
+package interfaces
+
+import "github.com/demola234/defiraise/defi"
+
+// ChainRequest selects the chain and contract version a request is routed to. Zero selects the default
+// chain and its current contract version.
+type ChainRequest struct {
+	ChainID         int64 `form:"chain_id" json:"chain_id" binding:"omitempty,min=1"`
+	ContractVersion int   `form:"contract_version" json:"contract_version" binding:"omitempty,min=1"`
+}
+
+// ChainResponse describes a chain the campaign contract is deployed to
+type ChainResponse struct {
+	ChainID         int64              `json:"chain_id"`
+	Name            string             `json:"name"`
+	ContractAddress string             `json:"contract_address"`
+	ExplorerURL     string             `json:"explorer_url"`
+	NativeSymbol    string             `json:"native_symbol"`
+	Default         bool               `json:"default"`
+	Testnet         bool               `json:"testnet"`
+	Contracts       []ContractResponse `json:"contracts"`
+}
+
+// ContractResponse describes one campaign contract version on a chain. Implementation is the contract
+// behind a proxy, when the chain could be read.
+type ContractResponse struct {
+	Version        int    `json:"version"`
+	Address        string `json:"address"`
+	Proxy          bool   `json:"proxy"`
+	Implementation string `json:"implementation,omitempty"`
+	Current        bool   `json:"current"`
+}
+
+// ChainStatusResponse is the health of a chain's RPC providers
+type ChainStatusResponse struct {
+	ChainID   int64                 `json:"chain_id"`
+	Name      string                `json:"name"`
+	Providers []defi.ProviderStatus `json:"providers"`
+}
@@ -0,0 +1,64 @@
+package interfaces
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/demola234/defiraise/utils"
+)
+
+// SetCurrencyRequest sets the preferred currency of a user or the display currency of a campaign
+type SetCurrencyRequest struct {
+	Currency string `json:"currency" binding:"required,currency"`
+}
+
+// CurrencyRequest overrides the currency fiat amounts are returned in
+type CurrencyRequest struct {
+	Currency string `form:"currency" binding:"omitempty,currency"`
+}
+
+// CampaignAmounts is a campaign's goal and progress in its token, and in fiat when a price is available
+type CampaignAmounts struct {
+	Token     string       `json:"token"`
+	Goal      float64      `json:"goal"`
+	Raised    float64      `json:"raised"`
+	Remaining float64      `json:"remaining"`
+	Fiat      *FiatAmounts `json:"fiat,omitempty"`
+}
+
+type FiatAmounts struct {
+	Currency  string  `json:"currency"`
+	Price     float64 `json:"price"`
+	Goal      float64 `json:"goal"`
+	Raised    float64 `json:"raised"`
+	Remaining float64 `json:"remaining"`
+}
+
+// NewCampaignAmounts converts a goal and raised amount in wei to token amounts
+func NewCampaignAmounts(token string, goalWei string, raisedWei string) CampaignAmounts {
+	remaining := "0"
+	goal, goalOK := new(big.Int).SetString(goalWei, 10)
+	raised, raisedOK := new(big.Int).SetString(raisedWei, 10)
+	if goalOK && raisedOK && goal.Cmp(raised) > 0 {
+		remaining = new(big.Int).Sub(goal, raised).String()
+	}
+
+	return CampaignAmounts{
+		Token:     token,
+		Goal:      utils.WeiToEther(goalWei),
+		Raised:    utils.WeiToEther(raisedWei),
+		Remaining: utils.WeiToEther(remaining),
+	}
+}
+
+// WithFiat returns a copy of the amounts valued at price, rounded to cents
+func (amounts CampaignAmounts) WithFiat(currency string, price float64) CampaignAmounts {
+	amounts.Fiat = &FiatAmounts{
+		Currency:  strings.ToUpper(currency),
+		Price:     price,
+		Goal:      utils.RoundCents(amounts.Goal * price),
+		Raised:    utils.RoundCents(amounts.Raised * price),
+		Remaining: utils.RoundCents(amounts.Remaining * price),
+	}
+	return amounts
+}
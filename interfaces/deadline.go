@@ -0,0 +1,46 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+)
+
+const (
+	DeadlineExtended = "extend"
+	DeadlineClosed   = "close"
+)
+
+type ExtendDeadlineRequest struct {
+	NewDeadline time.Time `json:"new_deadline" binding:"required"`
+}
+
+type DeadlineChange struct {
+	ID               int64     `json:"id"`
+	CampaignID       int64     `json:"campaign_id"`
+	Kind             string    `json:"kind"`
+	PreviousDeadline time.Time `json:"previous_deadline"`
+	Deadline         time.Time `json:"deadline"`
+	TxHash           string    `json:"tx_hash"`
+	ProposalID       *int64    `json:"proposal_id,omitempty"`
+	CreatedBy        string    `json:"created_by"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// NewDeadlineChange maps a recorded deadline change to the API shape
+func NewDeadlineChange(change db.CampaignDeadlineChanges) DeadlineChange {
+	rsp := DeadlineChange{
+		ID:               change.ID,
+		CampaignID:       change.CampaignID,
+		Kind:             change.Kind,
+		PreviousDeadline: change.PreviousDeadline,
+		Deadline:         change.Deadline,
+		TxHash:           change.TxHash,
+		CreatedBy:        change.CreatedBy,
+		CreatedAt:        change.CreatedAt,
+	}
+	if change.ProposalID.Valid {
+		rsp.ProposalID = &change.ProposalID.Int64
+	}
+	return rsp
+}
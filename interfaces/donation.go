@@ -0,0 +1,53 @@
+package interfaces
+
+import "time"
+
+// DonationHistoryItem is a single donation made from one of the user's wallets
+type DonationHistoryItem struct {
+	ID            int64     `json:"id"`
+	CampaignID    int64     `json:"campaign_id"`
+	CampaignTitle string    `json:"campaign_title"`
+	Donor         string    `json:"donor"`
+	Amount        float64   `json:"amount"`
+	Token         string    `json:"token"`
+	TxHash        string    `json:"tx_hash"`
+	BlockNumber   int64     `json:"block_number"`
+	Status        string    `json:"status"`
+	FiatAmount    *float64  `json:"fiat_amount"`
+	FiatCurrency  string    `json:"fiat_currency"`
+	DonatedAt     time.Time `json:"donated_at"`
+}
+
+// CampaignDonationTotal is how much the user has given to a single campaign
+type CampaignDonationTotal struct {
+	ChainID         int64   `json:"chain_id"`
+	ContractVersion int     `json:"contract_version"`
+	CampaignID      int64   `json:"campaign_id"`
+	CampaignTitle   string  `json:"campaign_title"`
+	DonationCount   int64   `json:"donation_count"`
+	TotalAmount     float64 `json:"total_amount"`
+	TotalFiat       float64 `json:"total_fiat"`
+}
+
+type DonationHistoryResponse struct {
+	Donations        Page                    `json:"donations"`
+	Totals           []CampaignDonationTotal `json:"totals"`
+	TotalDonated     float64                 `json:"total_donated"`
+	TotalDonatedFiat float64                 `json:"total_donated_fiat"`
+	FiatCurrency     string                  `json:"fiat_currency"`
+	DonationCount    int64                   `json:"donation_count"`
+}
+
+// CampaignTotals are a campaign's recorded donations, with fiat valued at each donation's time
+type CampaignTotals struct {
+	CampaignID    int64   `json:"campaign_id"`
+	DonationCount int64   `json:"donation_count"`
+	TotalAmount   float64 `json:"total_amount"`
+	TotalFiat     float64 `json:"total_fiat"`
+	FiatCurrency  string  `json:"fiat_currency"`
+}
+
+// DocumentRequest selects the format of a receipt or statement
+type DocumentRequest struct {
+	Format string `form:"format" json:"format" binding:"omitempty,oneof=pdf csv"`
+}
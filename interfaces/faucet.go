@@ -0,0 +1,50 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+)
+
+// ClaimFaucetGrantRequest asks for the onboarding grant on a test network
+type ClaimFaucetGrantRequest struct {
+	// ChainID defaults to the default chain
+	ChainID int64 `json:"chain_id" binding:"min=0"`
+}
+
+// FaucetGrant is one asset of an onboarding grant sent to a user's wallet
+type FaucetGrant struct {
+	ID      int64 `json:"id"`
+	ChainID int64 `json:"chain_id"`
+	// Asset is "native" for the chain's native currency, or the token's address
+	Asset   string `json:"asset"`
+	Address string `json:"address"`
+	// Amount is in the asset's base units, such as wei
+	Amount    string    `json:"amount"`
+	Status    string    `json:"status"`
+	TxHash    string    `json:"tx_hash,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewFaucetGrant maps a stored grant to the API shape
+func NewFaucetGrant(grant db.FaucetGrants) FaucetGrant {
+	return FaucetGrant{
+		ID:        grant.ID,
+		ChainID:   grant.ChainID,
+		Asset:     grant.Asset,
+		Address:   grant.Address,
+		Amount:    grant.Amount,
+		Status:    grant.Status,
+		TxHash:    grant.TxHash.String,
+		CreatedAt: grant.CreatedAt,
+	}
+}
+
+// NewFaucetGrants maps stored grants to the API shape
+func NewFaucetGrants(grants []db.FaucetGrants) []FaucetGrant {
+	rsp := make([]FaucetGrant, 0, len(grants))
+	for _, grant := range grants {
+		rsp = append(rsp, NewFaucetGrant(grant))
+	}
+	return rsp
+}
@@ -0,0 +1,47 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+)
+
+// GasBudget is what is left of a user's gas sponsorship on a chain for the current period
+type GasBudget struct {
+	ChainID   int64   `json:"chain_id"`
+	Budget    float64 `json:"budget"`
+	Spent     float64 `json:"spent"`
+	Remaining float64 `json:"remaining"`
+	Grants    int32   `json:"grants"`
+	MaxGrants int32   `json:"max_grants"`
+	// ResetsAt is when the budget starts over, or nil before the first sponsored action
+	ResetsAt *time.Time `json:"resets_at"`
+}
+
+// GasSponsorshipTotal is the gas sponsored for one action on a chain
+type GasSponsorshipTotal struct {
+	ChainID      int64   `json:"chain_id"`
+	Action       string  `json:"action"`
+	Sponsorships int64   `json:"sponsorships"`
+	Users        int64   `json:"users"`
+	Amount       float64 `json:"amount"`
+}
+
+// GasSponsorshipSummary accounts for the gas the relayer wallet has sponsored since a date
+type GasSponsorshipSummary struct {
+	RelayerAddress string                `json:"relayer_address"`
+	Since          time.Time             `json:"since"`
+	Totals         []GasSponsorshipTotal `json:"totals"`
+}
+
+// NewGasSponsorshipTotal maps a stored sponsorship total to the API shape
+func NewGasSponsorshipTotal(total db.SummarizeGasSponsorshipsRow) GasSponsorshipTotal {
+	return GasSponsorshipTotal{
+		ChainID:      total.ChainID,
+		Action:       total.Action,
+		Sponsorships: total.Sponsorships,
+		Users:        total.Users,
+		Amount:       utils.WeiToEther(total.Amount),
+	}
+}
@@ -0,0 +1,126 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+)
+
+const (
+	MatchingPoolActive    = "active"
+	MatchingPoolExhausted = "exhausted"
+	MatchingPoolClosed    = "closed"
+
+	MatchPending = "pending"
+	MatchSent    = "sent"
+	MatchSkipped = "skipped"
+)
+
+// CreateMatchingPoolRequest opens a matching pool. A pool with no campaign IDs or categories matches
+// donations to every campaign on the chain's contract version.
+type CreateMatchingPoolRequest struct {
+	Name string `json:"name" binding:"required"`
+	// RatioPercent is the share of each donation matched: 100 matches it 1:1, 50 matches half of it
+	RatioPercent int32      `json:"ratio_percent" binding:"required,min=1,max=1000"`
+	Cap          float64    `json:"cap" binding:"required,gt=0"`
+	MaxMatch     float64    `json:"max_match" binding:"omitempty,gt=0"`
+	MinDonation  float64    `json:"min_donation" binding:"omitempty,gt=0"`
+	CampaignIDs  []int64    `json:"campaign_ids" binding:"omitempty,dive,min=0"`
+	Categories   []string   `json:"categories" binding:"omitempty,dive,required"`
+	EndsAt       *time.Time `json:"ends_at"`
+	ChainRequest
+}
+
+type MatchingPool struct {
+	ID              int64      `json:"id"`
+	Sponsor         string     `json:"sponsor"`
+	Name            string     `json:"name"`
+	ChainID         int64      `json:"chain_id"`
+	ContractVersion int32      `json:"contract_version"`
+	WalletAddress   string     `json:"wallet_address"`
+	RatioPercent    int32      `json:"ratio_percent"`
+	Cap             float64    `json:"cap"`
+	MaxMatch        *float64   `json:"max_match"`
+	MinDonation     float64    `json:"min_donation"`
+	CampaignIDs     []int64    `json:"campaign_ids"`
+	Categories      []string   `json:"categories"`
+	EndsAt          *time.Time `json:"ends_at"`
+	MatchedTotal    float64    `json:"matched_total"`
+	Status          string     `json:"status"`
+	RefundTxHash    string     `json:"refund_tx_hash"`
+	ClosedAt        *time.Time `json:"closed_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// NewMatchingPool maps a stored matching pool to the API shape
+func NewMatchingPool(pool db.MatchingPools) MatchingPool {
+	rsp := MatchingPool{
+		ID:              pool.ID,
+		Sponsor:         pool.Sponsor,
+		Name:            pool.Name,
+		ChainID:         pool.ChainID,
+		ContractVersion: pool.ContractVersion,
+		WalletAddress:   pool.WalletAddress,
+		RatioPercent:    pool.RatioPercent,
+		Cap:             utils.WeiToEther(pool.Cap),
+		MinDonation:     utils.WeiToEther(pool.MinDonation),
+		CampaignIDs:     pool.CampaignIds,
+		Categories:      pool.Categories,
+		MatchedTotal:    utils.WeiToEther(pool.MatchedTotal),
+		Status:          pool.Status,
+		RefundTxHash:    pool.RefundTxHash.String,
+		CreatedAt:       pool.CreatedAt,
+	}
+	if pool.MaxMatch.Valid {
+		maxMatch := utils.WeiToEther(pool.MaxMatch.String)
+		rsp.MaxMatch = &maxMatch
+	}
+	if pool.EndsAt.Valid {
+		rsp.EndsAt = &pool.EndsAt.Time
+	}
+	if pool.ClosedAt.Valid {
+		rsp.ClosedAt = &pool.ClosedAt.Time
+	}
+	return rsp
+}
+
+type PoolMatch struct {
+	ID               int64     `json:"id"`
+	DonationRecordID int64     `json:"donation_record_id"`
+	CampaignID       int64     `json:"campaign_id"`
+	Amount           float64   `json:"amount"`
+	Status           string    `json:"status"`
+	TxHash           string    `json:"tx_hash"`
+	Reason           string    `json:"reason"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+func NewPoolMatch(match db.MatchingPoolMatches) PoolMatch {
+	return PoolMatch{
+		ID:               match.ID,
+		DonationRecordID: match.DonationRecordID,
+		CampaignID:       match.CampaignID,
+		Amount:           utils.WeiToEther(match.Amount),
+		Status:           match.Status,
+		TxHash:           match.TxHash.String,
+		Reason:           match.Reason.String,
+		CreatedAt:        match.CreatedAt,
+	}
+}
+
+// CampaignMatches is the total a pool has matched for one campaign
+type CampaignMatches struct {
+	CampaignID    int64   `json:"campaign_id"`
+	Matches       int64   `json:"matches"`
+	MatchedAmount float64 `json:"matched_amount"`
+}
+
+// MatchingPoolReport is a pool with what is left of it and where its matches went
+type MatchingPoolReport struct {
+	MatchingPool
+	Remaining     float64           `json:"remaining"`
+	WalletBalance string            `json:"wallet_balance"`
+	Campaigns     []CampaignMatches `json:"campaigns"`
+	RecentMatches []PoolMatch       `json:"recent_matches"`
+}
@@ -0,0 +1,96 @@
+package interfaces
+
+import (
+	"database/sql"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+)
+
+const (
+	MilestonePending   = "pending"
+	MilestoneSubmitted = "submitted"
+	MilestoneApproved  = "approved"
+	MilestoneRejected  = "rejected"
+	MilestoneReleased  = "released"
+
+	// MilestoneApprovedByDonors is recorded as the approver when a donor vote decides a milestone
+	MilestoneApprovedByDonors = "donors"
+)
+
+type MilestoneInput struct {
+	Title       string  `json:"title" binding:"required"`
+	Description string  `json:"description" binding:"required"`
+	Amount      float64 `json:"amount" binding:"required,gt=0"`
+}
+
+type CreateMilestonesRequest struct {
+	Milestones []MilestoneInput `json:"milestones" binding:"required,min=1,max=20,dive"`
+}
+
+type SubmitMilestoneRequest struct {
+	Evidence    string `json:"evidence" binding:"required"`
+	EvidenceURL string `json:"evidence_url" binding:"omitempty,url"`
+}
+
+// MilestoneDecisionRequest is a donor vote or a moderator review of a submitted milestone
+type MilestoneDecisionRequest struct {
+	Approve *bool `json:"approve" binding:"required"`
+}
+
+type MilestoneVotes struct {
+	ApproveWeight float64 `json:"approve_weight"`
+	RejectWeight  float64 `json:"reject_weight"`
+	VoteCount     int64   `json:"vote_count"`
+}
+
+type Milestone struct {
+	ID            int64          `json:"id"`
+	CampaignID    int64          `json:"campaign_id"`
+	Position      int32          `json:"position"`
+	Title         string         `json:"title"`
+	Description   string         `json:"description"`
+	Amount        float64        `json:"amount"`
+	Status        string         `json:"status"`
+	Evidence      string         `json:"evidence"`
+	EvidenceURL   string         `json:"evidence_url"`
+	SubmittedAt   *time.Time     `json:"submitted_at"`
+	ApprovedBy    string         `json:"approved_by"`
+	ApprovedAt    *time.Time     `json:"approved_at"`
+	ReleaseTxHash string         `json:"release_tx_hash"`
+	ReleasedAt    *time.Time     `json:"released_at"`
+	Votes         MilestoneVotes `json:"votes"`
+}
+
+// NewMilestone maps a milestone row and its vote tally to the API shape
+func NewMilestone(milestone db.CampaignMilestones, votes db.SumMilestoneVotesRow) Milestone {
+	return Milestone{
+		ID:            milestone.ID,
+		CampaignID:    milestone.CampaignID,
+		Position:      milestone.Position,
+		Title:         milestone.Title,
+		Description:   milestone.Description,
+		Amount:        utils.WeiToEther(milestone.Amount),
+		Status:        milestone.Status,
+		Evidence:      milestone.Evidence,
+		EvidenceURL:   milestone.EvidenceURL,
+		SubmittedAt:   nullTime(milestone.SubmittedAt),
+		ApprovedBy:    milestone.ApprovedBy,
+		ApprovedAt:    nullTime(milestone.ApprovedAt),
+		ReleaseTxHash: milestone.ReleaseTxHash.String,
+		ReleasedAt:    nullTime(milestone.ReleasedAt),
+		Votes: MilestoneVotes{
+			ApproveWeight: utils.WeiToEther(votes.ApproveWeight),
+			RejectWeight:  utils.WeiToEther(votes.RejectWeight),
+			VoteCount:     votes.VoteCount,
+		},
+	}
+}
+
+func nullTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
@@ -0,0 +1,67 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+)
+
+// CreateOnRampOrderRequest buys the chain's native currency with fiat money for the user's wallet
+type CreateOnRampOrderRequest struct {
+	FiatAmount   float64 `json:"fiat_amount" binding:"required,gt=0"`
+	FiatCurrency string  `json:"fiat_currency" binding:"required,currency"`
+	// CampaignID donates the purchase to a campaign once it completes
+	CampaignID *int64 `json:"campaign_id" binding:"omitempty,min=0"`
+	ChainRequest
+}
+
+// OnRampOrder is a purchase of crypto with fiat money, delivered to the user's wallet
+type OnRampOrder struct {
+	ID              int64   `json:"id"`
+	Provider        string  `json:"provider"`
+	ChainID         int64   `json:"chain_id"`
+	ContractVersion int32   `json:"contract_version"`
+	Address         string  `json:"address"`
+	Asset           string  `json:"asset"`
+	FiatAmount      string  `json:"fiat_amount"`
+	FiatCurrency    string  `json:"fiat_currency"`
+	CampaignID      *int64  `json:"campaign_id"`
+	CheckoutURL     string  `json:"checkout_url"`
+	Status          string  `json:"status"`
+	CryptoAmount    float64 `json:"crypto_amount"`
+	TxHash          string  `json:"tx_hash,omitempty"`
+	// DonationStatus is pending, sent or failed for a purchase donated to a campaign
+	DonationStatus string     `json:"donation_status,omitempty"`
+	DonationTxHash string     `json:"donation_tx_hash,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	CompletedAt    *time.Time `json:"completed_at"`
+}
+
+// NewOnRampOrder maps a stored order to the API shape
+func NewOnRampOrder(order db.OnrampOrders) OnRampOrder {
+	rsp := OnRampOrder{
+		ID:              order.ID,
+		Provider:        order.Provider,
+		ChainID:         order.ChainID,
+		ContractVersion: order.ContractVersion,
+		Address:         order.Address,
+		Asset:           order.Asset,
+		FiatAmount:      order.FiatAmount,
+		FiatCurrency:    order.FiatCurrency,
+		CheckoutURL:     order.CheckoutURL,
+		Status:          order.Status,
+		CryptoAmount:    utils.WeiToEther(order.CryptoAmount.String),
+		TxHash:          order.TxHash.String,
+		DonationStatus:  order.DonationStatus.String,
+		DonationTxHash:  order.DonationTxHash.String,
+		CreatedAt:       order.CreatedAt,
+	}
+	if order.CampaignID.Valid {
+		rsp.CampaignID = &order.CampaignID.Int64
+	}
+	if order.CompletedAt.Valid {
+		rsp.CompletedAt = &order.CompletedAt.Time
+	}
+	return rsp
+}
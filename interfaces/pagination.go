@@ -0,0 +1,32 @@
+package interfaces
+
+const (
+	// DefaultPageSize is used when a list request does not set a limit
+	DefaultPageSize int32 = 20
+	// MaxPageSize caps the limit a client can ask for on any list route
+	MaxPageSize int32 = 100
+)
+
+// PageRequest is the query string contract shared by every list route
+type PageRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int32  `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// PageSize returns the requested limit, falling back to DefaultPageSize
+func (req PageRequest) PageSize() int32 {
+	if req.Limit <= 0 {
+		return DefaultPageSize
+	}
+	if req.Limit > MaxPageSize {
+		return MaxPageSize
+	}
+	return req.Limit
+}
+
+// Page is the response body shared by every list route. NextCursor is empty on the last page.
+type Page struct {
+	Items      interface{} `json:"items"`
+	NextCursor string      `json:"next_cursor"`
+	TotalCount int64       `json:"total_count"`
+}
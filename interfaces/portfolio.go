@@ -0,0 +1,32 @@
+package interfaces
+
+const (
+	WalletCustodial = "custodial"
+	WalletLinked    = "linked"
+)
+
+type PortfolioResponse struct {
+	ChainID   int64             `json:"chain_id"`
+	Currency  string            `json:"currency"`
+	TotalFiat float64           `json:"total_fiat"`
+	Wallets   []WalletPortfolio `json:"wallets"`
+}
+
+type WalletPortfolio struct {
+	Address   string         `json:"address"`
+	Type      string         `json:"type"`
+	TotalFiat float64        `json:"total_fiat"`
+	Balances  []TokenHolding `json:"balances"`
+}
+
+// TokenHolding is one balance of a wallet. Address is empty for the native token.
+// Price and FiatValue are omitted when the token has no price.
+type TokenHolding struct {
+	Symbol    string   `json:"symbol"`
+	Address   string   `json:"address,omitempty"`
+	Decimals  uint8    `json:"decimals"`
+	Logo      string   `json:"logo,omitempty"`
+	Balance   string   `json:"balance"`
+	Price     *float64 `json:"price,omitempty"`
+	FiatValue *float64 `json:"fiat_value,omitempty"`
+}
@@ -0,0 +1,34 @@
+package interfaces
+
+import "time"
+
+// PriceHistoryRequest selects recorded price samples in [From, To)
+type PriceHistoryRequest struct {
+	Token    string    `form:"token"`
+	Currency string    `form:"currency"`
+	From     time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To       time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	PageRequest
+}
+
+type PriceSample struct {
+	Token      string    `json:"token"`
+	Currency   string    `json:"currency"`
+	Price      string    `json:"price"`
+	Source     string    `json:"source"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// CurrentPriceRequest selects the token and fiat currency to quote
+type CurrentPriceRequest struct {
+	Token    string `form:"token" binding:"omitempty,alphanum,max=10"`
+	Currency string `form:"currency" binding:"omitempty,alpha,len=3"`
+}
+
+type TokenPrice struct {
+	Token     string    `json:"token"`
+	Currency  string    `json:"currency"`
+	Price     float64   `json:"price"`
+	Sources   []string  `json:"sources"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
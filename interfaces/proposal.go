@@ -0,0 +1,111 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	ProposalExtendDeadline   = "extend_deadline"
+	ProposalReleaseMilestone = "release_milestone"
+	ProposalChangeScope      = "change_scope"
+
+	ProposalOpen      = "open"
+	ProposalPassed    = "passed"
+	ProposalExecuting = "executing"
+	ProposalFailed    = "failed"
+	ProposalExecuted  = "executed"
+)
+
+type CreateProposalRequest struct {
+	Kind        string `json:"kind" binding:"required,oneof=extend_deadline release_milestone change_scope"`
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description" binding:"required"`
+	// MilestoneID is the submitted milestone a release_milestone proposal releases
+	MilestoneID int64 `json:"milestone_id" binding:"omitempty,min=1"`
+	// NewDeadline is the deadline an extend_deadline proposal moves the campaign to
+	NewDeadline *time.Time `json:"new_deadline"`
+	// Quorum is the percentage of the campaign's donations that must vote, 20 by default
+	Quorum int32 `json:"quorum" binding:"omitempty,min=1,max=100"`
+	// VotingHours is how long the vote stays open, 72 by default
+	VotingHours int `json:"voting_hours" binding:"omitempty,min=1,max=720"`
+}
+
+// ProposalVoteRequest is a donor's vote with its EIP-712 signature, made with eth_signTypedData_v4 over
+// the typed data from /proposals/:proposal_id/vote-data
+type ProposalVoteRequest struct {
+	Voter     string `json:"voter" binding:"required,eth_addr"`
+	Support   *bool  `json:"support" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
+
+type ProposalVoteDataRequest struct {
+	Voter   string `form:"voter" binding:"required,eth_addr"`
+	Support *bool  `form:"support" binding:"required"`
+}
+
+type ProposalVotes struct {
+	ForWeight     float64 `json:"for_weight"`
+	AgainstWeight float64 `json:"against_weight"`
+	VoteCount     int64   `json:"vote_count"`
+}
+
+type Proposal struct {
+	ID              int64         `json:"id"`
+	ChainID         int64         `json:"chain_id"`
+	ContractVersion int32         `json:"contract_version"`
+	CampaignID      int64         `json:"campaign_id"`
+	Kind            string        `json:"kind"`
+	Title           string        `json:"title"`
+	Description     string        `json:"description"`
+	MilestoneID     *int64        `json:"milestone_id,omitempty"`
+	NewDeadline     *time.Time    `json:"new_deadline,omitempty"`
+	Quorum          int32         `json:"quorum"`
+	EndsAt          time.Time     `json:"ends_at"`
+	Status          string        `json:"status"`
+	Votes           ProposalVotes `json:"votes"`
+	// TotalWeight is the campaign's donations when the vote closed
+	TotalWeight float64    `json:"total_weight"`
+	CreatedBy   string     `json:"created_by"`
+	ClosedAt    *time.Time `json:"closed_at"`
+	ExecutedAt  *time.Time `json:"executed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type ProposalVoteData struct {
+	TypedData apitypes.TypedData `json:"typed_data"`
+}
+
+// NewProposal maps a proposal row and its vote tally to the API shape
+func NewProposal(proposal db.CampaignProposals, votes db.SumProposalVotesRow) Proposal {
+	rsp := Proposal{
+		ID:              proposal.ID,
+		ChainID:         proposal.ChainID,
+		ContractVersion: proposal.ContractVersion,
+		CampaignID:      proposal.CampaignID,
+		Kind:            proposal.Kind,
+		Title:           proposal.Title,
+		Description:     proposal.Description,
+		NewDeadline:     nullTime(proposal.NewDeadline),
+		Quorum:          proposal.Quorum,
+		EndsAt:          proposal.EndsAt,
+		Status:          proposal.Status,
+		Votes: ProposalVotes{
+			ForWeight:     utils.WeiToEther(votes.ForWeight),
+			AgainstWeight: utils.WeiToEther(votes.AgainstWeight),
+			VoteCount:     votes.VoteCount,
+		},
+		TotalWeight: utils.WeiToEther(proposal.TotalWeight),
+		CreatedBy:   proposal.CreatedBy,
+		ClosedAt:    nullTime(proposal.ClosedAt),
+		ExecutedAt:  nullTime(proposal.ExecutedAt),
+		CreatedAt:   proposal.CreatedAt,
+	}
+	if proposal.MilestoneID.Valid {
+		rsp.MilestoneID = &proposal.MilestoneID.Int64
+	}
+	return rsp
+}
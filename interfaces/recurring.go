@@ -0,0 +1,78 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+)
+
+const (
+	RecurringWeekly  = "weekly"
+	RecurringMonthly = "monthly"
+
+	RecurringActive    = "active"
+	RecurringPaused    = "paused"
+	RecurringCancelled = "cancelled"
+	RecurringCompleted = "completed"
+)
+
+type CreateRecurringDonationRequest struct {
+	CampaignID int64   `json:"campaign_id" binding:"min=0"`
+	Amount     float64 `json:"amount" binding:"required,gt=0"`
+	// Token defaults to the chain's native currency, the only one the contract accepts
+	Token        string     `json:"token"`
+	Interval     string     `json:"interval" binding:"required,oneof=weekly monthly"`
+	StartAt      *time.Time `json:"start_at"`
+	EndsAt       *time.Time `json:"ends_at"`
+	MaxDonations int32      `json:"max_donations" binding:"omitempty,min=1"`
+	ChainRequest
+}
+
+type RecurringDonation struct {
+	ID              int64      `json:"id"`
+	ChainID         int64      `json:"chain_id"`
+	ContractVersion int32      `json:"contract_version"`
+	CampaignID      int64      `json:"campaign_id"`
+	Amount          float64    `json:"amount"`
+	Token           string     `json:"token"`
+	Interval        string     `json:"interval"`
+	EndsAt          *time.Time `json:"ends_at"`
+	MaxDonations    *int32     `json:"max_donations"`
+	Status          string     `json:"status"`
+	NextRunAt       *time.Time `json:"next_run_at"`
+	DonationCount   int32      `json:"donation_count"`
+	FailedAttempts  int32      `json:"failed_attempts"`
+	LastTxHash      string     `json:"last_tx_hash"`
+	LastError       string     `json:"last_error"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// NewRecurringDonation maps a stored recurring donation to the API shape. Only an active one has a next run.
+func NewRecurringDonation(donation db.RecurringDonations) RecurringDonation {
+	rsp := RecurringDonation{
+		ID:              donation.ID,
+		ChainID:         donation.ChainID,
+		ContractVersion: donation.ContractVersion,
+		CampaignID:      donation.CampaignID,
+		Amount:          utils.WeiToEther(donation.Amount),
+		Token:           donation.Token,
+		Interval:        donation.Interval,
+		Status:          donation.Status,
+		DonationCount:   donation.DonationCount,
+		FailedAttempts:  donation.FailedAttempts,
+		LastTxHash:      donation.LastTxHash.String,
+		LastError:       donation.LastError.String,
+		CreatedAt:       donation.CreatedAt,
+	}
+	if donation.EndsAt.Valid {
+		rsp.EndsAt = &donation.EndsAt.Time
+	}
+	if donation.MaxDonations.Valid {
+		rsp.MaxDonations = &donation.MaxDonations.Int32
+	}
+	if donation.Status == RecurringActive {
+		rsp.NextRunAt = &donation.NextRunAt
+	}
+	return rsp
+}
@@ -0,0 +1,97 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+)
+
+const (
+	RewardClaimPending   = "pending"
+	RewardClaimShipped   = "shipped"
+	RewardClaimFulfilled = "fulfilled"
+	RewardClaimCancelled = "cancelled"
+)
+
+// CreateRewardTierRequest adds a reward tier to a campaign. A tier without a quantity is unlimited.
+type CreateRewardTierRequest struct {
+	Title       string  `json:"title" binding:"required"`
+	Description string  `json:"description" binding:"required"`
+	MinAmount   float64 `json:"min_amount" binding:"required,gt=0"`
+	Quantity    int32   `json:"quantity" binding:"omitempty,min=1"`
+}
+
+// UpdateRewardClaimRequest moves a claim through fulfilment. Cancelling a claim is final and gives its
+// slot back to the tier.
+type UpdateRewardClaimRequest struct {
+	Status string `json:"status" binding:"required,oneof=pending shipped fulfilled cancelled"`
+	Note   string `json:"note" binding:"max=500"`
+}
+
+type RewardTier struct {
+	ID          int64   `json:"id"`
+	CampaignID  int64   `json:"campaign_id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	MinAmount   float64 `json:"min_amount"`
+	Quantity    *int32  `json:"quantity"`
+	Claimed     int32   `json:"claimed"`
+	// Remaining is the number of claims left, or nil for an unlimited tier
+	Remaining *int32    `json:"remaining"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewRewardTier maps a stored reward tier to the API shape
+func NewRewardTier(tier db.RewardTiers) RewardTier {
+	rsp := RewardTier{
+		ID:          tier.ID,
+		CampaignID:  tier.CampaignID,
+		Title:       tier.Title,
+		Description: tier.Description,
+		MinAmount:   utils.WeiToEther(tier.MinAmount),
+		Claimed:     tier.Claimed,
+		CreatedAt:   tier.CreatedAt,
+	}
+	if tier.Quantity.Valid {
+		remaining := tier.Quantity.Int32 - tier.Claimed
+		rsp.Quantity = &tier.Quantity.Int32
+		rsp.Remaining = &remaining
+	}
+	return rsp
+}
+
+type RewardClaim struct {
+	ID              int64     `json:"id"`
+	TierID          int64     `json:"tier_id"`
+	ChainID         int64     `json:"chain_id"`
+	ContractVersion int32     `json:"contract_version"`
+	CampaignID      int64     `json:"campaign_id"`
+	Username        string    `json:"username"`
+	DonorAddress    string    `json:"donor_address"`
+	Amount          float64   `json:"amount"`
+	TxHash          string    `json:"tx_hash"`
+	Status          string    `json:"status"`
+	Note            string    `json:"note"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// NewRewardClaim maps a stored reward claim to the API shape
+func NewRewardClaim(claim db.RewardClaims) RewardClaim {
+	return RewardClaim{
+		ID:              claim.ID,
+		TierID:          claim.TierID,
+		ChainID:         claim.ChainID,
+		ContractVersion: claim.ContractVersion,
+		CampaignID:      claim.CampaignID,
+		Username:        claim.Username,
+		DonorAddress:    claim.DonorAddress,
+		Amount:          utils.WeiToEther(claim.Amount),
+		TxHash:          claim.TxHash,
+		Status:          claim.Status,
+		Note:            claim.Note,
+		CreatedAt:       claim.CreatedAt,
+		UpdatedAt:       claim.UpdatedAt,
+	}
+}
@@ -0,0 +1,117 @@
+package interfaces
+
+import (
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/utils"
+)
+
+const (
+	RoundScheduled  = "scheduled"
+	RoundOpen       = "open"
+	RoundCalculated = "calculated"
+	RoundApproved   = "approved"
+	RoundPaid       = "paid"
+
+	AllocationPending = "pending"
+	AllocationSending = "sending"
+	AllocationSent    = "sent"
+)
+
+type CreateFundingRoundRequest struct {
+	Name           string    `json:"name" binding:"required"`
+	CampaignIDs    []int64   `json:"campaign_ids" binding:"required,min=1,dive,min=0"`
+	MatchingAmount float64   `json:"matching_amount" binding:"required,gt=0"`
+	StartsAt       time.Time `json:"starts_at" binding:"required"`
+	EndsAt         time.Time `json:"ends_at" binding:"required"`
+	// RequireVerified only matches donors with a verified account, counting a user's wallets as one
+	// donor. It defaults to true.
+	RequireVerified *bool   `json:"require_verified"`
+	MinContribution float64 `json:"min_contribution" binding:"omitempty,gt=0"`
+	ChainRequest
+}
+
+type FundingRound struct {
+	ID              int64      `json:"id"`
+	Name            string     `json:"name"`
+	ChainID         int64      `json:"chain_id"`
+	ContractVersion int32      `json:"contract_version"`
+	CampaignIDs     []int64    `json:"campaign_ids"`
+	MatchingAmount  float64    `json:"matching_amount"`
+	WalletAddress   string     `json:"wallet_address"`
+	StartsAt        time.Time  `json:"starts_at"`
+	EndsAt          time.Time  `json:"ends_at"`
+	RequireVerified bool       `json:"require_verified"`
+	MinContribution float64    `json:"min_contribution"`
+	Status          string     `json:"status"`
+	CreatedBy       string     `json:"created_by"`
+	ApprovedBy      string     `json:"approved_by"`
+	CalculatedAt    *time.Time `json:"calculated_at"`
+	PaidAt          *time.Time `json:"paid_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+func NewFundingRound(round db.FundingRounds) FundingRound {
+	rsp := FundingRound{
+		ID:              round.ID,
+		Name:            round.Name,
+		ChainID:         round.ChainID,
+		ContractVersion: round.ContractVersion,
+		CampaignIDs:     round.CampaignIds,
+		MatchingAmount:  utils.WeiToEther(round.MatchingAmount),
+		WalletAddress:   round.WalletAddress,
+		StartsAt:        round.StartsAt,
+		EndsAt:          round.EndsAt,
+		RequireVerified: round.RequireVerified,
+		MinContribution: utils.WeiToEther(round.MinContribution),
+		Status:          round.Status,
+		CreatedBy:       round.CreatedBy,
+		ApprovedBy:      round.ApprovedBy.String,
+		CreatedAt:       round.CreatedAt,
+	}
+	if round.CalculatedAt.Valid {
+		rsp.CalculatedAt = &round.CalculatedAt.Time
+	}
+	if round.PaidAt.Valid {
+		rsp.PaidAt = &round.PaidAt.Time
+	}
+	return rsp
+}
+
+// RoundAllocation is a campaign's share of a funding round. Contributors counts the unique eligible
+// donors and Contributed what they gave during the round.
+type RoundAllocation struct {
+	CampaignID           int64      `json:"campaign_id"`
+	Contributors         int32      `json:"contributors"`
+	ExcludedContributors int32      `json:"excluded_contributors"`
+	Contributed          float64    `json:"contributed"`
+	MatchAmount          float64    `json:"match_amount"`
+	Status               string     `json:"status"`
+	TxHash               string     `json:"tx_hash"`
+	LastError            string     `json:"last_error"`
+	PaidAt               *time.Time `json:"paid_at"`
+}
+
+func NewRoundAllocation(allocation db.FundingRoundAllocations) RoundAllocation {
+	rsp := RoundAllocation{
+		CampaignID:           allocation.CampaignID,
+		Contributors:         allocation.Contributors,
+		ExcludedContributors: allocation.ExcludedContributors,
+		Contributed:          utils.WeiToEther(allocation.Contributed),
+		MatchAmount:          utils.WeiToEther(allocation.MatchAmount),
+		Status:               allocation.Status,
+		TxHash:               allocation.TxHash.String,
+		LastError:            allocation.LastError.String,
+	}
+	if allocation.PaidAt.Valid {
+		rsp.PaidAt = &allocation.PaidAt.Time
+	}
+	return rsp
+}
+
+// FundingRoundReport is a round with its allocations, largest first, once it has been calculated
+type FundingRoundReport struct {
+	FundingRound
+	Allocations []RoundAllocation `json:"allocations"`
+}
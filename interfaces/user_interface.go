@@ -24,6 +24,9 @@ type UserResponse struct {
 	IsFirstTime       bool      `json:"is_first_time"`
 	Avatar            string    `json:"avatar"`
 	Biometrics        bool      `json:"biometrics"`
+	PreferredCurrency string    `json:"preferred_currency"`
+	// Badges are the user's supporter badges, only filled in on the user's own profile
+	Badges []Badge `json:"badges,omitempty"`
 }
 
 type DocSuccessResponse struct {
@@ -43,6 +46,7 @@ func NewUserResponse(user db.Users) UserResponse {
 		Avatar:            user.Avatar,
 		IsFirstTime:       user.IsFirstTime,
 		Biometrics:        user.Biometrics,
+		PreferredCurrency: user.PreferredCurrency,
 	}
 }
 
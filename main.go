@@ -1,11 +1,12 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
-	"github.com/demola234/defiraise/api"
-	db "github.com/demola234/defiraise/db/sqlc"
 	"github.com/demola234/defiraise/utils"
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog"
@@ -32,25 +33,31 @@ func main() {
 		zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
 	}
 
-	conn, err := sql.Open(configs.DBDriver, configs.DBSource)
-	if err != nil {
-		log.Fatal().Msg(err.Error())
+	// without a command, serve the API and run the indexer, recurring donation worker, matcher and badge minter in one process
+	name, args := "", []string{}
+	if len(os.Args) > 1 {
+		name, args = os.Args[1], os.Args[2:]
 	}
 
-	store := db.NewStore(conn)
-
-	runGinServer(configs, store)
-}
+	cmd, ok := findCommand(name)
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
 
-func runGinServer(configs utils.Config, store db.Store) {
-	server, err := api.NewServer(configs, store)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	if err != nil {
-		log.Fatal().Msgf("cannot create server: %s", err)
+	if err := cmd.run(ctx, configs, args); err != nil {
+		log.Fatal().Err(err).Msgf("%s failed", cmd.name)
 	}
+}
 
-	err = server.Start(configs.HTTPServerAddress)
-	if err != nil {
-		log.Fatal().Msg("cannot start http server")
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: defiraise [command] [flags]")
+	fmt.Fprintln(os.Stderr, "\nWithout a command, serves the API and runs the indexer, recurring donation worker, matcher and badge minter.\n\nCommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-18s %s\n", cmd.name, cmd.summary)
 	}
+	fmt.Fprintln(os.Stderr, "\nRun 'defiraise <command> -h' for a command's flags.")
 }
@@ -0,0 +1,336 @@
+package matching
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/indexer"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultInterval is used when MATCHING_INTERVAL is not configured
+	defaultInterval = time.Minute
+	// batchSize is the number of matchable donations read at a time
+	batchSize = 50
+)
+
+// errInsufficientBalance stops a pool until its wallet is topped up
+var errInsufficientBalance = errors.New("pool wallet cannot cover the match")
+
+// Matcher sends a matching donation from each active pool's wallet for every confirmed donation the
+// pool is eligible for, until the pool's cap is reached or its end date passes
+type Matcher struct {
+	store      db.Store
+	chains     *defi.ChainRegistry
+	passPhrase string
+	interval   time.Duration
+}
+
+// NewMatcher creates a matcher for the pools on every chain
+func NewMatcher(store db.Store, chains *defi.ChainRegistry, configs utils.Config) *Matcher {
+	matcher := &Matcher{
+		store:      store,
+		chains:     chains,
+		passPhrase: configs.PassPhase,
+		interval:   configs.MatchingInterval,
+	}
+	if matcher.interval <= 0 {
+		matcher.interval = defaultInterval
+	}
+
+	return matcher
+}
+
+// Start matches donations straight away and then on every interval until ctx is cancelled
+func (matcher *Matcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(matcher.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := matcher.RunOnce(ctx); err != nil {
+			log.Error().Err(err).Msg("cannot match donations")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce matches the donations each active pool has not considered yet. A pool that fails is
+// logged and picked up again on the next run.
+func (matcher *Matcher) RunOnce(ctx context.Context) error {
+	pools, err := matcher.store.ListActiveMatchingPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		if err := matcher.matchPool(ctx, pool); err != nil {
+			log.Error().Err(err).Int64("pool_id", pool.ID).Msg("cannot match donations for pool")
+		}
+	}
+	return nil
+}
+
+// poolWallet is the chain and funding key of a pool, opened once a match has to be sent
+type poolWallet struct {
+	chain   *defi.Chain
+	key     *ecdsa.PrivateKey
+	address string
+}
+
+func (matcher *Matcher) matchPool(ctx context.Context, pool db.MatchingPools) error {
+	logger := log.With().Int64("pool_id", pool.ID).Int64("chain_id", pool.ChainID).Int32("version", pool.ContractVersion).Logger()
+
+	if pool.EndsAt.Valid && !time.Now().Before(pool.EndsAt.Time) {
+		if _, err := matcher.store.ExhaustMatchingPool(ctx, pool.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		logger.Info().Msg("matching pool has ended")
+		return nil
+	}
+
+	var wallet *poolWallet
+	openWallet := func() (*poolWallet, error) {
+		if wallet == nil {
+			opened, err := matcher.openWallet(pool)
+			if err != nil {
+				return nil, err
+			}
+			wallet = opened
+		}
+		return wallet, nil
+	}
+
+	for {
+		donations, err := matcher.store.ListMatchableDonations(ctx, db.ListMatchableDonationsParams{
+			PoolID: pool.ID,
+			Limit:  batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, donation := range donations {
+			pool, err = matcher.match(ctx, logger, pool, openWallet, donation)
+			if err != nil {
+				return err
+			}
+			if pool.Status != interfaces.MatchingPoolActive {
+				logger.Info().Str("status", pool.Status).Str("matched_total", pool.MatchedTotal).Msg("matching pool is no longer active")
+				return nil
+			}
+		}
+
+		if len(donations) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (matcher *Matcher) openWallet(pool db.MatchingPools) (*poolWallet, error) {
+	chain, err := matcher.chains.Get(pool.ChainID)
+	if err == nil {
+		chain, err = chain.At(int(pool.ContractVersion))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, address, err := defi.DecryptPrivateKey(pool.FilePath, matcher.passPhrase)
+	if err != nil {
+		return nil, err
+	}
+	return &poolWallet{chain: chain, key: key, address: address}, nil
+}
+
+// match considers one donation for the pool and returns the pool as it stands afterwards. The match
+// is claimed and its amount reserved against the cap before it is sent, and both are undone if
+// sending fails.
+func (matcher *Matcher) match(ctx context.Context, logger zerolog.Logger, pool db.MatchingPools, openWallet func() (*poolWallet, error), donation db.DonationRecords) (db.MatchingPools, error) {
+	logger = logger.With().Int64("donation_record_id", donation.ID).Int64("campaign_id", donation.CampaignID).Logger()
+
+	campaign, err := matcher.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         donation.ChainID,
+		ContractVersion: donation.ContractVersion,
+		CampaignID:      donation.CampaignID,
+	})
+	if err != nil {
+		return pool, err
+	}
+
+	amount := matchAmount(pool, donation.Amount)
+	reason := skipReason(campaign, time.Now())
+	if reason == "" && amount.Sign() == 0 {
+		reason = "the match rounds to zero"
+	}
+	if reason != "" {
+		_, err := matcher.store.ClaimMatchingPoolMatch(ctx, db.ClaimMatchingPoolMatchParams{
+			PoolID:           pool.ID,
+			DonationRecordID: donation.ID,
+			CampaignID:       donation.CampaignID,
+			Amount:           "0",
+			Status:           interfaces.MatchSkipped,
+			Reason:           sql.NullString{String: reason, Valid: true},
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return pool, err
+		}
+		logger.Info().Str("reason", reason).Msg("donation not matched")
+		return pool, nil
+	}
+
+	wallet, err := openWallet()
+	if err != nil {
+		return pool, err
+	}
+	if err := checkBalance(wallet, amount); err != nil {
+		return pool, err
+	}
+
+	claim, err := matcher.store.ClaimMatchingPoolMatch(ctx, db.ClaimMatchingPoolMatchParams{
+		PoolID:           pool.ID,
+		DonationRecordID: donation.ID,
+		CampaignID:       donation.CampaignID,
+		Amount:           amount.String(),
+		Status:           interfaces.MatchPending,
+	})
+	if err != nil {
+		// another matcher has claimed the donation
+		if errors.Is(err, sql.ErrNoRows) {
+			return pool, nil
+		}
+		return pool, err
+	}
+
+	reserved, err := matcher.store.ReserveMatchingPoolFunds(ctx, db.ReserveMatchingPoolFundsParams{
+		Amount: amount.String(),
+		ID:     pool.ID,
+	})
+	if err != nil {
+		if err := matcher.store.DeleteMatchingPoolMatch(ctx, claim.ID); err != nil {
+			return pool, err
+		}
+		// the pool was closed or matched by another matcher since it was read
+		if errors.Is(err, sql.ErrNoRows) {
+			return matcher.store.GetMatchingPool(ctx, pool.ID)
+		}
+		return pool, err
+	}
+
+	hash, err := wallet.chain.DonateWei(amount, int(donation.CampaignID), wallet.key, wallet.address)
+	if err != nil {
+		if _, err := matcher.store.ReleaseMatchingPoolFunds(ctx, db.ReleaseMatchingPoolFundsParams{Amount: amount.String(), ID: pool.ID}); err != nil {
+			return pool, err
+		}
+		if err := matcher.store.DeleteMatchingPoolMatch(ctx, claim.ID); err != nil {
+			return pool, err
+		}
+		return pool, fmt.Errorf("cannot send match for donation %d: %w", donation.ID, err)
+	}
+
+	if _, err := matcher.store.MarkMatchingPoolMatchSent(ctx, db.MarkMatchingPoolMatchSentParams{
+		ID:     claim.ID,
+		TxHash: sql.NullString{String: hash, Valid: true},
+	}); err != nil {
+		return reserved, err
+	}
+
+	indexer.RecordSentDonation(ctx, matcher.store, indexer.SentDonation{
+		ChainID:         pool.ChainID,
+		ContractVersion: pool.ContractVersion,
+		CampaignID:      donation.CampaignID,
+		DonorAddress:    pool.WalletAddress,
+		Amount:          amount,
+		Token:           wallet.chain.NativeSymbol,
+		TxHash:          hash,
+	})
+
+	utils.NewRedisCache().InvalidateAllCampaignCaches()
+
+	logger.Info().Str("tx_hash", hash).Str("amount", amount.String()).Msg("donation matched")
+	return reserved, nil
+}
+
+// checkBalance reports whether the pool wallet can cover a match of amount wei
+func checkBalance(wallet *poolWallet, amount *big.Int) error {
+	balance, err := wallet.chain.GetBalance(wallet.address)
+	if err != nil {
+		return err
+	}
+	bal, err := strconv.ParseFloat(balance, 64)
+	if err != nil {
+		return err
+	}
+	if utils.WeiToEther(amount.String()) > bal {
+		return errInsufficientBalance
+	}
+	return nil
+}
+
+// matchAmount is the pool's ratio of a donation of amount wei, limited by its per-donation maximum
+// and what is left of its cap
+func matchAmount(pool db.MatchingPools, amount string) *big.Int {
+	match, ok := new(big.Int).SetString(amount, 10)
+	if !ok || match.Sign() <= 0 {
+		return new(big.Int)
+	}
+	match.Mul(match, big.NewInt(int64(pool.RatioPercent)))
+	match.Quo(match, big.NewInt(100))
+
+	if pool.MaxMatch.Valid {
+		if max, ok := new(big.Int).SetString(pool.MaxMatch.String, 10); ok && match.Cmp(max) > 0 {
+			match = max
+		}
+	}
+
+	if remaining := Remaining(pool); match.Cmp(remaining) > 0 {
+		match = remaining
+	}
+	return match
+}
+
+// Remaining is what is left of a pool's cap in wei
+func Remaining(pool db.MatchingPools) *big.Int {
+	limit, _ := new(big.Int).SetString(pool.Cap, 10)
+	matched, _ := new(big.Int).SetString(pool.MatchedTotal, 10)
+	if limit == nil {
+		return new(big.Int)
+	}
+	if matched != nil {
+		limit.Sub(limit, matched)
+	}
+	if limit.Sign() < 0 {
+		return new(big.Int)
+	}
+	return limit
+}
+
+// skipReason reports why a donation to campaign is not matched at t, or "" when it is: a campaign
+// that has ended or reached its goal no longer needs the funds
+func skipReason(campaign db.IndexedCampaigns, t time.Time) string {
+	if !t.Before(campaign.Deadline) {
+		return "the campaign has ended"
+	}
+	goal, _ := new(big.Int).SetString(campaign.Goal, 10)
+	raised, _ := new(big.Int).SetString(campaign.TotalFunds, 10)
+	if goal != nil && raised != nil && goal.Sign() > 0 && raised.Cmp(goal) >= 0 {
+		return "the campaign has reached its goal"
+	}
+	return ""
+}
@@ -0,0 +1,133 @@
+package matching
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchAmount(t *testing.T) {
+	pool := db.MatchingPools{RatioPercent: 100, Cap: "1000", MatchedTotal: "0"}
+
+	testCases := []struct {
+		name   string
+		pool   func(pool db.MatchingPools) db.MatchingPools
+		amount string
+		match  string
+	}{
+		{name: "OneToOne", amount: "300", match: "300"},
+		{name: "Half", pool: func(pool db.MatchingPools) db.MatchingPools { pool.RatioPercent = 50; return pool }, amount: "301", match: "150"},
+		{name: "Double", pool: func(pool db.MatchingPools) db.MatchingPools { pool.RatioPercent = 200; return pool }, amount: "300", match: "600"},
+		{name: "MaxMatch", pool: func(pool db.MatchingPools) db.MatchingPools {
+			pool.MaxMatch = sql.NullString{String: "100", Valid: true}
+			return pool
+		}, amount: "300", match: "100"},
+		{name: "Remaining", pool: func(pool db.MatchingPools) db.MatchingPools { pool.MatchedTotal = "900"; return pool }, amount: "300", match: "100"},
+		{name: "CapReached", pool: func(pool db.MatchingPools) db.MatchingPools { pool.MatchedTotal = "1000"; return pool }, amount: "300", match: "0"},
+		{name: "Invalid", amount: "", match: "0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := pool
+			if tc.pool != nil {
+				p = tc.pool(p)
+			}
+			require.Equal(t, tc.match, matchAmount(p, tc.amount).String())
+		})
+	}
+}
+
+func TestSkipReason(t *testing.T) {
+	now := time.Now()
+
+	require.Empty(t, skipReason(db.IndexedCampaigns{Goal: "2000", TotalFunds: "1000", Deadline: now.Add(time.Hour)}, now))
+	require.NotEmpty(t, skipReason(db.IndexedCampaigns{Goal: "2000", TotalFunds: "1000", Deadline: now}, now))
+	require.NotEmpty(t, skipReason(db.IndexedCampaigns{Goal: "2000", TotalFunds: "2000", Deadline: now.Add(time.Hour)}, now))
+}
+
+func TestRunOnce(t *testing.T) {
+	// no chain is configured with this ID, so every match that has to be sent fails
+	pool := db.MatchingPools{ID: 4, ChainID: 999, ContractVersion: 1, RatioPercent: 100, Cap: "5000000000000000000", MatchedTotal: "0", Status: interfaces.MatchingPoolActive}
+	donation := db.DonationRecords{ID: 11, ChainID: 999, ContractVersion: 1, CampaignID: 7, Amount: "1000000000000000", Status: "confirmed"}
+	campaign := db.IndexedCampaigns{CampaignID: 7, Goal: "2000000000000000000", TotalFunds: "0", Deadline: time.Now().Add(30 * 24 * time.Hour)}
+
+	testCases := []struct {
+		name       string
+		pool       db.MatchingPools
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name: "Ended",
+			pool: func() db.MatchingPools {
+				ended := pool
+				ended.EndsAt = sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}
+				return ended
+			}(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ExhaustMatchingPool(gomock.Any(), gomock.Eq(pool.ID)).Times(1).Return(db.MatchingPools{}, nil)
+				store.EXPECT().ListMatchableDonations(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "CampaignEnded",
+			pool: pool,
+			buildStubs: func(store *mockdb.MockStore) {
+				ended := campaign
+				ended.Deadline = time.Now().Add(-time.Hour)
+
+				store.EXPECT().ListMatchableDonations(gomock.Any(), gomock.Eq(db.ListMatchableDonationsParams{PoolID: pool.ID, Limit: batchSize})).Times(1).Return([]db.DonationRecords{donation}, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(ended, nil)
+				store.EXPECT().
+					ClaimMatchingPoolMatch(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ClaimMatchingPoolMatchParams) (db.MatchingPoolMatches, error) {
+						require.Equal(t, donation.ID, arg.DonationRecordID)
+						require.Equal(t, interfaces.MatchSkipped, arg.Status)
+						require.Equal(t, "0", arg.Amount)
+						require.True(t, arg.Reason.Valid)
+						return db.MatchingPoolMatches{}, nil
+					})
+				store.EXPECT().ReserveMatchingPoolFunds(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "SendFails",
+			pool: pool,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListMatchableDonations(gomock.Any(), gomock.Any()).Times(1).Return([]db.DonationRecords{donation, donation}, nil)
+				// the pool stops at the first donation it cannot match
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(campaign, nil)
+				store.EXPECT().ClaimMatchingPoolMatch(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReserveMatchingPoolFunds(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().ListActiveMatchingPools(gomock.Any()).Times(1).Return([]db.MatchingPools{tc.pool}, nil)
+			store.EXPECT().CreateDonationRecord(gomock.Any(), gomock.Any()).Times(0)
+			tc.buildStubs(store)
+
+			config := utils.Config{CryptoDeployURL: "https://sepolia.example.com"}
+			chains, err := defi.LoadChainRegistry(config)
+			require.NoError(t, err)
+
+			require.NoError(t, NewMatcher(store, chains, config).RunOnce(context.Background()))
+		})
+	}
+}
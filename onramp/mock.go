@@ -0,0 +1,103 @@
+package onramp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+)
+
+const (
+	// MockName selects MockProvider
+	MockName = "mock"
+	// MockSignatureHeader carries the hex HMAC-SHA256 of a mock webhook's body
+	MockSignatureHeader = "X-Mock-Signature"
+)
+
+// MockWebhook is the body of a MockProvider webhook
+type MockWebhook struct {
+	OrderID      string `json:"order_id"`
+	Status       string `json:"status"`
+	CryptoAmount string `json:"crypto_amount"`
+	TxHash       string `json:"tx_hash"`
+}
+
+// MockProvider is a local stand-in for an on-ramp, for tests and development. It takes no payment and
+// delivers nothing: its orders complete when a webhook signed with its secret says so, see SignWebhook.
+type MockProvider struct {
+	secret []byte
+
+	mu     sync.Mutex
+	orders map[string]OrderRequest
+}
+
+// NewMockProvider creates a mock provider whose webhooks are signed with secret
+func NewMockProvider(secret string) *MockProvider {
+	return &MockProvider{secret: []byte(secret), orders: make(map[string]OrderRequest)}
+}
+
+func (provider *MockProvider) Name() string {
+	return MockName
+}
+
+func (provider *MockProvider) CreateOrder(ctx context.Context, request OrderRequest) (Order, error) {
+	id := "mock-" + request.Reference
+
+	provider.mu.Lock()
+	provider.orders[id] = request
+	provider.mu.Unlock()
+
+	return Order{ID: id, CheckoutURL: "https://checkout.mock.invalid/orders/" + id}, nil
+}
+
+// Order returns the request an order was created from
+func (provider *MockProvider) Order(id string) (OrderRequest, bool) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	request, ok := provider.orders[id]
+	return request, ok
+}
+
+// SignWebhook returns the signature header value of a webhook body
+func (provider *MockProvider) SignWebhook(body []byte) string {
+	return hex.EncodeToString(provider.sign(body))
+}
+
+func (provider *MockProvider) sign(body []byte) []byte {
+	mac := hmac.New(sha256.New, provider.secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+func (provider *MockProvider) VerifyWebhook(header http.Header, body []byte) (Event, error) {
+	signature, err := hex.DecodeString(header.Get(MockSignatureHeader))
+	if err != nil || !hmac.Equal(signature, provider.sign(body)) {
+		return Event{}, ErrInvalidSignature
+	}
+
+	var webhook MockWebhook
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		return Event{}, fmt.Errorf("invalid mock webhook: %w", err)
+	}
+
+	event := Event{OrderID: webhook.OrderID, Status: webhook.Status, TxHash: webhook.TxHash}
+	switch webhook.Status {
+	case StatusPending, StatusFailed:
+	case StatusCompleted:
+		amount, ok := new(big.Int).SetString(webhook.CryptoAmount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return Event{}, fmt.Errorf("invalid crypto amount %q", webhook.CryptoAmount)
+		}
+		event.CryptoAmount = amount
+	default:
+		return Event{}, fmt.Errorf("unknown order status %q", webhook.Status)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,80 @@
+package onramp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/demola234/defiraise/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	_, err := NewProvider(utils.Config{})
+	require.ErrorIs(t, err, ErrNoProvider)
+
+	_, err = NewProvider(utils.Config{OnRampProvider: "mock"})
+	require.Error(t, err)
+
+	_, err = NewProvider(utils.Config{OnRampProvider: "acme", OnRampWebhookSecret: "secret"})
+	require.Error(t, err)
+
+	provider, err := NewProvider(utils.Config{OnRampProvider: " Mock ", OnRampWebhookSecret: "secret"})
+	require.NoError(t, err)
+	require.Equal(t, MockName, provider.Name())
+}
+
+func TestMockProvider(t *testing.T) {
+	provider := NewMockProvider("secret")
+
+	order, err := provider.CreateOrder(context.Background(), OrderRequest{Reference: "7", Address: "0xabc", FiatAmount: "50.00", FiatCurrency: "USD"})
+	require.NoError(t, err)
+	require.Equal(t, "mock-7", order.ID)
+	require.NotEmpty(t, order.CheckoutURL)
+	request, ok := provider.Order(order.ID)
+	require.True(t, ok)
+	require.Equal(t, "0xabc", request.Address)
+
+	sign := func(webhook MockWebhook) (http.Header, []byte) {
+		body, err := json.Marshal(webhook)
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set(MockSignatureHeader, provider.SignWebhook(body))
+		return header, body
+	}
+
+	header, body := sign(MockWebhook{OrderID: order.ID, Status: StatusCompleted, CryptoAmount: "20000000000000000", TxHash: "0xdelivery"})
+	event, err := provider.VerifyWebhook(header, body)
+	require.NoError(t, err)
+	require.Equal(t, order.ID, event.OrderID)
+	require.Equal(t, StatusCompleted, event.Status)
+	require.Equal(t, "20000000000000000", event.CryptoAmount.String())
+
+	// a body changed after signing
+	_, err = provider.VerifyWebhook(header, append(body, ' '))
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	// signed with another secret
+	other := http.Header{}
+	other.Set(MockSignatureHeader, NewMockProvider("other").SignWebhook(body))
+	_, err = provider.VerifyWebhook(other, body)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = provider.VerifyWebhook(http.Header{}, body)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	header, body = sign(MockWebhook{OrderID: order.ID, Status: StatusCompleted})
+	_, err = provider.VerifyWebhook(header, body)
+	require.Error(t, err)
+
+	header, body = sign(MockWebhook{OrderID: order.ID, Status: "refunded"})
+	_, err = provider.VerifyWebhook(header, body)
+	require.Error(t, err)
+
+	header, body = sign(MockWebhook{OrderID: order.ID, Status: StatusFailed})
+	event, err = provider.VerifyWebhook(header, body)
+	require.NoError(t, err)
+	require.Equal(t, StatusFailed, event.Status)
+	require.Nil(t, event.CryptoAmount)
+}
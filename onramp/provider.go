@@ -0,0 +1,82 @@
+package onramp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/demola234/defiraise/utils"
+)
+
+// The statuses of an order, as reported by providers and stored on onramp_orders
+const (
+	StatusPending   = "pending"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+)
+
+var (
+	// ErrNoProvider is returned by NewProvider when ONRAMP_PROVIDER is not set
+	ErrNoProvider = errors.New("ONRAMP_PROVIDER is not set")
+	// ErrInvalidSignature is returned by VerifyWebhook for a webhook the provider did not sign
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// OrderRequest is a purchase of crypto with fiat money, delivered to Address
+type OrderRequest struct {
+	// Reference identifies the order on our side
+	Reference    string
+	ChainID      int64
+	Address      string
+	Asset        string
+	FiatAmount   string
+	FiatCurrency string
+	Email        string
+}
+
+// Order is an order created by a provider, paid for by the user at CheckoutURL
+type Order struct {
+	ID          string
+	CheckoutURL string
+}
+
+// Event is a verified webhook about the progress of an order
+type Event struct {
+	OrderID string
+	Status  string
+	// CryptoAmount is what was delivered to the order's address in base units, set once the order completed
+	CryptoAmount *big.Int
+	TxHash       string
+}
+
+// OnRampProvider sells crypto for fiat money and delivers it to an address, so users without crypto
+// can fund their custodial wallet
+type OnRampProvider interface {
+	// Name identifies the provider in logs and stored orders
+	Name() string
+	// CreateOrder creates an order the user pays for at its checkout URL
+	CreateOrder(ctx context.Context, request OrderRequest) (Order, error)
+	// VerifyWebhook checks a webhook was sent by the provider and returns the event it reports
+	VerifyWebhook(header http.Header, body []byte) (Event, error)
+}
+
+// NewProvider creates the provider named by ONRAMP_PROVIDER, signing webhooks with ONRAMP_WEBHOOK_SECRET
+func NewProvider(configs utils.Config) (OnRampProvider, error) {
+	name := strings.ToLower(strings.TrimSpace(configs.OnRampProvider))
+	if name == "" {
+		return nil, ErrNoProvider
+	}
+	if configs.OnRampWebhookSecret == "" {
+		return nil, errors.New("ONRAMP_WEBHOOK_SECRET is not set")
+	}
+
+	switch name {
+	case MockName:
+		return NewMockProvider(configs.OnRampWebhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown on-ramp provider %q, expected %s", configs.OnRampProvider, MockName)
+	}
+}
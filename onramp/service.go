@@ -0,0 +1,385 @@
+package onramp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/indexer"
+	"github.com/demola234/defiraise/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// donateGas is the gas limit defi sets on donations. Its cost is kept back from a purchase that is
+	// donated, so the custodial wallet can pay for the donation.
+	donateGas = 3000000
+	// deliveryTimeout is how long the delivery of a completed order may take to be mined before the
+	// order's donation is given up
+	deliveryTimeout = 24 * time.Hour
+	// donationInterval is how often Start donates the purchases of completed orders
+	donationInterval = time.Minute
+	// donationBatchSize is the number of completed orders DonatePending reads at a time
+	donationBatchSize = 50
+)
+
+var (
+	// ErrInvalidWebhook is returned for a signed webhook whose event cannot be read
+	ErrInvalidWebhook = errors.New("invalid on-ramp webhook")
+	// ErrUnknownOrder is returned for a webhook about an order that was not created here
+	ErrUnknownOrder = errors.New("unknown on-ramp order")
+	// errPurchaseTooSmall is recorded when a purchase does not cover the gas of its donation
+	errPurchaseTooSmall = errors.New("the purchase does not cover the gas of the donation")
+	// errDeliveryPending leaves a donation for a later run while the purchase may still arrive
+	errDeliveryPending = errors.New("the purchase has not been delivered yet")
+	// errDonationClaimed skips a donation another run is already sending
+	errDonationClaimed = errors.New("the donation is already being sent")
+)
+
+// donationChain is the part of a chain purchased funds are donated on
+type donationChain interface {
+	GetTransactionStatus(hash string) (*defi.TransactionStatus, error)
+	NativeBalance(ctx context.Context, address string) (*big.Int, error)
+	GasPrice(ctx context.Context) (*big.Int, error)
+	DonateWei(wei *big.Int, id int, privateKey *ecdsa.PrivateKey, address string) (string, error)
+}
+
+// CreateOrderParams is a purchase a user starts
+type CreateOrderParams struct {
+	FiatAmount   float64
+	FiatCurrency string
+	// CampaignID is the campaign on the chain's current contract the purchase is donated to, or nil
+	// to keep it in the wallet
+	CampaignID *int64
+}
+
+// Service sells crypto to users through an on-ramp provider. Orders deliver the native currency
+// straight to the user's custodial wallet, and the provider's webhooks complete them. An order made
+// for a campaign donates what was purchased, less the gas of the donation, once its delivery is mined,
+// see DonatePending.
+type Service struct {
+	store      db.Store
+	chains     *defi.ChainRegistry
+	provider   OnRampProvider
+	passPhrase string
+}
+
+// NewService creates a service over the provider of ONRAMP_PROVIDER, returning ErrNoProvider when it
+// is not set
+func NewService(store db.Store, chains *defi.ChainRegistry, configs utils.Config) (*Service, error) {
+	provider, err := NewProvider(configs)
+	if err != nil {
+		return nil, err
+	}
+	return NewServiceWithProvider(store, chains, provider, configs), nil
+}
+
+// NewServiceWithProvider creates a service over provider
+func NewServiceWithProvider(store db.Store, chains *defi.ChainRegistry, provider OnRampProvider, configs utils.Config) *Service {
+	return &Service{
+		store:      store,
+		chains:     chains,
+		provider:   provider,
+		passPhrase: configs.PassPhase,
+	}
+}
+
+// Provider returns the name of the service's provider
+func (service *Service) Provider() string {
+	return service.provider.Name()
+}
+
+// CreateOrder records a purchase to the user's custodial wallet on a chain and creates it with the
+// provider. A purchase the provider rejects is recorded as failed.
+func (service *Service) CreateOrder(ctx context.Context, chain *defi.Chain, user db.Users, params CreateOrderParams) (db.OnrampOrders, error) {
+	fiatAmount := strconv.FormatFloat(params.FiatAmount, 'f', 2, 64)
+	campaignID := sql.NullInt64{}
+	if params.CampaignID != nil {
+		campaignID = sql.NullInt64{Int64: *params.CampaignID, Valid: true}
+	}
+
+	order, err := service.store.CreateOnRampOrder(ctx, db.CreateOnRampOrderParams{
+		Provider:        service.provider.Name(),
+		Username:        user.Username,
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		Address:         user.Address,
+		Asset:           chain.NativeSymbol,
+		FiatAmount:      fiatAmount,
+		FiatCurrency:    params.FiatCurrency,
+		CampaignID:      campaignID,
+	})
+	if err != nil {
+		return db.OnrampOrders{}, err
+	}
+
+	created, err := service.provider.CreateOrder(ctx, OrderRequest{
+		Reference:    strconv.FormatInt(order.ID, 10),
+		ChainID:      chain.ID,
+		Address:      user.Address,
+		Asset:        chain.NativeSymbol,
+		FiatAmount:   fiatAmount,
+		FiatCurrency: params.FiatCurrency,
+		Email:        user.Email,
+	})
+	if err != nil {
+		if err := service.store.FailOnRampOrder(ctx, order.ID); err != nil {
+			log.Error().Err(err).Int64("order_id", order.ID).Msg("cannot record failed on-ramp order")
+		}
+		return db.OnrampOrders{}, fmt.Errorf("%s cannot create the order: %w", service.provider.Name(), err)
+	}
+
+	return service.store.SetOnRampOrderCheckout(ctx, db.SetOnRampOrderCheckoutParams{
+		ID:              order.ID,
+		ProviderOrderID: sql.NullString{String: created.ID, Valid: true},
+		CheckoutURL:     created.CheckoutURL,
+	})
+}
+
+// HandleWebhook verifies a provider webhook and applies the event it reports to its order. Webhooks
+// may be delivered more than once; an order completes or fails only once.
+func (service *Service) HandleWebhook(ctx context.Context, header http.Header, body []byte) (db.OnrampOrders, error) {
+	event, err := service.provider.VerifyWebhook(header, body)
+	if errors.Is(err, ErrInvalidSignature) {
+		return db.OnrampOrders{}, err
+	}
+	if err != nil {
+		return db.OnrampOrders{}, fmt.Errorf("%w: %v", ErrInvalidWebhook, err)
+	}
+
+	order, err := service.store.GetOnRampOrderByProviderID(ctx, db.GetOnRampOrderByProviderIDParams{
+		Provider:        service.provider.Name(),
+		ProviderOrderID: sql.NullString{String: event.OrderID, Valid: true},
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.OnrampOrders{}, ErrUnknownOrder
+	}
+	if err != nil {
+		return db.OnrampOrders{}, err
+	}
+
+	switch event.Status {
+	case StatusFailed:
+		if err := service.store.FailOnRampOrder(ctx, order.ID); err != nil {
+			return order, err
+		}
+		if order.Status == StatusPending {
+			order.Status = StatusFailed
+		}
+		return order, nil
+	case StatusCompleted:
+		completed, err := service.store.CompleteOnRampOrder(ctx, db.CompleteOnRampOrderParams{
+			ID:           order.ID,
+			CryptoAmount: sql.NullString{String: event.CryptoAmount.String(), Valid: true},
+			TxHash:       sql.NullString{String: event.TxHash, Valid: event.TxHash != ""},
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			// a redelivered webhook, or one about an order that already failed
+			return order, nil
+		}
+		if err != nil {
+			return order, err
+		}
+
+		log.Info().Int64("order_id", completed.ID).Str("username", completed.Username).
+			Str("amount", event.CryptoAmount.String()).Msg("on-ramp order completed")
+		return completed, nil
+	default:
+		return order, nil
+	}
+}
+
+// Start donates the purchases of completed orders straight away and then every donationInterval
+// until ctx is cancelled
+func (service *Service) Start(ctx context.Context) {
+	ticker := time.NewTicker(donationInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := service.DonatePending(ctx); err != nil {
+			log.Error().Err(err).Msg("cannot donate on-ramp purchases")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DonatePending donates the purchase of every completed order made for a campaign whose delivery is
+// mined and has reached the user's wallet. The webhook alone is not trusted to say the funds arrived.
+// Orders whose delivery is not mined yet are checked again on the next run.
+func (service *Service) DonatePending(ctx context.Context) error {
+	afterID := int64(0)
+	for {
+		orders, err := service.store.ListPendingOnRampDonations(ctx, db.ListPendingOnRampDonationsParams{
+			AfterID: afterID,
+			Limit:   donationBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, order := range orders {
+			service.donate(ctx, order)
+		}
+
+		if len(orders) < donationBatchSize {
+			return nil
+		}
+		afterID = orders[len(orders)-1].ID
+	}
+}
+
+// donate sends a completed order's purchase to its campaign and records the outcome on the order.
+// A donation that cannot be sent leaves the purchase in the user's wallet.
+func (service *Service) donate(ctx context.Context, order db.OnrampOrders) {
+	logger := log.With().Int64("order_id", order.ID).Int64("chain_id", order.ChainID).
+		Int64("campaign_id", order.CampaignID.Int64).Logger()
+
+	hash, err := service.sendDonation(ctx, order)
+	if errors.Is(err, errDeliveryPending) || errors.Is(err, errDonationClaimed) {
+		logger.Debug().Err(err).Msg("on-ramp purchase not donated yet")
+		return
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot donate on-ramp purchase")
+		order.DonationStatus = sql.NullString{String: StatusFailed, Valid: true}
+	} else {
+		utils.NewRedisCache().InvalidateAllCampaignCaches()
+		logger.Info().Str("tx_hash", hash).Msg("on-ramp purchase donated")
+		order.DonationStatus = sql.NullString{String: "sent", Valid: true}
+		order.DonationTxHash = sql.NullString{String: hash, Valid: true}
+	}
+
+	err = service.store.UpdateOnRampDonation(ctx, db.UpdateOnRampDonationParams{
+		ID:             order.ID,
+		DonationStatus: order.DonationStatus,
+		DonationTxHash: order.DonationTxHash,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot record on-ramp donation")
+	}
+}
+
+func (service *Service) sendDonation(ctx context.Context, order db.OnrampOrders) (string, error) {
+	chain, err := service.chains.Get(order.ChainID)
+	if err == nil {
+		chain, err = chain.At(int(order.ContractVersion))
+	}
+	if err != nil {
+		return "", err
+	}
+	user, err := service.store.GetUser(ctx, order.Username)
+	if err != nil {
+		return "", err
+	}
+
+	return service.donateOn(ctx, chain, order, user, func() (*ecdsa.PrivateKey, string, error) {
+		return defi.DecryptPrivateKey(user.FilePath, service.passPhrase)
+	})
+}
+
+// donateOn checks the purchase has been delivered and the order's campaign is still open, then claims
+// the donation and donates the purchase less the donation's gas
+func (service *Service) donateOn(ctx context.Context, chain donationChain, order db.OnrampOrders, user db.Users, key func() (*ecdsa.PrivateKey, string, error)) (string, error) {
+	purchased, ok := new(big.Int).SetString(order.CryptoAmount.String, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid purchased amount %q", order.CryptoAmount.String)
+	}
+	if err := checkDelivery(ctx, chain, order, purchased); err != nil {
+		return "", err
+	}
+
+	campaign, err := service.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         order.ChainID,
+		ContractVersion: order.ContractVersion,
+		CampaignID:      order.CampaignID.Int64,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !time.Now().Before(campaign.Deadline) {
+		return "", errors.New("campaign has closed")
+	}
+
+	gasPrice, err := chain.GasPrice(ctx)
+	if err != nil {
+		return "", err
+	}
+	amount := purchased.Sub(purchased, new(big.Int).Mul(gasPrice, big.NewInt(donateGas)))
+	if amount.Sign() <= 0 {
+		return "", errPurchaseTooSmall
+	}
+
+	privateKey, address, err := key()
+	if err != nil {
+		return "", err
+	}
+
+	// a donation left 'sending' by a run that stopped before recording it is not sent again
+	_, err = service.store.ClaimOnRampDonation(ctx, order.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errDonationClaimed
+	}
+	if err != nil {
+		return "", err
+	}
+	hash, err := chain.DonateWei(amount, int(order.CampaignID.Int64), privateKey, address)
+	if err != nil {
+		return "", err
+	}
+
+	indexer.RecordSentDonation(ctx, service.store, indexer.SentDonation{
+		ChainID:         order.ChainID,
+		ContractVersion: order.ContractVersion,
+		CampaignID:      order.CampaignID.Int64,
+		DonorAddress:    address,
+		Amount:          amount,
+		Token:           order.Asset,
+		TxHash:          hash,
+	})
+
+	return hash, nil
+}
+
+// checkDelivery returns nil once the order's delivery is mined and the wallet holds the purchase,
+// errDeliveryPending while the purchase may still arrive, or why it never will
+func checkDelivery(ctx context.Context, chain donationChain, order db.OnrampOrders, purchased *big.Int) error {
+	if !order.TxHash.Valid {
+		return errors.New("the order has no delivery transaction")
+	}
+
+	status, err := chain.GetTransactionStatus(order.TxHash.String)
+	if errors.Is(err, defi.ErrTransactionPending) {
+		if order.CompletedAt.Valid && time.Since(order.CompletedAt.Time) > deliveryTimeout {
+			return errors.New("the delivery was not mined in time")
+		}
+		return errDeliveryPending
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", errDeliveryPending, err)
+	}
+	if !status.Success {
+		return errors.New("the delivery failed")
+	}
+
+	balance, err := chain.NativeBalance(ctx, order.Address)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errDeliveryPending, err)
+	}
+	if balance.Cmp(purchased) < 0 {
+		return errors.New("the wallet does not hold the purchase")
+	}
+	return nil
+}
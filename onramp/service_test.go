@@ -0,0 +1,445 @@
+package onramp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChain struct {
+	delivery *defi.TransactionStatus
+	balance  *big.Int
+	gasPrice *big.Int
+	donated  []*big.Int
+}
+
+func (chain *fakeChain) GetTransactionStatus(hash string) (*defi.TransactionStatus, error) {
+	if chain.delivery == nil {
+		return nil, defi.ErrTransactionPending
+	}
+	return chain.delivery, nil
+}
+
+func (chain *fakeChain) NativeBalance(ctx context.Context, address string) (*big.Int, error) {
+	return chain.balance, nil
+}
+
+func (chain *fakeChain) GasPrice(ctx context.Context) (*big.Int, error) {
+	return chain.gasPrice, nil
+}
+
+func (chain *fakeChain) DonateWei(wei *big.Int, id int, privateKey *ecdsa.PrivateKey, address string) (string, error) {
+	chain.donated = append(chain.donated, wei)
+	return "0xdonation", nil
+}
+
+func TestCreateOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	provider := NewMockProvider("secret")
+	service := NewServiceWithProvider(store, nil, provider, utils.Config{})
+
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001", Email: "donor@example.com"}
+	chain := &defi.Chain{ID: defi.SepoliaChainID, NativeSymbol: "ETH", Version: 1}
+	campaignID := int64(4)
+
+	store.EXPECT().
+		CreateOnRampOrder(gomock.Any(), gomock.Eq(db.CreateOnRampOrderParams{
+			Provider:        MockName,
+			Username:        user.Username,
+			ChainID:         chain.ID,
+			ContractVersion: 1,
+			Address:         user.Address,
+			Asset:           "ETH",
+			FiatAmount:      "25.50",
+			FiatCurrency:    "EUR",
+			CampaignID:      sql.NullInt64{Int64: campaignID, Valid: true},
+		})).
+		Times(1).
+		Return(db.OnrampOrders{ID: 9, Provider: MockName, Status: StatusPending}, nil)
+	store.EXPECT().
+		SetOnRampOrderCheckout(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ interface{}, arg db.SetOnRampOrderCheckoutParams) (db.OnrampOrders, error) {
+			require.Equal(t, int64(9), arg.ID)
+			require.Equal(t, "mock-9", arg.ProviderOrderID.String)
+			return db.OnrampOrders{ID: 9, ProviderOrderID: arg.ProviderOrderID, CheckoutURL: arg.CheckoutURL, Status: StatusPending}, nil
+		})
+
+	order, err := service.CreateOrder(context.Background(), chain, user, CreateOrderParams{FiatAmount: 25.5, FiatCurrency: "EUR", CampaignID: &campaignID})
+	require.NoError(t, err)
+	require.NotEmpty(t, order.CheckoutURL)
+
+	request, ok := provider.Order("mock-9")
+	require.True(t, ok)
+	require.Equal(t, user.Address, request.Address)
+	require.Equal(t, "9", request.Reference)
+}
+
+func TestHandleWebhook(t *testing.T) {
+	provider := NewMockProvider("secret")
+	pending := db.OnrampOrders{
+		ID:              3,
+		Provider:        MockName,
+		ProviderOrderID: sql.NullString{String: "mock-3", Valid: true},
+		Username:        "alice",
+		Status:          StatusPending,
+	}
+	amount := "20000000000000000"
+
+	signed := func(webhook MockWebhook) (http.Header, []byte) {
+		body, err := json.Marshal(webhook)
+		require.NoError(t, err)
+		header := http.Header{}
+		header.Set(MockSignatureHeader, provider.SignWebhook(body))
+		return header, body
+	}
+
+	testCases := []struct {
+		name       string
+		webhook    MockWebhook
+		tamper     bool
+		buildStubs func(store *mockdb.MockStore)
+		check      func(t *testing.T, order db.OnrampOrders, err error)
+	}{
+		{
+			name:    "Completed",
+			webhook: MockWebhook{OrderID: "mock-3", Status: StatusCompleted, CryptoAmount: amount, TxHash: "0xdelivery"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(1).Return(pending, nil)
+				store.EXPECT().
+					CompleteOnRampOrder(gomock.Any(), gomock.Eq(db.CompleteOnRampOrderParams{
+						ID:           pending.ID,
+						CryptoAmount: sql.NullString{String: amount, Valid: true},
+						TxHash:       sql.NullString{String: "0xdelivery", Valid: true},
+					})).
+					Times(1).
+					Return(db.OnrampOrders{ID: pending.ID, Status: StatusCompleted, CryptoAmount: sql.NullString{String: amount, Valid: true}}, nil)
+				store.EXPECT().UpdateOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, order db.OnrampOrders, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusCompleted, order.Status)
+			},
+		},
+		{
+			// the webhook only completes the order: the purchase is donated once its delivery is mined
+			name:    "CompletedForCampaign",
+			webhook: MockWebhook{OrderID: "mock-3", Status: StatusCompleted, CryptoAmount: amount, TxHash: "0xdelivery"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(1).Return(pending, nil)
+				store.EXPECT().
+					CompleteOnRampOrder(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.OnrampOrders{
+						ID:             pending.ID,
+						Status:         StatusCompleted,
+						CampaignID:     sql.NullInt64{Int64: 4, Valid: true},
+						CryptoAmount:   sql.NullString{String: amount, Valid: true},
+						DonationStatus: sql.NullString{String: StatusPending, Valid: true},
+					}, nil)
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, order db.OnrampOrders, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusPending, order.DonationStatus.String)
+			},
+		},
+		{
+			name:    "Redelivered",
+			webhook: MockWebhook{OrderID: "mock-3", Status: StatusCompleted, CryptoAmount: amount},
+			buildStubs: func(store *mockdb.MockStore) {
+				completed := pending
+				completed.Status = StatusCompleted
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(1).Return(completed, nil)
+				store.EXPECT().CompleteOnRampOrder(gomock.Any(), gomock.Any()).Times(1).Return(db.OnrampOrders{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, order db.OnrampOrders, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusCompleted, order.Status)
+			},
+		},
+		{
+			name:    "Failed",
+			webhook: MockWebhook{OrderID: "mock-3", Status: StatusFailed},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(1).Return(pending, nil)
+				store.EXPECT().FailOnRampOrder(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, order db.OnrampOrders, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StatusFailed, order.Status)
+			},
+		},
+		{
+			name:    "UnknownOrder",
+			webhook: MockWebhook{OrderID: "mock-404", Status: StatusFailed},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(1).Return(db.OnrampOrders{}, sql.ErrNoRows)
+			},
+			check: func(t *testing.T, order db.OnrampOrders, err error) {
+				require.ErrorIs(t, err, ErrUnknownOrder)
+			},
+		},
+		{
+			name:    "InvalidSignature",
+			webhook: MockWebhook{OrderID: "mock-3", Status: StatusCompleted, CryptoAmount: amount},
+			tamper:  true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, order db.OnrampOrders, err error) {
+				require.ErrorIs(t, err, ErrInvalidSignature)
+			},
+		},
+		{
+			name:    "InvalidWebhook",
+			webhook: MockWebhook{OrderID: "mock-3", Status: StatusCompleted, CryptoAmount: "-1"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOnRampOrderByProviderID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, order db.OnrampOrders, err error) {
+				require.ErrorIs(t, err, ErrInvalidWebhook)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			service := NewServiceWithProvider(store, nil, provider, utils.Config{})
+
+			header, body := signed(tc.webhook)
+			if tc.tamper {
+				body = append(body, ' ')
+			}
+			order, err := service.HandleWebhook(context.Background(), header, body)
+			tc.check(t, order, err)
+		})
+	}
+}
+
+func TestDonateOn(t *testing.T) {
+	user := db.Users{Username: "alice", Address: "0xabc0000000000000000000000000000000000001"}
+	// 0.02 ether purchased and 3,000,000 gas at 1 gwei kept back
+	order := db.OnrampOrders{
+		ID:              3,
+		Username:        user.Username,
+		ChainID:         defi.SepoliaChainID,
+		ContractVersion: 1,
+		Asset:           "ETH",
+		Address:         user.Address,
+		CampaignID:      sql.NullInt64{Int64: 4, Valid: true},
+		CryptoAmount:    sql.NullString{String: "20000000000000000", Valid: true},
+		TxHash:          sql.NullString{String: "0xdelivery", Valid: true},
+		CompletedAt:     sql.NullTime{Time: time.Now(), Valid: true},
+	}
+	delivered := &defi.TransactionStatus{Success: true}
+	open := db.IndexedCampaigns{CampaignID: 4, Deadline: time.Now().Add(24 * time.Hour)}
+	key := func() (*ecdsa.PrivateKey, string, error) {
+		return nil, user.Address, nil
+	}
+
+	testCases := []struct {
+		name       string
+		order      func(order db.OnrampOrders) db.OnrampOrders
+		delivery   *defi.TransactionStatus
+		balance    int64
+		gasPrice   int64
+		buildStubs func(store *mockdb.MockStore)
+		check      func(t *testing.T, chain *fakeChain, hash string, err error)
+	}{
+		{
+			name:     "OK",
+			delivery: delivered,
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(open, nil)
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Eq(order.ID)).Times(1).Return(order, nil)
+				store.EXPECT().
+					CreateDonationRecord(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateDonationRecordParams) (db.DonationRecords, error) {
+						require.Equal(t, "17000000000000000", arg.Amount)
+						require.Equal(t, user.Address, arg.DonorAddress)
+						return db.DonationRecords{}, nil
+					})
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "0xdonation", hash)
+				require.Equal(t, []*big.Int{big.NewInt(17000000000000000)}, chain.donated)
+			},
+		},
+		{
+			name:     "DeliveryPending",
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.ErrorIs(t, err, errDeliveryPending)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name: "DeliveryTimedOut",
+			order: func(order db.OnrampOrders) db.OnrampOrders {
+				order.CompletedAt = sql.NullTime{Time: time.Now().Add(-deliveryTimeout - time.Hour), Valid: true}
+				return order
+			},
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.Error(t, err)
+				require.NotErrorIs(t, err, errDeliveryPending)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name:     "DeliveryFailed",
+			delivery: &defi.TransactionStatus{Success: false},
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.Error(t, err)
+				require.NotErrorIs(t, err, errDeliveryPending)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name: "NoDeliveryTx",
+			order: func(order db.OnrampOrders) db.OnrampOrders {
+				order.TxHash = sql.NullString{}
+				return order
+			},
+			delivery: delivered,
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.Error(t, err)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name:     "BalanceShort",
+			delivery: delivered,
+			balance:  10000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.Error(t, err)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name:     "AlreadyClaimed",
+			delivery: delivered,
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(open, nil)
+				store.EXPECT().ClaimOnRampDonation(gomock.Any(), gomock.Any()).Times(1).Return(db.OnrampOrders{}, sql.ErrNoRows)
+				store.EXPECT().CreateDonationRecord(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.ErrorIs(t, err, errDonationClaimed)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name:     "PurchaseTooSmall",
+			delivery: delivered,
+			balance:  20000000000000000,
+			gasPrice: 10000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(open, nil)
+				store.EXPECT().CreateDonationRecord(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.ErrorIs(t, err, errPurchaseTooSmall)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name:     "CampaignClosed",
+			delivery: delivered,
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(db.IndexedCampaigns{Deadline: time.Now().Add(-time.Hour)}, nil)
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.Error(t, err)
+				require.Empty(t, chain.donated)
+			},
+		},
+		{
+			name:     "CampaignNotFound",
+			delivery: delivered,
+			balance:  20000000000000000,
+			gasPrice: 1000000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(db.IndexedCampaigns{}, errors.New("no rows"))
+			},
+			check: func(t *testing.T, chain *fakeChain, hash string, err error) {
+				require.Error(t, err)
+				require.Empty(t, chain.donated)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			service := NewServiceWithProvider(store, nil, NewMockProvider("secret"), utils.Config{})
+
+			order := order
+			if tc.order != nil {
+				order = tc.order(order)
+			}
+			chain := &fakeChain{delivery: tc.delivery, balance: big.NewInt(tc.balance), gasPrice: big.NewInt(tc.gasPrice)}
+			hash, err := service.donateOn(context.Background(), chain, order, user, key)
+			tc.check(t, chain, hash, err)
+		})
+	}
+}
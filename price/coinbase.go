@@ -0,0 +1,65 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// CoinbaseBaseURL is the public Coinbase API
+const CoinbaseBaseURL = "https://api.coinbase.com"
+
+// CoinbaseSource reads spot prices from Coinbase
+type CoinbaseSource struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewCoinbaseSource creates a Coinbase source. An empty baseURL uses CoinbaseBaseURL.
+func NewCoinbaseSource(baseURL string, client *http.Client) *CoinbaseSource {
+	if baseURL == "" {
+		baseURL = CoinbaseBaseURL
+	}
+	if client == nil {
+		client = NewHTTPClient()
+	}
+
+	return &CoinbaseSource{baseURL: baseURL, client: client}
+}
+
+func (source *CoinbaseSource) Name() string {
+	return "coinbase"
+}
+
+func (source *CoinbaseSource) GetPrice(ctx context.Context, base string, currency string) (Quote, error) {
+	base, currency = normalize(base), normalize(currency)
+
+	var body struct {
+		Data struct {
+			Base     string `json:"base"`
+			Currency string `json:"currency"`
+			Amount   string `json:"amount"`
+		} `json:"data"`
+	}
+
+	url := fmt.Sprintf("%s/v2/prices/%s-%s/spot", source.baseURL, base, currency)
+	if err := getJSON(ctx, source.client, url, &body); err != nil {
+		return Quote{}, err
+	}
+
+	amount, err := strconv.ParseFloat(body.Data.Amount, 64)
+	if err != nil {
+		return Quote{}, fmt.Errorf("coinbase returned an invalid amount %q", body.Data.Amount)
+	}
+
+	// spot prices carry no timestamp, they are current as of the response
+	return Quote{
+		Base:      base,
+		Currency:  currency,
+		Price:     amount,
+		Source:    source.Name(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
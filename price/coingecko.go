@@ -0,0 +1,91 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CoinGeckoBaseURL is the public CoinGecko API
+const CoinGeckoBaseURL = "https://api.coingecko.com"
+
+// coinGeckoIDs maps token symbols to CoinGecko coin IDs
+var coinGeckoIDs = map[string]string{
+	"ETH":  "ethereum",
+	"WETH": "weth",
+	"USDC": "usd-coin",
+	"USDT": "tether",
+	"DAI":  "dai",
+	"LINK": "chainlink",
+	"WBTC": "wrapped-bitcoin",
+}
+
+// CoinGeckoSource reads simple prices from CoinGecko
+type CoinGeckoSource struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewCoinGeckoSource creates a CoinGecko source. An empty baseURL uses CoinGeckoBaseURL.
+func NewCoinGeckoSource(baseURL string, client *http.Client) *CoinGeckoSource {
+	if baseURL == "" {
+		baseURL = CoinGeckoBaseURL
+	}
+	if client == nil {
+		client = NewHTTPClient()
+	}
+
+	return &CoinGeckoSource{baseURL: baseURL, client: client}
+}
+
+func (source *CoinGeckoSource) Name() string {
+	return "coingecko"
+}
+
+func (source *CoinGeckoSource) GetPrice(ctx context.Context, base string, currency string) (Quote, error) {
+	base, currency = normalize(base), normalize(currency)
+
+	id, ok := coinGeckoIDs[base]
+	if !ok {
+		return Quote{}, ErrUnsupportedPair
+	}
+	vs := strings.ToLower(currency)
+
+	query := url.Values{}
+	query.Set("ids", id)
+	query.Set("vs_currencies", vs)
+	query.Set("include_last_updated_at", "true")
+
+	var body map[string]map[string]float64
+	if err := getJSON(ctx, source.client, source.baseURL+"/api/v3/simple/price?"+query.Encode(), &body); err != nil {
+		return Quote{}, err
+	}
+
+	prices, ok := body[id]
+	if !ok {
+		return Quote{}, ErrUnsupportedPair
+	}
+	amount, ok := prices[vs]
+	if !ok {
+		return Quote{}, ErrUnsupportedPair
+	}
+	if amount <= 0 {
+		return Quote{}, fmt.Errorf("coingecko returned an invalid price %v", amount)
+	}
+
+	updatedAt := time.Now()
+	if lastUpdated, ok := prices["last_updated_at"]; ok {
+		updatedAt = time.Unix(int64(lastUpdated), 0)
+	}
+
+	return Quote{
+		Base:      base,
+		Currency:  currency,
+		Price:     amount,
+		Source:    source.Name(),
+		UpdatedAt: updatedAt,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package price
+
+import (
+	"strings"
+
+	"github.com/demola234/defiraise/utils"
+)
+
+// DefaultSources are queried when PRICE_SOURCES is not configured
+const DefaultSources = "coinbase,coingecko,kraken"
+
+// NewOracleFromConfig creates an oracle over the configured sources, cached in Redis
+func NewOracleFromConfig(config utils.Config) (*Oracle, error) {
+	names := config.PriceSources
+	if strings.TrimSpace(names) == "" {
+		names = DefaultSources
+	}
+
+	providers, err := NewSources(strings.Split(names, ","), NewHTTPClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOracle(utils.NewRedisCache(), config.PriceMaxAge, config.PriceCacheTTL, providers...), nil
+}
@@ -0,0 +1,19 @@
+package price
+
+import "strings"
+
+// DefaultCurrency is used for users and campaigns that have not chosen a currency
+const DefaultCurrency = "USD"
+
+// FiatCurrencies are the currencies amounts can be displayed in. Every entry is quoted by at least one source.
+var FiatCurrencies = []string{"USD", "EUR", "GBP", "NGN", "CAD", "AUD", "JPY", "CHF", "ZAR"}
+
+// IsFiatCurrency reports whether code is a supported display currency
+func IsFiatCurrency(code string) bool {
+	for _, currency := range FiatCurrencies {
+		if strings.EqualFold(code, currency) {
+			return true
+		}
+	}
+	return false
+}
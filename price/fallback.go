@@ -0,0 +1,35 @@
+package price
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Fallback asks the primary provider first and the fallback provider only when the primary has no price
+type Fallback struct {
+	primary  PriceProvider
+	fallback PriceProvider
+}
+
+// NewFallback creates a provider that prefers primary over fallback
+func NewFallback(primary PriceProvider, fallback PriceProvider) *Fallback {
+	return &Fallback{primary: primary, fallback: fallback}
+}
+
+func (provider *Fallback) Name() string {
+	return provider.primary.Name()
+}
+
+func (provider *Fallback) GetPrice(ctx context.Context, base string, currency string) (Quote, error) {
+	quote, err := provider.primary.GetPrice(ctx, base, currency)
+	if err == nil {
+		return quote, nil
+	}
+	if !errors.Is(err, ErrUnsupportedPair) {
+		log.Warn().Err(err).Str("source", provider.primary.Name()).Str("pair", base+"/"+currency).Msg("primary price source failed, using fallback")
+	}
+
+	return provider.fallback.GetPrice(ctx, base, currency)
+}
@@ -0,0 +1,89 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KrakenBaseURL is the public Kraken API
+const KrakenBaseURL = "https://api.kraken.com"
+
+// krakenCurrencies are the fiat currencies Kraken lists pairs against
+var krakenCurrencies = map[string]bool{
+	"USD": true, "EUR": true, "GBP": true, "CAD": true, "JPY": true, "AUD": true, "CHF": true,
+}
+
+// KrakenSource reads the last trade price from Kraken's ticker
+type KrakenSource struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewKrakenSource creates a Kraken source. An empty baseURL uses KrakenBaseURL.
+func NewKrakenSource(baseURL string, client *http.Client) *KrakenSource {
+	if baseURL == "" {
+		baseURL = KrakenBaseURL
+	}
+	if client == nil {
+		client = NewHTTPClient()
+	}
+
+	return &KrakenSource{baseURL: baseURL, client: client}
+}
+
+func (source *KrakenSource) Name() string {
+	return "kraken"
+}
+
+func (source *KrakenSource) GetPrice(ctx context.Context, base string, currency string) (Quote, error) {
+	base, currency = normalize(base), normalize(currency)
+	if !krakenCurrencies[currency] {
+		return Quote{}, ErrUnsupportedPair
+	}
+
+	var body struct {
+		Error  []string `json:"error"`
+		Result map[string]struct {
+			// c is [price, lot volume] of the last trade
+			C []string `json:"c"`
+		} `json:"result"`
+	}
+
+	url := fmt.Sprintf("%s/0/public/Ticker?pair=%s%s", source.baseURL, base, currency)
+	if err := getJSON(ctx, source.client, url, &body); err != nil {
+		return Quote{}, err
+	}
+	if len(body.Error) > 0 {
+		if strings.Contains(strings.Join(body.Error, ","), "Unknown asset pair") {
+			return Quote{}, ErrUnsupportedPair
+		}
+		return Quote{}, errors.New("kraken: " + strings.Join(body.Error, ", "))
+	}
+
+	// Kraken answers with its own pair name (e.g. XETHZUSD), so take the only result
+	for _, ticker := range body.Result {
+		if len(ticker.C) == 0 {
+			break
+		}
+
+		amount, err := strconv.ParseFloat(ticker.C[0], 64)
+		if err != nil {
+			return Quote{}, fmt.Errorf("kraken returned an invalid price %q", ticker.C[0])
+		}
+
+		return Quote{
+			Base:      base,
+			Currency:  currency,
+			Price:     amount,
+			Source:    source.Name(),
+			UpdatedAt: time.Now(),
+		}, nil
+	}
+
+	return Quote{}, ErrUnsupportedPair
+}
@@ -0,0 +1,185 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultMaxAge is used when PRICE_MAX_AGE is not configured
+	DefaultMaxAge = 5 * time.Minute
+	// DefaultCacheTTL is used when PRICE_CACHE_TTL is not configured
+	DefaultCacheTTL = 30 * time.Second
+
+	// OracleSource is the source recorded on aggregated quotes
+	OracleSource = "median"
+)
+
+// Oracle queries every provider concurrently and returns the median of the fresh quotes.
+// It is itself a PriceProvider, so it can be nested or swapped for a single source.
+type Oracle struct {
+	providers []PriceProvider
+	cache     Cache
+	maxAge    time.Duration
+	cacheTTL  time.Duration
+	now       func() time.Time
+}
+
+// NewOracle creates an oracle over providers. cache may be nil to disable caching.
+func NewOracle(cache Cache, maxAge time.Duration, cacheTTL time.Duration, providers ...PriceProvider) *Oracle {
+	if maxAge <= 0 {
+		maxAge = DefaultMaxAge
+	}
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultCacheTTL
+	}
+
+	return &Oracle{
+		providers: providers,
+		cache:     cache,
+		maxAge:    maxAge,
+		cacheTTL:  cacheTTL,
+		now:       time.Now,
+	}
+}
+
+// NewSources builds the named HTTP sources sharing client
+func NewSources(names []string, client *http.Client) ([]PriceProvider, error) {
+	providers := make([]PriceProvider, 0, len(names))
+	for _, name := range names {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "":
+			continue
+		case "coinbase":
+			providers = append(providers, NewCoinbaseSource("", client))
+		case "coingecko":
+			providers = append(providers, NewCoinGeckoSource("", client))
+		case "kraken":
+			providers = append(providers, NewKrakenSource("", client))
+		default:
+			return nil, fmt.Errorf("unknown price source %q", name)
+		}
+	}
+
+	return providers, nil
+}
+
+func (oracle *Oracle) Name() string {
+	return OracleSource
+}
+
+// GetPrice returns the cached quote for the pair, or the median of the providers' fresh quotes
+func (oracle *Oracle) GetPrice(ctx context.Context, base string, currency string) (Quote, error) {
+	base, currency = normalize(base), normalize(currency)
+	key := cacheKey(base, currency)
+
+	if oracle.cache != nil {
+		var cached Quote
+		if err := oracle.cache.Get(key, &cached); err == nil && oracle.fresh(cached) {
+			return cached, nil
+		}
+	}
+
+	quotes := oracle.collect(ctx, base, currency)
+	if len(quotes) == 0 {
+		return Quote{}, fmt.Errorf("%w for %s/%s", ErrNoPrice, base, currency)
+	}
+
+	quote := Median(quotes)
+	if oracle.cache != nil {
+		if err := oracle.cache.Set(key, quote, oracle.cacheTTL); err != nil {
+			log.Warn().Err(err).Str("pair", base+"/"+currency).Msg("cannot cache price")
+		}
+	}
+
+	return quote, nil
+}
+
+// collect queries the providers concurrently and keeps the fresh, positive quotes
+func (oracle *Oracle) collect(ctx context.Context, base string, currency string) []Quote {
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		quotes []Quote
+	)
+
+	for _, provider := range oracle.providers {
+		wg.Add(1)
+		go func(provider PriceProvider) {
+			defer wg.Done()
+
+			quote, err := provider.GetPrice(ctx, base, currency)
+			if err != nil {
+				if !errors.Is(err, ErrUnsupportedPair) {
+					log.Warn().Err(err).Str("source", provider.Name()).Str("pair", base+"/"+currency).Msg("price source failed")
+				}
+				return
+			}
+			if quote.Price <= 0 || !oracle.fresh(quote) {
+				log.Warn().Str("source", provider.Name()).Time("updated_at", quote.UpdatedAt).Msg("discarding stale price")
+				return
+			}
+
+			mu.Lock()
+			quotes = append(quotes, quote)
+			mu.Unlock()
+		}(provider)
+	}
+	wg.Wait()
+
+	return quotes
+}
+
+func (oracle *Oracle) fresh(quote Quote) bool {
+	return oracle.now().Sub(quote.UpdatedAt) <= oracle.maxAge
+}
+
+// Median combines quotes for the same pair into one. With an even count the two middle prices are averaged.
+// The result carries the oldest timestamp of the quotes and lists the sources that contributed.
+func Median(quotes []Quote) Quote {
+	if len(quotes) == 0 {
+		return Quote{}
+	}
+
+	sorted := make([]Quote, len(quotes))
+	copy(sorted, quotes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Price < sorted[j].Price
+	})
+
+	mid := len(sorted) / 2
+	price := sorted[mid].Price
+	if len(sorted)%2 == 0 {
+		price = (sorted[mid-1].Price + sorted[mid].Price) / 2
+	}
+
+	sources := make([]string, len(sorted))
+	updatedAt := sorted[0].UpdatedAt
+	for i, quote := range sorted {
+		sources[i] = quote.Source
+		if quote.UpdatedAt.Before(updatedAt) {
+			updatedAt = quote.UpdatedAt
+		}
+	}
+	sort.Strings(sources)
+
+	return Quote{
+		Base:      sorted[0].Base,
+		Currency:  sorted[0].Currency,
+		Price:     price,
+		Source:    strings.Join(sources, ","),
+		UpdatedAt: updatedAt,
+	}
+}
+
+func cacheKey(base string, currency string) string {
+	return "price_" + base + "_" + currency
+}
@@ -0,0 +1,142 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	name  string
+	quote Quote
+	err   error
+	calls int
+}
+
+func (provider *fakeProvider) Name() string {
+	return provider.name
+}
+
+func (provider *fakeProvider) GetPrice(ctx context.Context, base string, currency string) (Quote, error) {
+	provider.calls++
+	if provider.err != nil {
+		return Quote{}, provider.err
+	}
+	quote := provider.quote
+	quote.Base, quote.Currency, quote.Source = base, currency, provider.name
+	return quote, nil
+}
+
+// memoryCache stores JSON like RedisCache does
+type memoryCache map[string][]byte
+
+func (cache memoryCache) Get(key string, dest interface{}) error {
+	data, ok := cache[key]
+	if !ok {
+		return errors.New("cache miss")
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (cache memoryCache) Set(key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	cache[key] = data
+	return nil
+}
+
+func TestMedian(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	odd := Median([]Quote{
+		{Price: 3010, Source: "b", UpdatedAt: at},
+		{Price: 2990, Source: "a", UpdatedAt: at.Add(-time.Minute)},
+		{Price: 9999, Source: "c", UpdatedAt: at},
+	})
+	require.Equal(t, 3010.0, odd.Price)
+	require.Equal(t, "a,b,c", odd.Source)
+	require.True(t, at.Add(-time.Minute).Equal(odd.UpdatedAt))
+
+	even := Median([]Quote{{Price: 3000}, {Price: 3010}})
+	require.Equal(t, 3005.0, even.Price)
+}
+
+func TestOracleDropsFailedAndStaleQuotes(t *testing.T) {
+	now := time.Now()
+	oracle := NewOracle(nil, time.Minute, 0,
+		&fakeProvider{name: "fresh1", quote: Quote{Price: 3000, UpdatedAt: now}},
+		&fakeProvider{name: "fresh2", quote: Quote{Price: 3020, UpdatedAt: now}},
+		&fakeProvider{name: "stale", quote: Quote{Price: 10, UpdatedAt: now.Add(-time.Hour)}},
+		&fakeProvider{name: "broken", err: errors.New("timeout")},
+		&fakeProvider{name: "unsupported", err: ErrUnsupportedPair},
+	)
+
+	quote, err := oracle.GetPrice(context.Background(), "eth", "usd")
+	require.NoError(t, err)
+	require.Equal(t, "ETH", quote.Base)
+	require.Equal(t, "USD", quote.Currency)
+	require.Equal(t, 3010.0, quote.Price)
+	require.Equal(t, "fresh1,fresh2", quote.Source)
+}
+
+func TestOracleNoPrice(t *testing.T) {
+	oracle := NewOracle(nil, time.Minute, 0,
+		&fakeProvider{name: "stale", quote: Quote{Price: 3000, UpdatedAt: time.Now().Add(-time.Hour)}},
+		&fakeProvider{name: "broken", err: errors.New("timeout")},
+	)
+
+	_, err := oracle.GetPrice(context.Background(), "ETH", "USD")
+	require.ErrorIs(t, err, ErrNoPrice)
+}
+
+func TestOracleCache(t *testing.T) {
+	provider := &fakeProvider{name: "source", quote: Quote{Price: 3000, UpdatedAt: time.Now()}}
+	cache := memoryCache{}
+	oracle := NewOracle(cache, time.Minute, time.Minute, provider)
+
+	first, err := oracle.GetPrice(context.Background(), "ETH", "USD")
+	require.NoError(t, err)
+	second, err := oracle.GetPrice(context.Background(), "ETH", "USD")
+	require.NoError(t, err)
+
+	require.Equal(t, 1, provider.calls)
+	require.Equal(t, first.Price, second.Price)
+	require.Contains(t, cache, cacheKey("ETH", "USD"))
+
+	// a cached quote older than maxAge is refreshed
+	oracle.now = func() time.Time { return time.Now().Add(time.Hour) }
+	provider.quote.UpdatedAt = time.Now().Add(time.Hour)
+	_, err = oracle.GetPrice(context.Background(), "ETH", "USD")
+	require.NoError(t, err)
+	require.Equal(t, 2, provider.calls)
+}
+
+func TestNewSources(t *testing.T) {
+	providers, err := NewSources([]string{"coinbase", " CoinGecko", "kraken", ""}, nil)
+	require.NoError(t, err)
+	require.Len(t, providers, 3)
+
+	_, err = NewSources([]string{"binance"}, nil)
+	require.Error(t, err)
+}
+
+func TestFallback(t *testing.T) {
+	primary := &fakeProvider{name: "primary", quote: Quote{Price: 3000, UpdatedAt: time.Now()}}
+	fallback := &fakeProvider{name: "fallback", quote: Quote{Price: 3100, UpdatedAt: time.Now()}}
+
+	quote, err := NewFallback(primary, fallback).GetPrice(context.Background(), "ETH", "USD")
+	require.NoError(t, err)
+	require.Equal(t, "primary", quote.Source)
+	require.Equal(t, 0, fallback.calls)
+
+	primary.err = ErrUnsupportedPair
+	quote, err = NewFallback(primary, fallback).GetPrice(context.Background(), "ETH", "NGN")
+	require.NoError(t, err)
+	require.Equal(t, "fallback", quote.Source)
+}
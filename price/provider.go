@@ -0,0 +1,76 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultTimeout bounds every HTTP request made by a source
+const defaultTimeout = 10 * time.Second
+
+var (
+	// ErrUnsupportedPair is returned by a source that does not quote the requested pair
+	ErrUnsupportedPair = errors.New("price pair is not supported by this source")
+	// ErrNoPrice is returned when no source produced a fresh quote
+	ErrNoPrice = errors.New("no fresh price available")
+)
+
+// Quote is the price of one unit of Base expressed in Currency
+type Quote struct {
+	Base      string    `json:"base"`
+	Currency  string    `json:"currency"`
+	Price     float64   `json:"price"`
+	Source    string    `json:"source"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PriceProvider quotes token prices in fiat currencies
+type PriceProvider interface {
+	// Name identifies the source in logs and responses
+	Name() string
+	// GetPrice returns the price of base (e.g. ETH, USDC) in currency (e.g. USD, EUR)
+	GetPrice(ctx context.Context, base string, currency string) (Quote, error)
+}
+
+// Cache stores quotes between requests. utils.RedisCache satisfies it.
+type Cache interface {
+	Get(key string, dest interface{}) error
+	Set(key string, value interface{}, expiration time.Duration) error
+}
+
+// NewHTTPClient returns the client used by HTTP sources when none is given
+func NewHTTPClient() *http.Client {
+	return &http.Client{Timeout: defaultTimeout}
+}
+
+// getJSON fetches url and decodes a 2xx JSON body into dest
+func getJSON(ctx context.Context, client *http.Client, url string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s returned %d: %s", url, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(dest)
+}
+
+func normalize(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
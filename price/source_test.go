@@ -0,0 +1,82 @@
+package price
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCoinbaseSource(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v2/prices/ETH-EUR/spot", r.URL.Path)
+		w.Write([]byte(`{"data":{"base":"ETH","currency":"EUR","amount":"2950.12"}}`))
+	})
+
+	quote, err := NewCoinbaseSource(server.URL, server.Client()).GetPrice(context.Background(), "eth", "eur")
+	require.NoError(t, err)
+	require.Equal(t, "ETH", quote.Base)
+	require.Equal(t, "EUR", quote.Currency)
+	require.Equal(t, 2950.12, quote.Price)
+	require.Equal(t, "coinbase", quote.Source)
+}
+
+func TestCoinbaseSourceErrorStatus(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := NewCoinbaseSource(server.URL, server.Client()).GetPrice(context.Background(), "ETH", "USD")
+	require.ErrorContains(t, err, "429")
+}
+
+func TestCoinGeckoSource(t *testing.T) {
+	updated := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v3/simple/price", r.URL.Path)
+		require.Equal(t, "usd-coin", r.URL.Query().Get("ids"))
+		require.Equal(t, "ngn", r.URL.Query().Get("vs_currencies"))
+		w.Write([]byte(`{"usd-coin":{"ngn":1520.5,"last_updated_at":1714564800}}`))
+	})
+
+	quote, err := NewCoinGeckoSource(server.URL, server.Client()).GetPrice(context.Background(), "USDC", "NGN")
+	require.NoError(t, err)
+	require.Equal(t, 1520.5, quote.Price)
+	require.True(t, updated.Equal(quote.UpdatedAt))
+
+	_, err = NewCoinGeckoSource(server.URL, server.Client()).GetPrice(context.Background(), "SHIB", "USD")
+	require.ErrorIs(t, err, ErrUnsupportedPair)
+}
+
+func TestKrakenSource(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "ETHUSD", r.URL.Query().Get("pair"))
+		w.Write([]byte(`{"error":[],"result":{"XETHZUSD":{"c":["3001.50","0.1"]}}}`))
+	})
+
+	quote, err := NewKrakenSource(server.URL, server.Client()).GetPrice(context.Background(), "ETH", "USD")
+	require.NoError(t, err)
+	require.Equal(t, 3001.5, quote.Price)
+
+	_, err = NewKrakenSource(server.URL, server.Client()).GetPrice(context.Background(), "ETH", "NGN")
+	require.ErrorIs(t, err, ErrUnsupportedPair)
+}
+
+func TestKrakenSourceUnknownPair(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error":["EQuery:Unknown asset pair"]}`))
+	})
+
+	_, err := NewKrakenSource(server.URL, server.Client()).GetPrice(context.Background(), "FOO", "USD")
+	require.ErrorIs(t, err, ErrUnsupportedPair)
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/demola234/defiraise/api"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// errProposalClaimed skips a proposal a concurrent run is already executing
+var errProposalClaimed = errors.New("the proposal is already being executed")
+
+// closeProposals decides the proposals on one contract version whose vote has ended, against the
+// campaign's on-chain donations at closing, and acts on the result: a release_milestone proposal
+// approves its milestone when it passes and rejects it when it fails, a passed extend_deadline
+// proposal sends the extension signed by the campaign owner, and a passed change_scope proposal is
+// executed once its result is recorded. A passed proposal stays passed until it is executed, so one
+// that cannot be acted on is tried again on the next run. An extension is claimed before it is sent,
+// so it is sent at most once, see extendDeadline.
+func closeProposals(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, dryRun bool) error {
+	ended, err := store.ListEndedCampaignProposals(ctx, db.ListEndedCampaignProposalsParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		EndsAt:          time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, proposal := range ended {
+		logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("campaign_id", proposal.CampaignID).Int64("proposal_id", proposal.ID).Str("kind", proposal.Kind).Logger()
+
+		votes, err := store.SumProposalVotes(ctx, proposal.ID)
+		if err != nil {
+			return err
+		}
+		_, total, err := chain.DonorContributions(proposal.CampaignID)
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot read campaign donations")
+			failed++
+			continue
+		}
+
+		forWeight, _ := new(big.Int).SetString(votes.ForWeight, 10)
+		againstWeight, _ := new(big.Int).SetString(votes.AgainstWeight, 10)
+		status := interfaces.ProposalFailed
+		if defi.ProposalPasses(forWeight, againstWeight, total, proposal.Quorum) {
+			status = interfaces.ProposalPassed
+		}
+		logger = logger.With().Str("status", status).Str("for", votes.ForWeight).Str("against", votes.AgainstWeight).Str("total", total.String()).Logger()
+
+		if dryRun {
+			logger.Info().Msg("proposal would be closed")
+			continue
+		}
+
+		proposal, err = store.CloseCampaignProposal(ctx, db.CloseCampaignProposalParams{
+			ID:            proposal.ID,
+			Status:        status,
+			ForWeight:     votes.ForWeight,
+			AgainstWeight: votes.AgainstWeight,
+			TotalWeight:   total.String(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			// closed by a concurrent run
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		logger.Info().Msg("proposal closed")
+
+		if proposal.Status != interfaces.ProposalPassed {
+			if err := rejectProposal(ctx, store, proposal); err != nil {
+				logger.Error().Err(err).Msg("cannot act on proposal")
+				failed++
+			}
+		}
+	}
+
+	passed, err := store.ListPassedCampaignProposals(ctx, db.ListPassedCampaignProposalsParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+	})
+	if err != nil {
+		return err
+	}
+	for _, proposal := range passed {
+		logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("campaign_id", proposal.CampaignID).Int64("proposal_id", proposal.ID).Str("kind", proposal.Kind).Logger()
+
+		if dryRun {
+			logger.Info().Msg("proposal would be executed")
+			continue
+		}
+
+		err := executeProposal(ctx, configs, store, chain, proposal)
+		if errors.Is(err, errProposalClaimed) {
+			continue
+		}
+		if errors.Is(err, api.ErrInvalidExtension) || errors.Is(err, api.ErrGoalReached) {
+			// the extension can no longer be made, so retrying cannot help
+			logger.Warn().Err(err).Msg("proposal cannot be executed")
+			if _, err := store.FailCampaignProposal(ctx, proposal.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot act on proposal, retrying on the next run")
+			failed++
+			continue
+		}
+		logger.Info().Msg("proposal executed")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d proposals on chain %d could not be closed", failed, chain.ID)
+	}
+	return nil
+}
+
+// rejectProposal acts on a proposal that did not pass: the milestone of a release_milestone proposal
+// is rejected
+func rejectProposal(ctx context.Context, store db.Store, proposal db.CampaignProposals) error {
+	if proposal.Kind != interfaces.ProposalReleaseMilestone {
+		return nil
+	}
+	_, err := store.RejectCampaignMilestone(ctx, db.RejectCampaignMilestoneParams{ID: proposal.MilestoneID.Int64, ApprovedBy: fmt.Sprintf("proposal:%d", proposal.ID)})
+	// a milestone decided by donor votes or a moderator in the meantime keeps that decision
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	return nil
+}
+
+// executeProposal acts on a passed proposal and marks it executed
+func executeProposal(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, proposal db.CampaignProposals) error {
+	switch proposal.Kind {
+	case interfaces.ProposalReleaseMilestone:
+		_, err := store.ApproveCampaignMilestone(ctx, db.ApproveCampaignMilestoneParams{ID: proposal.MilestoneID.Int64, ApprovedBy: fmt.Sprintf("proposal:%d", proposal.ID)})
+		// a milestone decided by donor votes or a moderator in the meantime keeps that decision
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	case interfaces.ProposalExtendDeadline:
+		if err := extendDeadline(ctx, configs, store, chain, proposal); err != nil {
+			return err
+		}
+	}
+	_, err := store.ExecuteCampaignProposal(ctx, proposal.ID)
+	return err
+}
+
+// extendDeadline checks the extension of a passed extend_deadline proposal like one made through the
+// API, since the campaign may have changed during the vote, sends it signed by the campaign owner and
+// records it. The proposal is claimed as executing before the extension is sent and the transaction
+// is recorded on it straight after, so a proposal that already has a transaction is only recorded and
+// one left executing without a transaction by a stopped run is not sent again.
+func extendDeadline(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, proposal db.CampaignProposals) error {
+	campaign, err := store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         proposal.ChainID,
+		ContractVersion: proposal.ContractVersion,
+		CampaignID:      proposal.CampaignID,
+	})
+	if err != nil {
+		return err
+	}
+
+	hash := proposal.ExecutionTxHash.String
+	if !proposal.ExecutionTxHash.Valid {
+		if hash, err = sendExtension(ctx, configs, store, chain, campaign, proposal); err != nil {
+			return err
+		}
+	}
+
+	_, err = store.CreateCampaignDeadlineChange(ctx, db.CreateCampaignDeadlineChangeParams{
+		ChainID:          proposal.ChainID,
+		ContractVersion:  proposal.ContractVersion,
+		CampaignID:       proposal.CampaignID,
+		Kind:             interfaces.DeadlineExtended,
+		PreviousDeadline: campaign.Deadline,
+		Deadline:         proposal.NewDeadline.Time,
+		TxHash:           hash,
+		ProposalID:       sql.NullInt64{Int64: proposal.ID, Valid: true},
+		CreatedBy:        proposal.CreatedBy,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = store.UpdateIndexedCampaignDeadline(ctx, db.UpdateIndexedCampaignDeadlineParams{
+		ChainID:         proposal.ChainID,
+		ContractVersion: proposal.ContractVersion,
+		CampaignID:      proposal.CampaignID,
+		Deadline:        proposal.NewDeadline.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.NewRedisCache().InvalidateAllCampaignCaches()
+	return nil
+}
+
+// sendExtension claims a passed extend_deadline proposal, sends its extension and records the
+// transaction on it. A proposal claimed by a concurrent run is skipped with errProposalClaimed.
+func sendExtension(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, campaign db.IndexedCampaigns, proposal db.CampaignProposals) (string, error) {
+	if err := api.CheckDeadlineExtension(ctx, store, configs, campaign, proposal.NewDeadline.Time); err != nil {
+		return "", err
+	}
+	owner, err := store.GetUserByAddress(ctx, campaign.Owner)
+	if err != nil {
+		return "", err
+	}
+	privateKey, _, err := defi.DecryptPrivateKey(owner.FilePath, configs.PassPhase)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = store.ClaimCampaignProposalExecution(ctx, proposal.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errProposalClaimed
+	}
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := chain.ExtendDeadline(ctx, proposal.CampaignID, proposal.NewDeadline.Time, privateKey)
+	if err != nil {
+		// nothing was sent, so the proposal is tried again on the next run
+		if err := store.ReleaseCampaignProposalExecution(ctx, proposal.ID); err != nil {
+			log.Error().Err(err).Int64("proposal_id", proposal.ID).Msg("cannot release proposal")
+		}
+		return "", err
+	}
+
+	err = store.SetCampaignProposalExecutionTx(ctx, db.SetCampaignProposalExecutionTxParams{
+		ID:              proposal.ID,
+		ExecutionTxHash: sql.NullString{String: hash, Valid: true},
+	})
+	return hash, err
+}
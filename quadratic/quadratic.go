@@ -0,0 +1,119 @@
+// Package quadratic splits the matching pot of a funding round between campaigns with the quadratic
+// funding formula, which favours campaigns backed by many donors over campaigns backed by a few large ones
+package quadratic
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Contribution is what one unique donor gave a campaign during a round, in wei
+type Contribution struct {
+	Donor    string
+	Amount   *big.Int
+	Eligible bool
+}
+
+// Allocation is a campaign's share of a round's matching pot, in wei
+type Allocation struct {
+	Contributors         int32
+	ExcludedContributors int32
+	Contributed          *big.Int
+	Match                *big.Int
+}
+
+// Aggregate turns a campaign's on-chain totals per donor address at the start and end of a round into
+// what each unique donor gave during it. filter decides who is behind an address, so that one donor's
+// addresses are counted together, and whether they count towards matching at all. A donor whose
+// total for the round is below min is not eligible.
+func Aggregate(ctx context.Context, filter SybilFilter, baseline, current map[string]*big.Int, min *big.Int) ([]Contribution, error) {
+	addresses := make([]string, 0, len(current))
+	for address := range current {
+		addresses = append(addresses, address)
+	}
+	// identities are resolved in a fixed order so the result does not depend on map iteration
+	sort.Strings(addresses)
+
+	byDonor := make(map[string]*Contribution)
+	var donors []string
+	for _, address := range addresses {
+		amount := new(big.Int).Set(current[address])
+		if before, ok := baseline[strings.ToLower(address)]; ok {
+			amount.Sub(amount, before)
+		}
+		if amount.Sign() <= 0 {
+			continue
+		}
+
+		identity, err := filter.Identify(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		contribution, ok := byDonor[identity.Donor]
+		if !ok {
+			contribution = &Contribution{Donor: identity.Donor, Amount: new(big.Int), Eligible: true}
+			byDonor[identity.Donor] = contribution
+			donors = append(donors, identity.Donor)
+		}
+		contribution.Amount.Add(contribution.Amount, amount)
+		contribution.Eligible = contribution.Eligible && identity.Eligible
+	}
+
+	contributions := make([]Contribution, len(donors))
+	for i, donor := range donors {
+		contribution := *byDonor[donor]
+		if min != nil && contribution.Amount.Cmp(min) < 0 {
+			contribution.Eligible = false
+		}
+		contributions[i] = contribution
+	}
+	return contributions, nil
+}
+
+// Allocate splits pot between the campaigns in proportion to (Σ√c)² − Σc over the eligible
+// contributions c of each campaign. The shares are rounded down, so up to one wei per campaign of the
+// pot is left over.
+func Allocate(pot *big.Int, campaigns map[int64][]Contribution) map[int64]Allocation {
+	allocations := make(map[int64]Allocation, len(campaigns))
+	weights := make(map[int64]*big.Int, len(campaigns))
+	total := new(big.Int)
+
+	for id, contributions := range campaigns {
+		allocation := Allocation{Contributed: new(big.Int), Match: new(big.Int)}
+		sumSqrt := new(big.Int)
+		sum := new(big.Int)
+		for _, contribution := range contributions {
+			if !contribution.Eligible {
+				allocation.ExcludedContributors++
+				continue
+			}
+			allocation.Contributors++
+			allocation.Contributed.Add(allocation.Contributed, contribution.Amount)
+			sumSqrt.Add(sumSqrt, new(big.Int).Sqrt(contribution.Amount))
+			sum.Add(sum, contribution.Amount)
+		}
+
+		// the square roots are rounded down, which can take a single donor's weight just below zero
+		weight := sumSqrt.Mul(sumSqrt, sumSqrt)
+		weight.Sub(weight, sum)
+		if weight.Sign() < 0 {
+			weight.SetInt64(0)
+		}
+		weights[id] = weight
+		total.Add(total, weight)
+		allocations[id] = allocation
+	}
+
+	if total.Sign() == 0 {
+		return allocations
+	}
+	for id, allocation := range allocations {
+		allocation.Match.Mul(pot, weights[id])
+		allocation.Match.Quo(allocation.Match, total)
+		allocations[id] = allocation
+	}
+	return allocations
+}
@@ -0,0 +1,99 @@
+package quadratic
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"testing"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func wei(amount int64) *big.Int {
+	return big.NewInt(amount)
+}
+
+func TestAllocate(t *testing.T) {
+	// the same 400 wei from four donors outweighs it from one: (4·√100)² − 400 = 1200 against 0
+	allocations := Allocate(wei(1000), map[int64][]Contribution{
+		1: {
+			{Donor: "a", Amount: wei(100), Eligible: true},
+			{Donor: "b", Amount: wei(100), Eligible: true},
+			{Donor: "c", Amount: wei(100), Eligible: true},
+			{Donor: "d", Amount: wei(100), Eligible: true},
+		},
+		2: {{Donor: "e", Amount: wei(400), Eligible: true}},
+		3: {
+			{Donor: "f", Amount: wei(100), Eligible: true},
+			{Donor: "g", Amount: wei(100), Eligible: true},
+			{Donor: "h", Amount: wei(900), Eligible: false},
+		},
+	})
+
+	// campaign 3: (√100 + √100)² − 200 = 200, so the pot splits 1200:0:200
+	require.Equal(t, "857", allocations[1].Match.String())
+	require.Equal(t, "0", allocations[2].Match.String())
+	require.Equal(t, "142", allocations[3].Match.String())
+
+	require.Equal(t, int32(4), allocations[1].Contributors)
+	require.Equal(t, "400", allocations[1].Contributed.String())
+	require.Equal(t, int32(2), allocations[3].Contributors)
+	require.Equal(t, int32(1), allocations[3].ExcludedContributors)
+	require.Equal(t, "200", allocations[3].Contributed.String())
+}
+
+func TestAllocateNoMatches(t *testing.T) {
+	allocations := Allocate(wei(1000), map[int64][]Contribution{
+		1: {{Donor: "a", Amount: wei(100), Eligible: true}},
+		2: nil,
+	})
+
+	require.Len(t, allocations, 2)
+	require.Zero(t, allocations[1].Match.Sign())
+	require.Zero(t, allocations[2].Match.Sign())
+}
+
+func TestAggregate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	// 0xa1 and 0xa2 are wallets of the same verified user, 0xb1 belongs to an unverified user and
+	// 0xc1 to nobody here
+	store.EXPECT().GetDonorIdentity(gomock.Any(), "0xa1").Return(db.GetDonorIdentityRow{Username: "ada", IsEmailVerified: true}, nil)
+	store.EXPECT().GetDonorIdentity(gomock.Any(), "0xa2").Return(db.GetDonorIdentityRow{Username: "ada", IsEmailVerified: true}, nil)
+	store.EXPECT().GetDonorIdentity(gomock.Any(), "0xb1").Return(db.GetDonorIdentityRow{Username: "bob"}, nil)
+	store.EXPECT().GetDonorIdentity(gomock.Any(), "0xc1").Return(db.GetDonorIdentityRow{}, sql.ErrNoRows)
+
+	baseline := map[string]*big.Int{"0xa1": wei(50), "0xd1": wei(70)}
+	current := map[string]*big.Int{
+		"0xa1": wei(80),
+		"0xa2": wei(40),
+		"0xb1": wei(100),
+		"0xc1": wei(100),
+		// gave nothing during the round
+		"0xd1": wei(70),
+	}
+
+	contributions, err := Aggregate(context.Background(), VerifiedUsers{Store: store}, baseline, current, nil)
+	require.NoError(t, err)
+	require.Equal(t, []Contribution{
+		{Donor: "ada", Amount: wei(70), Eligible: true},
+		{Donor: "bob", Amount: wei(100), Eligible: false},
+		{Donor: "0xc1", Amount: wei(100), Eligible: false},
+	}, contributions)
+}
+
+func TestAggregateMinContribution(t *testing.T) {
+	current := map[string]*big.Int{"0xa1": wei(80), "0xb1": wei(20)}
+
+	contributions, err := Aggregate(context.Background(), AnyDonor{}, nil, current, wei(50))
+	require.NoError(t, err)
+	require.Equal(t, []Contribution{
+		{Donor: "0xa1", Amount: wei(80), Eligible: true},
+		{Donor: "0xb1", Amount: wei(20), Eligible: false},
+	}, contributions)
+}
@@ -0,0 +1,55 @@
+package quadratic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+)
+
+// Identity is who is behind a donor address and whether their donations count towards matching
+type Identity struct {
+	Donor    string
+	Eligible bool
+}
+
+// SybilFilter identifies the donor behind an address, so that one person's addresses count as one
+// donor, and decides whether they are eligible for matching. Quadratic funding rewards the number of
+// donors, so a round that counted every address could be gamed by splitting a donation across many.
+type SybilFilter interface {
+	Identify(ctx context.Context, address string) (Identity, error)
+}
+
+// AnyDonor counts every address as its own eligible donor
+type AnyDonor struct{}
+
+func (AnyDonor) Identify(_ context.Context, address string) (Identity, error) {
+	return Identity{Donor: strings.ToLower(address), Eligible: true}, nil
+}
+
+// VerifiedUsers only counts donors with a verified account here. The custodial and linked wallets of a
+// user are counted together as the user; an address that belongs to nobody is not eligible.
+type VerifiedUsers struct {
+	Store db.Store
+}
+
+func (filter VerifiedUsers) Identify(ctx context.Context, address string) (Identity, error) {
+	user, err := filter.Store.GetDonorIdentity(ctx, address)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Identity{Donor: strings.ToLower(address)}, nil
+		}
+		return Identity{}, err
+	}
+	return Identity{Donor: user.Username, Eligible: user.IsEmailVerified}, nil
+}
+
+// FilterFor returns the sybil filter a round uses
+func FilterFor(store db.Store, round db.FundingRounds) SybilFilter {
+	if round.RequireVerified {
+		return VerifiedUsers{Store: store}
+	}
+	return AnyDonor{}
+}
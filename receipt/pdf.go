@@ -0,0 +1,125 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pageWidth    = 595 // A4 in points
+	pageHeight   = 842
+	marginLeft   = 50
+	marginTop    = 60
+	lineHeight   = 16
+	linesPerPage = (pageHeight - 2*marginTop) / lineHeight
+)
+
+type pdfLine struct {
+	text string
+	bold bool
+}
+
+// pdfDocument is a minimal text-only PDF writer. It only needs the standard
+// Helvetica fonts, so no font files are embedded.
+type pdfDocument struct {
+	pages [][]pdfLine
+}
+
+func newPDFDocument() *pdfDocument {
+	return &pdfDocument{pages: [][]pdfLine{{}}}
+}
+
+func (doc *pdfDocument) add(line pdfLine) {
+	last := len(doc.pages) - 1
+	if len(doc.pages[last]) >= linesPerPage {
+		doc.pages = append(doc.pages, []pdfLine{})
+		last++
+	}
+	doc.pages[last] = append(doc.pages[last], line)
+}
+
+// Heading writes a bold line
+func (doc *pdfDocument) Heading(text string) {
+	doc.add(pdfLine{text: text, bold: true})
+}
+
+// Line writes a regular line
+func (doc *pdfDocument) Line(format string, args ...interface{}) {
+	doc.add(pdfLine{text: fmt.Sprintf(format, args...)})
+}
+
+// Blank writes an empty line
+func (doc *pdfDocument) Blank() {
+	doc.add(pdfLine{})
+}
+
+// Bytes renders the document
+func (doc *pdfDocument) Bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// objects 1-4 are fixed, each page then takes a page object and a content stream
+	kids := make([]string, len(doc.pages))
+	for i := range doc.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(doc.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range doc.pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i,
+		))
+
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n%d TL\n%d %d Td\n", lineHeight, marginLeft, pageHeight-marginTop)
+		for _, line := range page {
+			font := "F1 10"
+			if line.bold {
+				font = "F2 13"
+			}
+			fmt.Fprintf(&content, "/%s Tf\n(%s) Tj\nT*\n", font, escapePDF(line.text))
+		}
+		content.WriteString("ET")
+
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// escapePDF escapes a string literal and drops characters the standard fonts cannot show
+func escapePDF(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,203 @@
+package receipt
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	// FormatPDF renders a printable document
+	FormatPDF = "pdf"
+	// FormatCSV renders a spreadsheet friendly export
+	FormatCSV = "csv"
+)
+
+// Donation is everything printed about a single donation
+type Donation struct {
+	ID            int64
+	CampaignID    int64
+	CampaignTitle string
+	CampaignOwner string
+	Donor         string
+	Amount        float64
+	Token         string
+	TxHash        string
+	BlockNumber   int64
+	FiatAmount    string
+	FiatCurrency  string
+	DonatedAt     time.Time
+}
+
+// Statement is a donor's giving over one calendar year
+type Statement struct {
+	Username  string
+	Year      int
+	Donations []Donation
+}
+
+var csvHeader = []string{
+	"receipt_id", "donated_at", "campaign_id", "campaign", "campaign_owner", "donor",
+	"amount", "token", "fiat_amount", "fiat_currency", "tx_hash", "block_number",
+}
+
+// Render renders a single donation receipt in the given format
+func Render(donation Donation, format string) ([]byte, error) {
+	switch format {
+	case FormatPDF:
+		return receiptPDF(donation), nil
+	case FormatCSV:
+		return toCSV([]Donation{donation})
+	default:
+		return nil, fmt.Errorf("unsupported receipt format %q", format)
+	}
+}
+
+// RenderStatement renders a yearly giving statement in the given format
+func RenderStatement(statement Statement, format string) ([]byte, error) {
+	switch format {
+	case FormatPDF:
+		return statementPDF(statement), nil
+	case FormatCSV:
+		return toCSV(statement.Donations)
+	default:
+		return nil, fmt.Errorf("unsupported statement format %q", format)
+	}
+}
+
+// ContentType returns the MIME type for a format
+func ContentType(format string) string {
+	if format == FormatCSV {
+		return "text/csv"
+	}
+	return "application/pdf"
+}
+
+func toCSV(donations []Donation) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	for _, d := range donations {
+		record := []string{
+			strconv.FormatInt(d.ID, 10),
+			d.DonatedAt.UTC().Format(time.RFC3339),
+			strconv.FormatInt(d.CampaignID, 10),
+			d.CampaignTitle,
+			d.CampaignOwner,
+			d.Donor,
+			formatAmount(d.Amount),
+			d.Token,
+			d.FiatAmount,
+			d.FiatCurrency,
+			d.TxHash,
+			strconv.FormatInt(d.BlockNumber, 10),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func receiptPDF(d Donation) []byte {
+	doc := newPDFDocument()
+
+	doc.Heading("DefiRaise Donation Receipt")
+	doc.Blank()
+	doc.Line("Receipt ID:     %d", d.ID)
+	doc.Line("Date:           %s", d.DonatedAt.UTC().Format("02 Jan 2006 15:04:05 MST"))
+	doc.Blank()
+	doc.Line("Campaign:       %s (#%d)", d.CampaignTitle, d.CampaignID)
+	doc.Line("Campaign owner: %s", d.CampaignOwner)
+	doc.Line("Donor wallet:   %s", d.Donor)
+	doc.Blank()
+	doc.Line("Amount:         %s %s", formatAmount(d.Amount), d.Token)
+	doc.Line("Fiat value:     %s", formatFiat(d.FiatAmount, d.FiatCurrency))
+	doc.Blank()
+	doc.Line("Transaction:    %s", d.TxHash)
+	doc.Line("Block:          %d", d.BlockNumber)
+	doc.Blank()
+	doc.Line("Fiat value is the market price at the time of the donation.")
+
+	return doc.Bytes()
+}
+
+func statementPDF(s Statement) []byte {
+	doc := newPDFDocument()
+
+	doc.Heading(fmt.Sprintf("DefiRaise Giving Statement %d", s.Year))
+	doc.Line("Prepared for %s", s.Username)
+	doc.Blank()
+
+	if len(s.Donations) == 0 {
+		doc.Line("No confirmed donations were recorded in %d.", s.Year)
+		return doc.Bytes()
+	}
+
+	for _, d := range s.Donations {
+		doc.Line("%s  %s %s  (%s)  %s",
+			d.DonatedAt.UTC().Format("2006-01-02"),
+			formatAmount(d.Amount), d.Token,
+			formatFiat(d.FiatAmount, d.FiatCurrency),
+			d.CampaignTitle,
+		)
+		doc.Line("    tx %s, block %d", d.TxHash, d.BlockNumber)
+	}
+
+	doc.Blank()
+	doc.Heading("Totals")
+	tokens, fiat := totals(s.Donations)
+	for _, token := range sortedKeys(tokens) {
+		doc.Line("%s %s", formatAmount(tokens[token]), token)
+	}
+	for _, currency := range sortedKeys(fiat) {
+		doc.Line("%s %s", strconv.FormatFloat(fiat[currency], 'f', 2, 64), currency)
+	}
+
+	return doc.Bytes()
+}
+
+// totals sums donations per token and, where a fiat value is known, per currency
+func totals(donations []Donation) (map[string]float64, map[string]float64) {
+	tokens := map[string]float64{}
+	fiat := map[string]float64{}
+
+	for _, d := range donations {
+		tokens[d.Token] += d.Amount
+
+		if value, err := strconv.ParseFloat(d.FiatAmount, 64); err == nil {
+			fiat[d.FiatCurrency] += value
+		}
+	}
+
+	return tokens, fiat
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+func formatFiat(amount string, currency string) string {
+	if amount == "" {
+		return "unavailable"
+	}
+	return amount + " " + currency
+}
@@ -0,0 +1,69 @@
+package receipt
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomDonation(id int64) Donation {
+	return Donation{
+		ID:            id,
+		CampaignID:    3,
+		CampaignTitle: "Clean water (phase 2)",
+		CampaignOwner: "0x1554d6aA4f1189A36De9b3B33564b10126Ac266d",
+		Donor:         "0x574bc33136180f0734fc3fa55379e9e28701395e",
+		Amount:        0.25,
+		Token:         "ETH",
+		TxHash:        "0xabc123",
+		BlockNumber:   42,
+		FiatAmount:    "812.50",
+		FiatCurrency:  "USD",
+		DonatedAt:     time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRenderReceiptCSV(t *testing.T) {
+	data, err := Render(randomDonation(7), FormatCSV)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, csvHeader, records[0])
+	require.Equal(t, "7", records[1][0])
+	require.Equal(t, "0.25", records[1][6])
+	require.Equal(t, "812.50", records[1][8])
+	require.Equal(t, "0xabc123", records[1][10])
+}
+
+func TestRenderReceiptPDF(t *testing.T) {
+	data, err := Render(randomDonation(7), FormatPDF)
+	require.NoError(t, err)
+
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4")))
+	require.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+	require.Contains(t, string(data), "0xabc123")
+	require.Contains(t, string(data), `Clean water \(phase 2\)`)
+}
+
+func TestRenderStatementPaginates(t *testing.T) {
+	statement := Statement{Username: "donor", Year: 2024}
+	for i := int64(1); i <= 60; i++ {
+		statement.Donations = append(statement.Donations, randomDonation(i))
+	}
+
+	data, err := RenderStatement(statement, FormatPDF)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "/Count 3")
+	require.Contains(t, string(data), "15 ETH")
+	require.Contains(t, string(data), "48750.00 USD")
+}
+
+func TestRenderUnsupportedFormat(t *testing.T) {
+	_, err := Render(randomDonation(1), "xml")
+	require.Error(t, err)
+}
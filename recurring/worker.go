@@ -0,0 +1,329 @@
+package recurring
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/indexer"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultInterval is used when RECURRING_INTERVAL is not configured
+	defaultInterval = time.Minute
+	// defaultRetryDelay is used when RECURRING_RETRY_DELAY is not configured
+	defaultRetryDelay = time.Hour
+	// defaultMaxAttempts is used when RECURRING_MAX_ATTEMPTS is not configured
+	defaultMaxAttempts = 3
+	// leaseDuration is how long a claimed donation is held by one worker. A worker that stops while
+	// running it leaves it to be claimed again once the lease runs out.
+	leaseDuration = 10 * time.Minute
+	// batchSize is the number of due donations claimed at a time
+	batchSize = 50
+)
+
+// errInsufficientBalance is recorded when the donor's wallet cannot cover an installment
+var errInsufficientBalance = errors.New("insufficient balance")
+
+// Notifier tells a user how an installment of their recurring donation went
+type Notifier func(user db.Users, subject string, details string) error
+
+// EmailNotifier sends notices with the recurring donation email template
+func EmailNotifier(user db.Users, subject string, details string) error {
+	email := utils.EmailInfo{
+		Name:     user.Username,
+		Details:  details,
+		Subject:  subject,
+		Template: "recurring_donation.html",
+	}
+	_, err := utils.SendEmail(user.Email, user.Username, email, "./utils")
+	return err
+}
+
+// Worker donates each due installment of the recurring donations from the donor's custodial wallet,
+// retrying a failed installment up to maxAttempts times before skipping it
+type Worker struct {
+	store       db.Store
+	chains      *defi.ChainRegistry
+	passPhrase  string
+	interval    time.Duration
+	retryDelay  time.Duration
+	maxAttempts int32
+	notify      Notifier
+}
+
+// NewWorker creates a worker for the recurring donations on every chain. notify may be nil to send emails.
+func NewWorker(store db.Store, chains *defi.ChainRegistry, configs utils.Config, notify Notifier) *Worker {
+	worker := &Worker{
+		store:       store,
+		chains:      chains,
+		passPhrase:  configs.PassPhase,
+		interval:    configs.RecurringInterval,
+		retryDelay:  configs.RecurringRetryDelay,
+		maxAttempts: int32(configs.RecurringMaxAttempts),
+		notify:      notify,
+	}
+	if worker.interval <= 0 {
+		worker.interval = defaultInterval
+	}
+	if worker.retryDelay <= 0 {
+		worker.retryDelay = defaultRetryDelay
+	}
+	if worker.maxAttempts <= 0 {
+		worker.maxAttempts = defaultMaxAttempts
+	}
+	if worker.notify == nil {
+		worker.notify = EmailNotifier
+	}
+
+	return worker
+}
+
+// Start runs the due donations straight away and then on every interval until ctx is cancelled
+func (worker *Worker) Start(ctx context.Context) {
+	ticker := time.NewTicker(worker.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := worker.RunDue(ctx); err != nil {
+			log.Error().Err(err).Msg("cannot run recurring donations")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue claims the donations whose next run has come and runs them, until none are left
+func (worker *Worker) RunDue(ctx context.Context) error {
+	for {
+		now := time.Now()
+		due, err := worker.store.ClaimDueRecurringDonations(ctx, db.ClaimDueRecurringDonationsParams{
+			LeaseUntil: now.Add(leaseDuration),
+			Now:        now,
+			Limit:      batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, donation := range due {
+			if err := worker.run(ctx, donation); err != nil {
+				log.Error().Err(err).Int64("recurring_donation_id", donation.ID).Msg("cannot run recurring donation")
+			}
+		}
+
+		if len(due) < batchSize {
+			return nil
+		}
+	}
+}
+
+// run donates one installment and schedules the next. An error is only returned when the outcome
+// could not be recorded, in which case the donation is run again once its lease runs out.
+func (worker *Worker) run(ctx context.Context, donation db.RecurringDonations) error {
+	logger := log.With().Int64("recurring_donation_id", donation.ID).Int64("chain_id", donation.ChainID).
+		Int32("version", donation.ContractVersion).Int64("campaign_id", donation.CampaignID).Logger()
+
+	user, err := worker.store.GetUser(ctx, donation.Username)
+	if err != nil {
+		return err
+	}
+	campaign, err := worker.store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+		ChainID:         donation.ChainID,
+		ContractVersion: donation.ContractVersion,
+		CampaignID:      donation.CampaignID,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if reason := endReason(donation, campaign, now); reason != "" {
+		_, err := worker.store.UpdateRecurringDonationStatus(ctx, db.UpdateRecurringDonationStatusParams{
+			Status:       interfaces.RecurringCompleted,
+			ID:           donation.ID,
+			FromStatuses: []string{interfaces.RecurringActive},
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		logger.Info().Str("reason", reason).Msg("recurring donation completed")
+		worker.sendNotice(user, fmt.Sprintf("Your recurring donation to %s has ended", campaign.Title),
+			fmt.Sprintf("Your recurring donation to %s has ended because %s. You gave %d times in total.", campaign.Title, reason, donation.DonationCount))
+		return nil
+	}
+
+	hash, sent, err := worker.donate(ctx, donation, user)
+	if err != nil {
+		return worker.recordFailure(ctx, donation, user, campaign, err)
+	}
+
+	indexer.RecordSentDonation(ctx, worker.store, indexer.SentDonation{
+		ChainID:         donation.ChainID,
+		ContractVersion: donation.ContractVersion,
+		CampaignID:      donation.CampaignID,
+		DonorAddress:    user.Address,
+		Amount:          sent,
+		Token:           donation.Token,
+		TxHash:          hash,
+	})
+
+	donation.DonationCount++
+	next := nextRun(now, donation.Interval)
+	status := interfaces.RecurringActive
+	if endReason(donation, campaign, next) != "" {
+		status = interfaces.RecurringCompleted
+	}
+
+	_, err = worker.store.RecordRecurringDonationRun(ctx, db.RecordRecurringDonationRunParams{
+		ID:         donation.ID,
+		Status:     status,
+		NextRunAt:  next,
+		LastTxHash: sql.NullString{String: hash, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.NewRedisCache().InvalidateAllCampaignCaches()
+
+	logger.Info().Str("tx_hash", hash).Str("status", status).Msg("recurring donation sent")
+	details := fmt.Sprintf("We sent your recurring donation of %g %s to %s. Transaction: %s.",
+		utils.WeiToEther(donation.Amount), donation.Token, campaign.Title, hash)
+	if status == interfaces.RecurringCompleted {
+		details += " This was the last donation of the schedule."
+	} else {
+		details += fmt.Sprintf(" The next one is due on %s.", next.Format("2 January 2006"))
+	}
+	worker.sendNotice(user, fmt.Sprintf("Your recurring donation to %s was sent", campaign.Title), details)
+	return nil
+}
+
+// donate checks the donor's balance covers the installment and sends it through the campaign contract,
+// returning the transaction hash and the wei sent
+func (worker *Worker) donate(ctx context.Context, donation db.RecurringDonations, user db.Users) (string, *big.Int, error) {
+	chain, err := worker.chains.Get(donation.ChainID)
+	if err == nil {
+		chain, err = chain.At(int(donation.ContractVersion))
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	// the installment is sent in exactly the wei it was stored with, so its donation record matches
+	// the on-chain donation
+	amount, ok := new(big.Int).SetString(donation.Amount, 10)
+	if !ok {
+		return "", nil, fmt.Errorf("invalid installment amount %q", donation.Amount)
+	}
+	balance, err := chain.NativeBalance(ctx, user.Address)
+	if err != nil {
+		return "", nil, err
+	}
+	if amount.Cmp(balance) > 0 {
+		return "", nil, errInsufficientBalance
+	}
+
+	privateKey, address, err := defi.DecryptPrivateKey(user.FilePath, worker.passPhrase)
+	if err != nil {
+		return "", nil, err
+	}
+
+	hash, err := chain.DonateWei(amount, int(donation.CampaignID), privateKey, address)
+	if err != nil {
+		return "", nil, err
+	}
+	return hash, amount, nil
+}
+
+// recordFailure schedules a retry of a failed installment, or skips it once it has failed maxAttempts
+// times, telling the user either way
+func (worker *Worker) recordFailure(ctx context.Context, donation db.RecurringDonations, user db.Users, campaign db.IndexedCampaigns, cause error) error {
+	now := time.Now()
+	attempts := donation.FailedAttempts + 1
+	params := db.RecordRecurringDonationFailureParams{
+		ID:             donation.ID,
+		Status:         interfaces.RecurringActive,
+		NextRunAt:      now.Add(worker.retryDelay),
+		FailedAttempts: attempts,
+		LastError:      sql.NullString{String: cause.Error(), Valid: true},
+	}
+
+	skipped := attempts >= worker.maxAttempts
+	if skipped {
+		params.NextRunAt = nextRun(now, donation.Interval)
+		params.FailedAttempts = 0
+		if endReason(donation, campaign, params.NextRunAt) != "" {
+			params.Status = interfaces.RecurringCompleted
+		}
+	}
+
+	if _, err := worker.store.RecordRecurringDonationFailure(ctx, params); err != nil {
+		return err
+	}
+
+	log.Warn().Err(cause).Int64("recurring_donation_id", donation.ID).Int32("attempt", attempts).Bool("skipped", skipped).Msg("recurring donation failed")
+
+	details := fmt.Sprintf("We could not send your recurring donation of %g %s to %s: %s.",
+		utils.WeiToEther(donation.Amount), donation.Token, campaign.Title, cause)
+	switch {
+	case !skipped:
+		details += fmt.Sprintf(" We will try again on %s.", params.NextRunAt.Format("2 January 2006 at 15:04 MST"))
+	case params.Status == interfaces.RecurringCompleted:
+		details += " This donation was skipped and was the last of the schedule."
+	default:
+		details += fmt.Sprintf(" This donation was skipped; the next one is due on %s.", params.NextRunAt.Format("2 January 2006"))
+	}
+	worker.sendNotice(user, fmt.Sprintf("Your recurring donation to %s failed", campaign.Title), details)
+	return nil
+}
+
+// sendNotice emails the user, logging instead of failing the run when the email cannot be sent
+func (worker *Worker) sendNotice(user db.Users, subject string, details string) {
+	if err := worker.notify(user, subject, details); err != nil {
+		log.Warn().Err(err).Str("username", user.Username).Msg("cannot send recurring donation notice")
+	}
+}
+
+// nextRun returns the time of the installment after one made at t
+func nextRun(t time.Time, interval string) time.Time {
+	if interval == interfaces.RecurringWeekly {
+		return t.AddDate(0, 0, 7)
+	}
+	return t.AddDate(0, 1, 0)
+}
+
+// endReason reports why a recurring donation has no installment at t, or "" when it still has one:
+// the campaign has ended or reached its goal, the schedule's end has passed or its donations have all been made
+func endReason(donation db.RecurringDonations, campaign db.IndexedCampaigns, t time.Time) string {
+	switch {
+	case !t.Before(campaign.Deadline):
+		return "the campaign has ended"
+	case fundsReached(campaign):
+		return "the campaign has reached its goal"
+	case donation.EndsAt.Valid && t.After(donation.EndsAt.Time):
+		return "the schedule's end date has passed"
+	case donation.MaxDonations.Valid && donation.DonationCount >= donation.MaxDonations.Int32:
+		return "all scheduled donations have been made"
+	}
+	return ""
+}
+
+// fundsReached reports whether the indexed funds of a campaign have reached its goal
+func fundsReached(campaign db.IndexedCampaigns) bool {
+	goal, _ := new(big.Int).SetString(campaign.Goal, 10)
+	raised, _ := new(big.Int).SetString(campaign.TotalFunds, 10)
+	return goal != nil && raised != nil && goal.Sign() > 0 && raised.Cmp(goal) >= 0
+}
@@ -0,0 +1,177 @@
+package recurring
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextRun(t *testing.T) {
+	at := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	require.Equal(t, time.Date(2024, 2, 7, 9, 0, 0, 0, time.UTC), nextRun(at, interfaces.RecurringWeekly))
+	// AddDate normalises 31 February to 2 March
+	require.Equal(t, time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC), nextRun(at, interfaces.RecurringMonthly))
+}
+
+func TestEndReason(t *testing.T) {
+	now := time.Now()
+	campaign := db.IndexedCampaigns{Goal: "2000", TotalFunds: "1000", Deadline: now.Add(time.Hour)}
+
+	testCases := []struct {
+		name     string
+		donation db.RecurringDonations
+		campaign db.IndexedCampaigns
+		ended    bool
+	}{
+		{name: "Active", campaign: campaign},
+		{name: "CampaignEnded", campaign: db.IndexedCampaigns{Goal: "2000", TotalFunds: "1000", Deadline: now}, ended: true},
+		{name: "GoalReached", campaign: db.IndexedCampaigns{Goal: "2000", TotalFunds: "2000", Deadline: now.Add(time.Hour)}, ended: true},
+		{name: "EndsAtPassed", donation: db.RecurringDonations{EndsAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true}}, campaign: campaign, ended: true},
+		{name: "EndsAtAhead", donation: db.RecurringDonations{EndsAt: sql.NullTime{Time: now.Add(time.Minute), Valid: true}}, campaign: campaign},
+		{name: "AllDonated", donation: db.RecurringDonations{MaxDonations: sql.NullInt32{Int32: 3, Valid: true}, DonationCount: 3}, campaign: campaign, ended: true},
+		{name: "DonationsLeft", donation: db.RecurringDonations{MaxDonations: sql.NullInt32{Int32: 3, Valid: true}, DonationCount: 2}, campaign: campaign},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.ended, endReason(tc.donation, tc.campaign, now) != "")
+		})
+	}
+}
+
+func TestRunDue(t *testing.T) {
+	user := db.Users{Username: utils.RandomString(6), Email: "donor@example.com", Address: "0xabc0000000000000000000000000000000000001"}
+	campaign := db.IndexedCampaigns{CampaignID: 7, Title: "Clean water", Goal: "2000000000000000000", TotalFunds: "0", Deadline: time.Now().Add(30 * 24 * time.Hour)}
+	// no chain is configured with this ID, so every donation attempt fails
+	donation := db.RecurringDonations{ID: 9, Username: user.Username, ChainID: 999, ContractVersion: 1, CampaignID: 7, Amount: "1000000000000000", Token: "ETH", Interval: interfaces.RecurringWeekly, Status: interfaces.RecurringActive}
+
+	testCases := []struct {
+		name       string
+		donation   db.RecurringDonations
+		campaign   db.IndexedCampaigns
+		buildStubs func(store *mockdb.MockStore)
+		subject    string
+	}{
+		{
+			name:     "Retry",
+			donation: donation,
+			campaign: campaign,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RecordRecurringDonationFailure(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RecordRecurringDonationFailureParams) (db.RecurringDonations, error) {
+						require.Equal(t, interfaces.RecurringActive, arg.Status)
+						require.Equal(t, int32(1), arg.FailedAttempts)
+						require.WithinDuration(t, time.Now().Add(defaultRetryDelay), arg.NextRunAt, time.Minute)
+						require.True(t, arg.LastError.Valid)
+						return db.RecurringDonations{}, nil
+					})
+			},
+			subject: "Your recurring donation to Clean water failed",
+		},
+		{
+			name: "Skip",
+			donation: func() db.RecurringDonations {
+				failing := donation
+				failing.FailedAttempts = defaultMaxAttempts - 1
+				return failing
+			}(),
+			campaign: campaign,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RecordRecurringDonationFailure(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RecordRecurringDonationFailureParams) (db.RecurringDonations, error) {
+						require.Equal(t, interfaces.RecurringActive, arg.Status)
+						require.Zero(t, arg.FailedAttempts)
+						require.WithinDuration(t, time.Now().AddDate(0, 0, 7), arg.NextRunAt, time.Minute)
+						return db.RecurringDonations{}, nil
+					})
+			},
+			subject: "Your recurring donation to Clean water failed",
+		},
+		{
+			name: "SkipLast",
+			donation: func() db.RecurringDonations {
+				failing := donation
+				failing.FailedAttempts = defaultMaxAttempts - 1
+				return failing
+			}(),
+			campaign: func() db.IndexedCampaigns {
+				ending := campaign
+				ending.Deadline = time.Now().Add(24 * time.Hour)
+				return ending
+			}(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RecordRecurringDonationFailure(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.RecordRecurringDonationFailureParams) (db.RecurringDonations, error) {
+						require.Equal(t, interfaces.RecurringCompleted, arg.Status)
+						return db.RecurringDonations{}, nil
+					})
+			},
+			subject: "Your recurring donation to Clean water failed",
+		},
+		{
+			name:     "CampaignEnded",
+			donation: donation,
+			campaign: func() db.IndexedCampaigns {
+				ended := campaign
+				ended.Deadline = time.Now().Add(-time.Hour)
+				return ended
+			}(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateRecurringDonationStatus(gomock.Any(), gomock.Eq(db.UpdateRecurringDonationStatusParams{
+						Status:       interfaces.RecurringCompleted,
+						ID:           donation.ID,
+						FromStatuses: []string{interfaces.RecurringActive},
+					})).
+					Times(1).
+					Return(db.RecurringDonations{}, nil)
+				store.EXPECT().RecordRecurringDonationFailure(gomock.Any(), gomock.Any()).Times(0)
+			},
+			subject: "Your recurring donation to Clean water has ended",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().ClaimDueRecurringDonations(gomock.Any(), gomock.Any()).Times(1).Return([]db.RecurringDonations{tc.donation}, nil)
+			store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+			store.EXPECT().GetIndexedCampaign(gomock.Any(), gomock.Any()).Times(1).Return(tc.campaign, nil)
+			store.EXPECT().CreateDonationRecord(gomock.Any(), gomock.Any()).Times(0)
+			tc.buildStubs(store)
+
+			config := utils.Config{CryptoDeployURL: "https://sepolia.example.com"}
+			chains, err := defi.LoadChainRegistry(config)
+			require.NoError(t, err)
+
+			var subjects []string
+			worker := NewWorker(store, chains, config, func(to db.Users, subject string, details string) error {
+				require.Equal(t, user.Email, to.Email)
+				subjects = append(subjects, subject)
+				return nil
+			})
+
+			require.NoError(t, worker.RunDue(context.Background()))
+			require.Equal(t, []string{tc.subject}, subjects)
+		})
+	}
+}
@@ -0,0 +1,452 @@
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+// The approved actions whose gas is sponsored
+const (
+	ActionDonate         = "donate"
+	ActionCreateCampaign = "create_campaign"
+	ActionWithdraw       = "withdraw"
+	ActionExtendDeadline = "extend_deadline"
+	ActionCloseCampaign  = "close_campaign"
+)
+
+const (
+	// defaultBudget is the ether a user is sponsored per period when GAS_BUDGET is not configured
+	defaultBudget = 0.05
+	// defaultBudgetPeriod is used when GAS_BUDGET_PERIOD is not configured
+	defaultBudgetPeriod = 30 * 24 * time.Hour
+	// defaultMaxGrants is the number of top-ups a user gets per period when GAS_MAX_GRANTS is not configured
+	defaultMaxGrants = 10
+	// defaultDailyCap is the ether sponsored per chain per day when GAS_DAILY_CAP is not configured
+	defaultDailyCap = 1
+	// defaultWait is how long a top-up may take to be mined when GAS_WAIT is not configured
+	defaultWait = 2 * time.Minute
+	// pollInterval is how often a top-up's receipt is checked while waiting for it
+	pollInterval = 3 * time.Second
+	// priceHeadroom is the percentage added to the gas price a top-up is worked out at, since the
+	// action goes out at the price suggested once the top-up is mined
+	priceHeadroom = 25
+	// reconcileBatchSize is the number of sponsored actions ReconcileSponsorships checks per run
+	reconcileBatchSize = 100
+	// reconcileTimeout is how long a sponsored action may go unmined before its whole top-up is
+	// counted as spent
+	reconcileTimeout = 24 * time.Hour
+)
+
+// sponsoredActions are the actions whose gas can be sponsored
+var sponsoredActions = map[string]bool{
+	ActionDonate:         true,
+	ActionCreateCampaign: true,
+	ActionWithdraw:       true,
+	ActionExtendDeadline: true,
+	ActionCloseCampaign:  true,
+}
+
+var (
+	// ErrNoRelayerKey is returned by NewRelayer when RELAYER_PRIVATE_KEY is not set
+	ErrNoRelayerKey    = errors.New("RELAYER_PRIVATE_KEY is not set")
+	ErrUnverified      = errors.New("verify your email to have gas sponsored, or fund your wallet")
+	ErrGasPriceTooHigh = errors.New("gas is too expensive to sponsor right now, try again later or fund your wallet")
+	ErrDailyCapReached = errors.New("gas sponsorship is paused for today, try again later or fund your wallet")
+	ErrBudgetExceeded  = errors.New("your sponsored gas budget is used up, fund your wallet to continue")
+	ErrTooManyGrants   = errors.New("you have had too many sponsored transactions, fund your wallet to continue")
+	ErrTopUpFailed     = errors.New("gas top-up failed")
+)
+
+// gasChain is the part of a chain the relayer tops wallets up on
+type gasChain interface {
+	GasPrice(ctx context.Context) (*big.Int, error)
+	NativeBalance(ctx context.Context, address string) (*big.Int, error)
+	Transfer(ctx context.Context, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error)
+	WaitForTransaction(ctx context.Context, hash string, interval time.Duration) (bool, error)
+}
+
+// Relayer sponsors the gas of approved actions from custodial wallets that cannot pay for it. Custodial
+// accounts are plain accounts and the campaign contract reads msg.sender, so instead of forwarding a
+// signed request the relayer wallet sends the user's wallet the estimated gas of the action's call,
+// waits for it to be mined and lets the action go out as usual. Top-ups count against a per-user budget
+// and number of grants per period, a daily cap per chain and an optional gas price ceiling, and each
+// one is recorded and later reconciled with the gas the action used.
+type Relayer struct {
+	store       db.Store
+	key         *ecdsa.PrivateKey
+	address     string
+	budget      *big.Int
+	period      time.Duration
+	maxGrants   int32
+	dailyCap    *big.Int
+	maxGasPrice *big.Int
+	wait        time.Duration
+}
+
+// NewRelayer creates a relayer that pays from RELAYER_PRIVATE_KEY's wallet, which must hold the native
+// currency of every chain
+func NewRelayer(store db.Store, configs utils.Config) (*Relayer, error) {
+	if configs.RelayerKey == "" {
+		return nil, ErrNoRelayerKey
+	}
+	key, err := crypto.HexToECDSA(configs.RelayerKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RELAYER_PRIVATE_KEY: %w", err)
+	}
+
+	relayer := &Relayer{
+		store:     store,
+		key:       key,
+		address:   crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		budget:    etherToWei(configs.GasBudget, defaultBudget),
+		period:    configs.GasBudgetPeriod,
+		maxGrants: configs.GasMaxGrants,
+		dailyCap:  etherToWei(configs.GasDailyCap, defaultDailyCap),
+		wait:      configs.GasWait,
+	}
+	if relayer.period <= 0 {
+		relayer.period = defaultBudgetPeriod
+	}
+	if relayer.maxGrants <= 0 {
+		relayer.maxGrants = defaultMaxGrants
+	}
+	if relayer.wait <= 0 {
+		relayer.wait = defaultWait
+	}
+	if configs.GasMaxPrice > 0 {
+		// GAS_MAX_PRICE is in gwei
+		relayer.maxGasPrice = etherToWei(configs.GasMaxPrice/1e9, 0)
+	}
+
+	return relayer, nil
+}
+
+// Address returns the relayer wallet's address
+func (relayer *Relayer) Address() string {
+	return relayer.address
+}
+
+// Sponsor tops up a user's custodial wallet with the gas an approved action's call needs and waits for
+// the top-up to be mined. gas is the call's gas limit, as estimated by defi, and value is the wei the
+// action sends, such as a donation's amount, which is never sponsored. It returns the recorded top-up,
+// or nil when the wallet already covers the action or cannot cover its value.
+func (relayer *Relayer) Sponsor(ctx context.Context, chain *defi.Chain, user db.Users, action string, gas uint64, value *big.Int) (*db.GasSponsorships, error) {
+	return relayer.sponsor(ctx, chain.ID, chain, user, action, gas, value)
+}
+
+func (relayer *Relayer) sponsor(ctx context.Context, chainID int64, chain gasChain, user db.Users, action string, gas uint64, value *big.Int) (*db.GasSponsorships, error) {
+	if !sponsoredActions[action] {
+		return nil, fmt.Errorf("gas is not sponsored for %s", action)
+	}
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	gasPrice, err := chain.GasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := chain.NativeBalance(ctx, user.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	price := new(big.Int).Mul(gasPrice, big.NewInt(100+priceHeadroom))
+	price.Quo(price, big.NewInt(100))
+	need := new(big.Int).Mul(price, new(big.Int).SetUint64(gas))
+	need.Add(need, value)
+	if balance.Cmp(need) >= 0 || balance.Cmp(value) < 0 {
+		return nil, nil
+	}
+	amount := need.Sub(need, balance)
+
+	if !user.IsEmailVerified {
+		return nil, ErrUnverified
+	}
+	if relayer.maxGasPrice != nil && gasPrice.Cmp(relayer.maxGasPrice) > 0 {
+		return nil, ErrGasPriceTooHigh
+	}
+
+	sponsored, err := relayer.store.SumGasSponsored(ctx, db.SumGasSponsoredParams{
+		ChainID:   chainID,
+		CreatedAt: time.Now().Add(-24 * time.Hour),
+	})
+	if err != nil {
+		return nil, err
+	}
+	today, ok := new(big.Int).SetString(sponsored, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid sponsored gas total %q", sponsored)
+	}
+	if today.Add(today, amount).Cmp(relayer.dailyCap) > 0 {
+		log.Warn().Int64("chain_id", chainID).Str("sponsored", sponsored).Msg("daily gas sponsorship cap reached")
+		return nil, ErrDailyCapReached
+	}
+
+	if err := relayer.reserve(ctx, chainID, user.Username, amount); err != nil {
+		return nil, err
+	}
+
+	sponsorship, err := relayer.store.CreateGasSponsorship(ctx, db.CreateGasSponsorshipParams{
+		ChainID:  chainID,
+		Username: user.Username,
+		Address:  user.Address,
+		Action:   action,
+		GasPrice: price.String(),
+		Amount:   amount.String(),
+	})
+	if err != nil {
+		relayer.release(ctx, chainID, user.Username, amount)
+		return nil, err
+	}
+
+	hash, err := chain.Transfer(ctx, user.Address, amount, relayer.key)
+	if err != nil {
+		relayer.fail(ctx, &sponsorship)
+		return nil, fmt.Errorf("cannot send gas top-up: %w", err)
+	}
+
+	sponsorship.Status = "sent"
+	sponsorship.TxHash = sql.NullString{String: hash, Valid: true}
+	if err := relayer.store.MarkGasSponsorshipSent(ctx, db.MarkGasSponsorshipSentParams{ID: sponsorship.ID, TxHash: sponsorship.TxHash}); err != nil {
+		log.Error().Err(err).Int64("sponsorship_id", sponsorship.ID).Str("tx_hash", hash).Msg("cannot record gas top-up")
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, relayer.wait)
+	defer cancel()
+	success, err := chain.WaitForTransaction(waitCtx, hash, pollInterval)
+	if err != nil {
+		// the top-up may still be mined, so it stays sent and counted against the budget
+		return nil, fmt.Errorf("gas top-up %s is not mined yet, try again shortly: %w", hash, err)
+	}
+	if !success {
+		relayer.fail(ctx, &sponsorship)
+		return nil, ErrTopUpFailed
+	}
+
+	sponsorship.Status = "confirmed"
+	if err := relayer.store.UpdateGasSponsorshipStatus(ctx, db.UpdateGasSponsorshipStatusParams{ID: sponsorship.ID, Status: sponsorship.Status}); err != nil {
+		log.Error().Err(err).Int64("sponsorship_id", sponsorship.ID).Msg("cannot confirm gas top-up")
+	}
+
+	return &sponsorship, nil
+}
+
+// reserve counts a top-up against the user's budget, or returns the limit it would break
+func (relayer *Relayer) reserve(ctx context.Context, chainID int64, username string, amount *big.Int) error {
+	_, err := relayer.store.ReserveGasBudget(ctx, db.ReserveGasBudgetParams{
+		Username:  username,
+		ChainID:   chainID,
+		Amount:    amount.String(),
+		Budget:    relayer.budget.String(),
+		Since:     time.Now().Add(-relayer.period),
+		MaxGrants: relayer.maxGrants,
+	})
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	budget, err := relayer.store.GetGasBudget(ctx, db.GetGasBudgetParams{Username: username, ChainID: chainID})
+	if err == nil && budget.Grants >= relayer.maxGrants {
+		return ErrTooManyGrants
+	}
+	return ErrBudgetExceeded
+}
+
+// release gives a top-up that was never sent back to the user's budget
+func (relayer *Relayer) release(ctx context.Context, chainID int64, username string, amount *big.Int) {
+	err := relayer.store.ReleaseGasBudget(ctx, db.ReleaseGasBudgetParams{
+		Amount:   amount.String(),
+		Username: username,
+		ChainID:  chainID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("chain_id", chainID).Str("username", username).Msg("cannot release gas budget")
+	}
+}
+
+// fail records a top-up that did not reach the wallet and releases it from the user's budget
+func (relayer *Relayer) fail(ctx context.Context, sponsorship *db.GasSponsorships) {
+	sponsorship.Status = "failed"
+	if err := relayer.store.UpdateGasSponsorshipStatus(ctx, db.UpdateGasSponsorshipStatusParams{ID: sponsorship.ID, Status: sponsorship.Status}); err != nil {
+		log.Error().Err(err).Int64("sponsorship_id", sponsorship.ID).Msg("cannot record failed gas top-up")
+	}
+
+	amount, _ := new(big.Int).SetString(sponsorship.Amount, 10)
+	relayer.release(ctx, sponsorship.ChainID, sponsorship.Username, amount)
+}
+
+// RecordAction links a sponsored action's transaction to its top-up
+func (relayer *Relayer) RecordAction(ctx context.Context, sponsorship *db.GasSponsorships, hash string) {
+	err := relayer.store.SetGasSponsorshipAction(ctx, db.SetGasSponsorshipActionParams{
+		ID:           sponsorship.ID,
+		ActionTxHash: sql.NullString{String: hash, Valid: true},
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("sponsorship_id", sponsorship.ID).Str("tx_hash", hash).Msg("cannot record sponsored action")
+	}
+}
+
+// receiptChain is the part of a chain sponsored actions are reconciled on
+type receiptChain interface {
+	GetTransactionStatus(hash string) (*defi.TransactionStatus, error)
+}
+
+// ReconcileSponsorships settles the top-ups of mined sponsored actions with the gas the actions paid.
+// A top-up's unused part stays in the user's wallet, where it covers the next action, and is given back
+// to the user's budget if the budget period it was counted in has not run out. The least recently
+// checked actions are checked first, and an action still unmined after reconcileTimeout is settled
+// with its whole top-up spent, so actions that are never mined do not hold up the rest.
+func ReconcileSponsorships(ctx context.Context, store db.Store, chains *defi.ChainRegistry) error {
+	return reconcileSponsorships(ctx, store, func(chainID int64) (receiptChain, error) {
+		return chains.Get(chainID)
+	})
+}
+
+func reconcileSponsorships(ctx context.Context, store db.Store, chainFor func(chainID int64) (receiptChain, error)) error {
+	sponsorships, err := store.ListUnreconciledGasSponsorships(ctx, reconcileBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, sponsorship := range sponsorships {
+		logger := log.With().Int64("sponsorship_id", sponsorship.ID).Str("action_tx_hash", sponsorship.ActionTxHash.String).Logger()
+
+		if err := store.MarkGasSponsorshipChecked(ctx, sponsorship.ID); err != nil {
+			return err
+		}
+
+		amount, ok := new(big.Int).SetString(sponsorship.Amount, 10)
+		if !ok {
+			return fmt.Errorf("invalid gas top-up amount %q", sponsorship.Amount)
+		}
+
+		chain, err := chainFor(sponsorship.ChainID)
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot reconcile gas top-up")
+			continue
+		}
+		status, err := chain.GetTransactionStatus(sponsorship.ActionTxHash.String)
+		spent := amount
+		switch {
+		case errors.Is(err, defi.ErrTransactionPending):
+			if time.Since(sponsorship.CreatedAt) < reconcileTimeout {
+				continue
+			}
+			logger.Warn().Msg("sponsored action was never mined")
+		case err != nil:
+			logger.Error().Err(err).Msg("cannot fetch sponsored action receipt")
+			continue
+		case status.GasCost == nil:
+			logger.Warn().Msg("provider does not report the gas cost of sponsored actions")
+			continue
+		default:
+			spent = status.GasCost
+		}
+		if spent.Cmp(amount) > 0 {
+			// the user's own balance paid the rest
+			spent = amount
+		}
+
+		if _, err := store.ReconcileGasSponsorship(ctx, db.ReconcileGasSponsorshipParams{
+			ID:    sponsorship.ID,
+			Spent: sql.NullString{String: spent.String(), Valid: true},
+		}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// reconciled by a concurrent run
+				continue
+			}
+			return err
+		}
+
+		unused := new(big.Int).Sub(amount, spent)
+		if unused.Sign() > 0 {
+			if err := store.RefundGasBudget(ctx, db.RefundGasBudgetParams{
+				Amount:      unused.String(),
+				Username:    sponsorship.Username,
+				ChainID:     sponsorship.ChainID,
+				SponsoredAt: sponsorship.CreatedAt,
+			}); err != nil {
+				return err
+			}
+		}
+		logger.Info().Str("spent", spent.String()).Str("unused", unused.String()).Msg("gas top-up reconciled")
+	}
+
+	return nil
+}
+
+// Budget returns what is left of a user's budget on a chain
+func (relayer *Relayer) Budget(ctx context.Context, username string, chainID int64) (interfaces.GasBudget, error) {
+	rsp := interfaces.GasBudget{
+		ChainID:   chainID,
+		Budget:    utils.WeiToEther(relayer.budget.String()),
+		Remaining: utils.WeiToEther(relayer.budget.String()),
+		MaxGrants: relayer.maxGrants,
+	}
+
+	budget, err := relayer.store.GetGasBudget(ctx, db.GetGasBudgetParams{Username: username, ChainID: chainID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return rsp, nil
+	}
+	if err != nil {
+		return interfaces.GasBudget{}, err
+	}
+
+	resetsAt := budget.PeriodStart.Add(relayer.period)
+	if !resetsAt.After(time.Now()) {
+		// the period has run out, so the next top-up starts a new one
+		return rsp, nil
+	}
+
+	rsp.Spent = utils.WeiToEther(budget.Spent)
+	rsp.Remaining = rsp.Budget - rsp.Spent
+	if rsp.Remaining < 0 {
+		rsp.Remaining = 0
+	}
+	rsp.Grants = budget.Grants
+	rsp.ResetsAt = &resetsAt
+	return rsp, nil
+}
+
+// Summary accounts for the gas sponsored on every chain since a date
+func (relayer *Relayer) Summary(ctx context.Context, since time.Time) (interfaces.GasSponsorshipSummary, error) {
+	totals, err := relayer.store.SummarizeGasSponsorships(ctx, since)
+	if err != nil {
+		return interfaces.GasSponsorshipSummary{}, err
+	}
+
+	rsp := interfaces.GasSponsorshipSummary{
+		RelayerAddress: relayer.address,
+		Since:          since,
+		Totals:         make([]interfaces.GasSponsorshipTotal, 0, len(totals)),
+	}
+	for _, total := range totals {
+		rsp.Totals = append(rsp.Totals, interfaces.NewGasSponsorshipTotal(total))
+	}
+	return rsp, nil
+}
+
+// etherToWei converts a configured ether amount to wei from its decimal text, so 0.05 is exactly
+// 5e16 wei, using fallback when the amount is not set
+func etherToWei(ether float64, fallback float64) *big.Int {
+	if ether <= 0 {
+		ether = fallback
+	}
+	amount, _ := new(big.Rat).SetString(strconv.FormatFloat(ether, 'f', -1, 64))
+	amount.Mul(amount, new(big.Rat).SetInt(big.NewInt(1e18)))
+	return new(big.Int).Quo(amount.Num(), amount.Denom())
+}
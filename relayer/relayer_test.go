@@ -0,0 +1,343 @@
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	mockdb "github.com/demola234/defiraise/db/mock"
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChain struct {
+	gasPrice *big.Int
+	balance  *big.Int
+	sendErr  error
+	mined    bool
+	sent     []*big.Int
+}
+
+func (chain *fakeChain) GasPrice(ctx context.Context) (*big.Int, error) {
+	return chain.gasPrice, nil
+}
+
+func (chain *fakeChain) NativeBalance(ctx context.Context, address string) (*big.Int, error) {
+	return chain.balance, nil
+}
+
+func (chain *fakeChain) Transfer(ctx context.Context, to string, amount *big.Int, key *ecdsa.PrivateKey) (string, error) {
+	if chain.sendErr != nil {
+		return "", chain.sendErr
+	}
+	chain.sent = append(chain.sent, amount)
+	return "0xtopup", nil
+}
+
+func (chain *fakeChain) WaitForTransaction(ctx context.Context, hash string, interval time.Duration) (bool, error) {
+	return chain.mined, nil
+}
+
+func TestNewRelayer(t *testing.T) {
+	_, err := NewRelayer(nil, utils.Config{})
+	require.ErrorIs(t, err, ErrNoRelayerKey)
+
+	_, err = NewRelayer(nil, utils.Config{RelayerKey: "not a key"})
+	require.Error(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	relayer, err := NewRelayer(nil, utils.Config{RelayerKey: hexKey(key), GasMaxPrice: 50})
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey).Hex(), relayer.Address())
+	require.Equal(t, "50000000000000000", relayer.budget.String())
+	require.Equal(t, "50000000000", relayer.maxGasPrice.String())
+	require.Equal(t, int32(defaultMaxGrants), relayer.maxGrants)
+}
+
+func TestSponsor(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	user := db.Users{Username: utils.RandomString(6), Address: "0xabc0000000000000000000000000000000000001", IsEmailVerified: true}
+	gasPrice := big.NewInt(1000000000)
+	// a donation of 1000 wei estimated at 2,400,000 gas needs 3,000,000 gwei on top at 1 gwei with the
+	// price headroom, and the wallet holds 1000 wei more
+	gas := uint64(2400000)
+	value := big.NewInt(1000)
+	balance := big.NewInt(2000)
+	amount := "2999999999999000"
+	sponsorship := db.GasSponsorships{ID: 5, ChainID: 1, Username: user.Username, Amount: amount, Status: "pending"}
+
+	testCases := []struct {
+		name       string
+		user       db.Users
+		chain      *fakeChain
+		buildStubs func(store *mockdb.MockStore)
+		check      func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error)
+	}{
+		{
+			name:  "OK",
+			user:  user,
+			chain: &fakeChain{gasPrice: gasPrice, balance: balance, mined: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SumGasSponsored(gomock.Any(), gomock.Any()).Times(1).Return("0", nil)
+				store.EXPECT().
+					ReserveGasBudget(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.ReserveGasBudgetParams) (db.GasBudgets, error) {
+						require.Equal(t, amount, arg.Amount)
+						require.Equal(t, user.Username, arg.Username)
+						return db.GasBudgets{Spent: arg.Amount, Grants: 1}, nil
+					})
+				store.EXPECT().
+					CreateGasSponsorship(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.CreateGasSponsorshipParams) (db.GasSponsorships, error) {
+						require.Equal(t, "1250000000", arg.GasPrice)
+						require.Equal(t, amount, arg.Amount)
+						return sponsorship, nil
+					})
+				store.EXPECT().
+					MarkGasSponsorshipSent(gomock.Any(), gomock.Eq(db.MarkGasSponsorshipSentParams{ID: sponsorship.ID, TxHash: sql.NullString{String: "0xtopup", Valid: true}})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					UpdateGasSponsorshipStatus(gomock.Any(), gomock.Eq(db.UpdateGasSponsorshipStatusParams{ID: sponsorship.ID, Status: "confirmed"})).
+					Times(1).
+					Return(nil)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "confirmed", sponsorship.Status)
+				require.Len(t, chain.sent, 1)
+				require.Equal(t, amount, chain.sent[0].String())
+			},
+		},
+		{
+			name:  "Covered",
+			user:  user,
+			chain: &fakeChain{gasPrice: gasPrice, balance: big.NewInt(3000000000001000)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReserveGasBudget(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.NoError(t, err)
+				require.Nil(t, sponsorship)
+				require.Empty(t, chain.sent)
+			},
+		},
+		{
+			name:  "Unverified",
+			user:  db.Users{Username: user.Username, Address: user.Address},
+			chain: &fakeChain{gasPrice: gasPrice, balance: balance},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReserveGasBudget(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name:  "GasPriceTooHigh",
+			user:  user,
+			chain: &fakeChain{gasPrice: big.NewInt(200000000000), balance: balance},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReserveGasBudget(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.ErrorIs(t, err, ErrGasPriceTooHigh)
+			},
+		},
+		{
+			name:  "DailyCapReached",
+			user:  user,
+			chain: &fakeChain{gasPrice: gasPrice, balance: balance},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SumGasSponsored(gomock.Any(), gomock.Any()).Times(1).Return("999000000000000000", nil)
+				store.EXPECT().ReserveGasBudget(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.ErrorIs(t, err, ErrDailyCapReached)
+			},
+		},
+		{
+			name:  "BudgetExceeded",
+			user:  user,
+			chain: &fakeChain{gasPrice: gasPrice, balance: balance},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SumGasSponsored(gomock.Any(), gomock.Any()).Times(1).Return("0", nil)
+				store.EXPECT().ReserveGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{}, sql.ErrNoRows)
+				store.EXPECT().GetGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{Spent: "49000000000000000", Grants: 3}, nil)
+				store.EXPECT().CreateGasSponsorship(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.ErrorIs(t, err, ErrBudgetExceeded)
+			},
+		},
+		{
+			name:  "TooManyGrants",
+			user:  user,
+			chain: &fakeChain{gasPrice: gasPrice, balance: balance},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SumGasSponsored(gomock.Any(), gomock.Any()).Times(1).Return("0", nil)
+				store.EXPECT().ReserveGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{}, sql.ErrNoRows)
+				store.EXPECT().GetGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{Spent: "1000", Grants: defaultMaxGrants}, nil)
+				store.EXPECT().CreateGasSponsorship(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.ErrorIs(t, err, ErrTooManyGrants)
+			},
+		},
+		{
+			name:  "SendError",
+			user:  user,
+			chain: &fakeChain{gasPrice: gasPrice, balance: balance, sendErr: errors.New("insufficient funds")},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SumGasSponsored(gomock.Any(), gomock.Any()).Times(1).Return("0", nil)
+				store.EXPECT().ReserveGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{}, nil)
+				store.EXPECT().CreateGasSponsorship(gomock.Any(), gomock.Any()).Times(1).Return(sponsorship, nil)
+				store.EXPECT().
+					UpdateGasSponsorshipStatus(gomock.Any(), gomock.Eq(db.UpdateGasSponsorshipStatusParams{ID: sponsorship.ID, Status: "failed"})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					ReleaseGasBudget(gomock.Any(), gomock.Eq(db.ReleaseGasBudgetParams{Amount: amount, Username: user.Username, ChainID: sponsorship.ChainID})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().MarkGasSponsorshipSent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, chain *fakeChain, sponsorship *db.GasSponsorships, err error) {
+				require.ErrorContains(t, err, "insufficient funds")
+				require.Nil(t, sponsorship)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			relayer, err := NewRelayer(store, utils.Config{RelayerKey: hexKey(key), GasMaxPrice: 100})
+			require.NoError(t, err)
+
+			sponsorship, err := relayer.sponsor(context.Background(), 1, tc.chain, tc.user, ActionDonate, gas, value)
+			tc.check(t, tc.chain, sponsorship, err)
+		})
+	}
+}
+
+type fakeReceipts map[string]*defi.TransactionStatus
+
+func (receipts fakeReceipts) GetTransactionStatus(hash string) (*defi.TransactionStatus, error) {
+	status, ok := receipts[hash]
+	if !ok {
+		return nil, defi.ErrTransactionPending
+	}
+	return status, nil
+}
+
+func TestReconcileSponsorships(t *testing.T) {
+	sponsoredAt := time.Now().Add(-time.Hour)
+	sponsorship := func(id int64, hash string) db.GasSponsorships {
+		return db.GasSponsorships{
+			ID:           id,
+			ChainID:      1,
+			Username:     "alice",
+			Amount:       "3000000000000000",
+			Status:       "confirmed",
+			ActionTxHash: sql.NullString{String: hash, Valid: true},
+			CreatedAt:    sponsoredAt,
+		}
+	}
+	receipts := fakeReceipts{
+		// the donation used 2,000,000 gas at 1 gwei of a top-up worked out for 3,000,000
+		"0xdonate": {Success: true, GasCost: big.NewInt(2000000000000000)},
+		// the user's own balance paid for more than the top-up
+		"0xcreate": {Success: true, GasCost: big.NewInt(4000000000000000)},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	// an action that was never mined counts its whole top-up as spent
+	dropped := sponsorship(4, "0xdropped")
+	dropped.CreatedAt = time.Now().Add(-reconcileTimeout - time.Hour)
+
+	store.EXPECT().
+		ListUnreconciledGasSponsorships(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.GasSponsorships{sponsorship(1, "0xdonate"), sponsorship(2, "0xcreate"), sponsorship(3, "0xpending"), dropped}, nil)
+	store.EXPECT().MarkGasSponsorshipChecked(gomock.Any(), gomock.Any()).Times(4).Return(nil)
+	store.EXPECT().
+		ReconcileGasSponsorship(gomock.Any(), gomock.Eq(db.ReconcileGasSponsorshipParams{ID: 1, Spent: sql.NullString{String: "2000000000000000", Valid: true}})).
+		Times(1).
+		Return(db.GasSponsorships{}, nil)
+	store.EXPECT().
+		RefundGasBudget(gomock.Any(), gomock.Eq(db.RefundGasBudgetParams{Amount: "1000000000000000", Username: "alice", ChainID: 1, SponsoredAt: sponsoredAt})).
+		Times(1).
+		Return(nil)
+	store.EXPECT().
+		ReconcileGasSponsorship(gomock.Any(), gomock.Eq(db.ReconcileGasSponsorshipParams{ID: 2, Spent: sql.NullString{String: "3000000000000000", Valid: true}})).
+		Times(1).
+		Return(db.GasSponsorships{}, nil)
+	store.EXPECT().
+		ReconcileGasSponsorship(gomock.Any(), gomock.Eq(db.ReconcileGasSponsorshipParams{ID: 4, Spent: sql.NullString{String: "3000000000000000", Valid: true}})).
+		Times(1).
+		Return(db.GasSponsorships{}, nil)
+
+	err := reconcileSponsorships(context.Background(), store, func(chainID int64) (receiptChain, error) {
+		return receipts, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestBudget(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	relayer, err := NewRelayer(store, utils.Config{RelayerKey: hexKey(key), GasBudget: 0.1, GasBudgetPeriod: 24 * time.Hour})
+	require.NoError(t, err)
+
+	store.EXPECT().GetGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{}, sql.ErrNoRows)
+	budget, err := relayer.Budget(context.Background(), "alice", 1)
+	require.NoError(t, err)
+	require.Equal(t, 0.1, budget.Remaining)
+	require.Nil(t, budget.ResetsAt)
+
+	store.EXPECT().GetGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{PeriodStart: time.Now().Add(-time.Hour), Spent: "40000000000000000", Grants: 2}, nil)
+	budget, err = relayer.Budget(context.Background(), "alice", 1)
+	require.NoError(t, err)
+	require.InDelta(t, 0.06, budget.Remaining, 1e-9)
+	require.Equal(t, int32(2), budget.Grants)
+	require.NotNil(t, budget.ResetsAt)
+
+	// a budget whose period has run out is reported as unused
+	store.EXPECT().GetGasBudget(gomock.Any(), gomock.Any()).Times(1).Return(db.GasBudgets{PeriodStart: time.Now().Add(-48 * time.Hour), Spent: "40000000000000000", Grants: 2}, nil)
+	budget, err = relayer.Budget(context.Background(), "alice", 1)
+	require.NoError(t, err)
+	require.Equal(t, 0.1, budget.Remaining)
+	require.Zero(t, budget.Grants)
+}
+
+func hexKey(key *ecdsa.PrivateKey) string {
+	return hex.EncodeToString(crypto.FromECDSA(key))
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/interfaces"
+	"github.com/demola234/defiraise/quadratic"
+	"github.com/demola234/defiraise/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// settleRounds moves the funding rounds on one contract version along: a round that has started
+// records each participating campaign's on-chain donations at starts_at as its baseline, a round that
+// has ended has its allocations calculated from the donations made up to ends_at, and the allocations
+// of a round a moderator has approved are paid to the campaign owners from the round's wallet.
+func settleRounds(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, dryRun bool) error {
+	now := time.Now()
+	failed := 0
+
+	steps := []struct {
+		status string
+		due    func(round db.FundingRounds) bool
+		run    func(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, round db.FundingRounds, dryRun bool) error
+	}{
+		{status: interfaces.RoundScheduled, due: func(round db.FundingRounds) bool { return !now.Before(round.StartsAt) }, run: openRound},
+		{status: interfaces.RoundOpen, due: func(round db.FundingRounds) bool { return !now.Before(round.EndsAt) }, run: calculateRound},
+		{status: interfaces.RoundApproved, due: func(db.FundingRounds) bool { return true }, run: payRound},
+	}
+	for _, step := range steps {
+		rounds, err := store.ListFundingRoundsByStatus(ctx, db.ListFundingRoundsByStatusParams{
+			ChainID:         chain.ID,
+			ContractVersion: int32(chain.Version),
+			Status:          step.status,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, round := range rounds {
+			if !step.due(round) {
+				continue
+			}
+			if err := step.run(ctx, configs, store, chain, round, dryRun); err != nil {
+				log.Error().Err(err).Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("round_id", round.ID).Str("status", round.Status).Msg("cannot settle funding round")
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d funding rounds on chain %d could not be settled", failed, chain.ID)
+	}
+	return nil
+}
+
+// openRound records the baseline of a round that has started from the contract state at the last
+// block mined before starts_at, so donations made after starts_at count for the round however late
+// this runs.
+func openRound(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, round db.FundingRounds, dryRun bool) error {
+	logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("round_id", round.ID).Logger()
+
+	if dryRun {
+		logger.Info().Msg("funding round would open")
+		return nil
+	}
+
+	block, err := chain.BlockAt(ctx, round.StartsAt)
+	if err != nil {
+		return err
+	}
+
+	for _, campaignID := range round.CampaignIds {
+		contributions, _, err := chain.DonorContributionsAt(ctx, campaignID, block)
+		if err != nil {
+			return err
+		}
+		for donor, amount := range contributions {
+			// a rerun after a partial baseline keeps the amounts recorded first
+			if err := store.CreateFundingRoundBaseline(ctx, db.CreateFundingRoundBaselineParams{
+				RoundID:      round.ID,
+				CampaignID:   campaignID,
+				DonorAddress: donor,
+				Amount:       amount.String(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := store.OpenFundingRound(ctx, round.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	logger.Info().Int("campaigns", len(round.CampaignIds)).Str("block", block.String()).Msg("funding round opened")
+	return nil
+}
+
+// calculateRound aggregates the donations each campaign received during an ended round per unique
+// donor and splits the round's matching amount between the campaigns. The donations are read at the
+// last block mined before ends_at, so donations made after the round do not count.
+func calculateRound(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, round db.FundingRounds, dryRun bool) error {
+	logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("round_id", round.ID).Logger()
+
+	filter := quadratic.FilterFor(store, round)
+	min, _ := new(big.Int).SetString(round.MinContribution, 10)
+
+	block, err := chain.BlockAt(ctx, round.EndsAt)
+	if err != nil {
+		return err
+	}
+
+	campaigns := make(map[int64][]quadratic.Contribution, len(round.CampaignIds))
+	for _, campaignID := range round.CampaignIds {
+		baselines, err := store.ListFundingRoundBaselines(ctx, db.ListFundingRoundBaselinesParams{
+			RoundID:    round.ID,
+			CampaignID: campaignID,
+		})
+		if err != nil {
+			return err
+		}
+		baseline := make(map[string]*big.Int, len(baselines))
+		for _, entry := range baselines {
+			amount, _ := new(big.Int).SetString(entry.Amount, 10)
+			baseline[strings.ToLower(entry.DonorAddress)] = amount
+		}
+
+		current, _, err := chain.DonorContributionsAt(ctx, campaignID, block)
+		if err != nil {
+			return err
+		}
+
+		campaigns[campaignID], err = quadratic.Aggregate(ctx, filter, baseline, current, min)
+		if err != nil {
+			return err
+		}
+	}
+
+	pot, _ := new(big.Int).SetString(round.MatchingAmount, 10)
+	allocations := quadratic.Allocate(pot, campaigns)
+
+	if dryRun {
+		for campaignID, allocation := range allocations {
+			logger.Info().Int64("campaign_id", campaignID).Int32("contributors", allocation.Contributors).Str("match", allocation.Match.String()).Msg("campaign would be allocated")
+		}
+		return nil
+	}
+
+	for campaignID, contributions := range campaigns {
+		for _, contribution := range contributions {
+			if err := store.UpsertFundingRoundContribution(ctx, db.UpsertFundingRoundContributionParams{
+				RoundID:    round.ID,
+				CampaignID: campaignID,
+				Donor:      contribution.Donor,
+				Amount:     contribution.Amount.String(),
+				Eligible:   contribution.Eligible,
+			}); err != nil {
+				return err
+			}
+		}
+
+		allocation := allocations[campaignID]
+		if _, err := store.UpsertFundingRoundAllocation(ctx, db.UpsertFundingRoundAllocationParams{
+			RoundID:              round.ID,
+			CampaignID:           campaignID,
+			Contributors:         allocation.Contributors,
+			ExcludedContributors: allocation.ExcludedContributors,
+			Contributed:          allocation.Contributed.String(),
+			MatchAmount:          allocation.Match.String(),
+		}); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	if _, err := store.MarkFundingRoundCalculated(ctx, round.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	logger.Info().Int("campaigns", len(allocations)).Str("block", block.String()).Msg("funding round calculated")
+	return nil
+}
+
+// payRound sends each unpaid allocation of an approved round to the campaign owner from the round's
+// wallet. An allocation is claimed before it is sent; one left claimed by a run that stopped before
+// recording the transaction must be checked by hand rather than sent twice.
+func payRound(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, round db.FundingRounds, dryRun bool) error {
+	logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("round_id", round.ID).Logger()
+
+	allocations, err := store.ListFundingRoundAllocations(ctx, round.ID)
+	if err != nil {
+		return err
+	}
+
+	var privateKey *ecdsa.PrivateKey
+	failed := 0
+	for _, allocation := range allocations {
+		amount, _ := new(big.Int).SetString(allocation.MatchAmount, 10)
+		if allocation.Status != interfaces.AllocationPending || amount == nil || amount.Sign() == 0 {
+			continue
+		}
+		logger := logger.With().Int64("campaign_id", allocation.CampaignID).Str("amount", allocation.MatchAmount).Logger()
+
+		campaign, err := store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+			ChainID:         chain.ID,
+			ContractVersion: int32(chain.Version),
+			CampaignID:      allocation.CampaignID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			logger.Info().Str("owner", campaign.Owner).Msg("allocation would be paid")
+			continue
+		}
+
+		if privateKey == nil {
+			key, _, err := defi.DecryptPrivateKey(round.FilePath, configs.PassPhase)
+			if err != nil {
+				return err
+			}
+			privateKey = key
+		}
+
+		if _, err := store.ClaimFundingRoundAllocation(ctx, db.ClaimFundingRoundAllocationParams{
+			RoundID:    round.ID,
+			CampaignID: allocation.CampaignID,
+		}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// claimed by a concurrent run
+				continue
+			}
+			return err
+		}
+
+		hash, sendErr := chain.Transfer(ctx, campaign.Owner, amount, privateKey)
+		if sendErr != nil {
+			logger.Error().Err(sendErr).Msg("cannot pay allocation")
+			failed++
+			if _, err := store.ReleaseFundingRoundAllocation(ctx, db.ReleaseFundingRoundAllocationParams{
+				RoundID:    round.ID,
+				CampaignID: allocation.CampaignID,
+				LastError:  sql.NullString{String: sendErr.Error(), Valid: true},
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := store.MarkFundingRoundAllocationSent(ctx, db.MarkFundingRoundAllocationSentParams{
+			RoundID:    round.ID,
+			CampaignID: allocation.CampaignID,
+			TxHash:     sql.NullString{String: hash, Valid: true},
+		}); err != nil {
+			return err
+		}
+		logger.Info().Str("tx_hash", hash).Msg("allocation paid")
+	}
+
+	if dryRun {
+		return nil
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d allocations could not be paid", failed)
+	}
+
+	unpaid, err := store.CountUnpaidFundingRoundAllocations(ctx, round.ID)
+	if err != nil {
+		return err
+	}
+	if unpaid == 0 {
+		if _, err := store.MarkFundingRoundPaid(ctx, round.ID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		logger.Info().Msg("funding round paid")
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	db "github.com/demola234/defiraise/db/sqlc"
+	"github.com/demola234/defiraise/defi"
+	"github.com/demola234/defiraise/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+// settle releases the funds of the campaigns on one contract version that are past their deadline:
+// to the owner when the goal was reached, otherwise back to the donors. Only the owner can settle a
+// campaign, so campaigns whose owner has no wallet on this platform are skipped. The milestone total
+// of a funded campaign with milestones moves on from the owner's wallet into escrow, see releaseMilestones.
+// Proposals whose vote has ended are closed first, see closeProposals, and funding rounds are moved
+// along last, see settleRounds.
+func settle(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, dryRun bool) error {
+	// a passed proposal can approve a milestone that is released below
+	proposalsErr := closeProposals(ctx, configs, store, chain, dryRun)
+
+	ended, err := store.ListEndedCampaigns(ctx, db.ListEndedCampaignsParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		Deadline:        time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, campaign := range ended {
+		logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("campaign_id", campaign.CampaignID).Logger()
+
+		// the index may lag behind a payout, so check the contract still holds funds
+		goal, funds, err := chain.CampaignFunds(campaign.CampaignID)
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot read campaign")
+			failed++
+			continue
+		}
+		if funds.Sign() == 0 {
+			continue
+		}
+
+		action := "refund"
+		if funds.Cmp(goal) >= 0 {
+			action = "payout"
+		}
+		logger = logger.With().Str("action", action).Str("funds", funds.String()).Logger()
+
+		owner, err := store.GetUserByAddress(ctx, campaign.Owner)
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn().Str("owner", campaign.Owner).Msg("owner has no wallet here, skipping")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			logger.Info().Msg("campaign would be settled")
+			continue
+		}
+
+		privateKey, address, err := defi.DecryptPrivateKey(owner.FilePath, configs.PassPhase)
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot unlock owner wallet")
+			failed++
+			continue
+		}
+
+		var hash string
+		if action == "payout" {
+			hash, err = chain.PayOut(int(campaign.CampaignID), address, privateKey)
+		} else {
+			hash, err = chain.SendBackDonations(int(campaign.CampaignID), address, privateKey)
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot settle campaign")
+			failed++
+			continue
+		}
+
+		logger.Info().Str("tx_hash", hash).Msg("campaign settled")
+
+		if action == "payout" {
+			if err := escrowMilestones(ctx, store, chain, campaign.CampaignID, hash); err != nil {
+				logger.Error().Err(err).Msg("cannot escrow campaign milestones")
+				failed++
+			}
+		}
+	}
+
+	if err := releaseMilestones(ctx, configs, store, chain, dryRun); err != nil {
+		return err
+	}
+
+	roundsErr := settleRounds(ctx, configs, store, chain, dryRun)
+
+	if failed > 0 {
+		return fmt.Errorf("%d campaigns on chain %d could not be settled", failed, chain.ID)
+	}
+	if proposalsErr != nil {
+		return proposalsErr
+	}
+	return roundsErr
+}
+
+// escrowMilestones records that the milestone total of a campaign that was just paid out is owed to
+// escrow. releaseMilestones deposits it from the owner's wallet once the payout is mined, later in the
+// same run or on a later one, so settle does not wait for the payout. Campaigns without milestones
+// keep the whole payout.
+func escrowMilestones(ctx context.Context, store db.Store, chain *defi.Chain, campaignID int64, payoutHash string) error {
+	milestones, err := store.ListCampaignMilestones(ctx, db.ListCampaignMilestonesParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      campaignID,
+	})
+	if err != nil || len(milestones) == 0 {
+		return err
+	}
+
+	total := new(big.Int)
+	for _, milestone := range milestones {
+		amount, _ := new(big.Int).SetString(milestone.Amount, 10)
+		total.Add(total, amount)
+	}
+
+	_, err = store.CreateCampaignEscrow(ctx, db.CreateCampaignEscrowParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+		CampaignID:      campaignID,
+		Amount:          total.String(),
+		PayoutTxHash:    payoutHash,
+	})
+	return err
+}
+
+// escrowAccount returns the ESCROW_PRIVATE_KEY account, or an empty address when it is not set
+func escrowAccount(configs utils.Config) (*ecdsa.PrivateKey, string, error) {
+	if configs.EscrowKey == "" {
+		return nil, "", nil
+	}
+	escrowKey, err := crypto.HexToECDSA(configs.EscrowKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid ESCROW_PRIVATE_KEY: %w", err)
+	}
+	return escrowKey, crypto.PubkeyToAddress(escrowKey.PublicKey).Hex(), nil
+}
+
+// depositEscrow sends a campaign's milestone total from the owner's wallet to escrow and records the deposit
+func depositEscrow(ctx context.Context, store db.Store, chain *defi.Chain, escrow db.CampaignEscrows, escrowAddress string, ownerKey *ecdsa.PrivateKey) error {
+	amount, _ := new(big.Int).SetString(escrow.Amount, 10)
+	hash, err := chain.Transfer(ctx, escrowAddress, amount, ownerKey)
+	if err != nil {
+		return fmt.Errorf("cannot deposit milestone funds into escrow: %w", err)
+	}
+
+	if _, err := store.SetCampaignEscrowDeposit(ctx, db.SetCampaignEscrowDepositParams{
+		ChainID:         escrow.ChainID,
+		ContractVersion: escrow.ContractVersion,
+		CampaignID:      escrow.CampaignID,
+		DepositTxHash:   sql.NullString{String: hash, Valid: true},
+	}); err != nil {
+		return err
+	}
+	log.Info().Int64("chain_id", escrow.ChainID).Int32("version", escrow.ContractVersion).Int64("campaign_id", escrow.CampaignID).
+		Str("tx_hash", hash).Msg("milestone funds deposited into escrow")
+	return nil
+}
+
+// releaseMilestones finishes moving escrowed milestone funds: the milestone total of a mined payout is
+// deposited from the owner's wallet into the ESCROW_PRIVATE_KEY account, and once the deposit
+// succeeded, every approved milestone is sent from escrow to the owner.
+// The owner keeps the funds above the milestone total, which pay for the deposit's gas.
+func releaseMilestones(ctx context.Context, configs utils.Config, store db.Store, chain *defi.Chain, dryRun bool) error {
+	escrows, err := store.ListUndepositedCampaignEscrows(ctx, db.ListUndepositedCampaignEscrowsParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+	})
+	if err != nil {
+		return err
+	}
+	approved, err := store.ListApprovedCampaignMilestones(ctx, db.ListApprovedCampaignMilestonesParams{
+		ChainID:         chain.ID,
+		ContractVersion: int32(chain.Version),
+	})
+	if err != nil {
+		return err
+	}
+	if len(escrows) == 0 && len(approved) == 0 {
+		return nil
+	}
+
+	escrowKey, escrowAddress, err := escrowAccount(configs)
+	if err != nil {
+		return err
+	}
+	if escrowAddress == "" {
+		log.Warn().Int64("chain_id", chain.ID).Int("version", chain.Version).Msg("ESCROW_PRIVATE_KEY is not set, skipping milestone escrow")
+		return nil
+	}
+
+	failed := 0
+	for _, escrow := range escrows {
+		logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("campaign_id", escrow.CampaignID).Logger()
+
+		status, err := chain.GetTransactionStatus(escrow.PayoutTxHash)
+		if errors.Is(err, defi.ErrTransactionPending) {
+			continue
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot read payout transaction")
+			failed++
+			continue
+		}
+		if !status.Success {
+			// the contract still holds the funds, so the next run pays the campaign out again
+			logger.Warn().Str("tx_hash", escrow.PayoutTxHash).Msg("payout failed, dropping escrow")
+			if err := store.DeleteCampaignEscrow(ctx, db.DeleteCampaignEscrowParams{
+				ChainID:         escrow.ChainID,
+				ContractVersion: escrow.ContractVersion,
+				CampaignID:      escrow.CampaignID,
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		campaign, err := store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+			ChainID:         escrow.ChainID,
+			ContractVersion: escrow.ContractVersion,
+			CampaignID:      escrow.CampaignID,
+		})
+		if err != nil {
+			return err
+		}
+		owner, err := store.GetUserByAddress(ctx, campaign.Owner)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			logger.Info().Str("amount", escrow.Amount).Msg("milestone funds would be deposited into escrow")
+			continue
+		}
+
+		privateKey, _, err := defi.DecryptPrivateKey(owner.FilePath, configs.PassPhase)
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot unlock owner wallet")
+			failed++
+			continue
+		}
+
+		if err := depositEscrow(ctx, store, chain, escrow, escrowAddress, privateKey); err != nil {
+			logger.Error().Err(err).Msg("cannot deposit milestone funds into escrow")
+			failed++
+		}
+	}
+
+	for _, milestone := range approved {
+		logger := log.With().Int64("chain_id", chain.ID).Int("version", chain.Version).Int64("campaign_id", milestone.CampaignID).Int64("milestone_id", milestone.ID).Logger()
+
+		escrow, err := store.GetCampaignEscrow(ctx, db.GetCampaignEscrowParams{
+			ChainID:         milestone.ChainID,
+			ContractVersion: milestone.ContractVersion,
+			CampaignID:      milestone.CampaignID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			// the campaign has not been paid out yet
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !escrow.DepositTxHash.Valid {
+			continue
+		}
+
+		status, err := chain.GetTransactionStatus(escrow.DepositTxHash.String)
+		if errors.Is(err, defi.ErrTransactionPending) {
+			continue
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot read escrow deposit transaction")
+			failed++
+			continue
+		}
+		if !status.Success {
+			// clear the deposit so the next run sends it again
+			logger.Warn().Str("tx_hash", escrow.DepositTxHash.String).Msg("escrow deposit failed")
+			if _, err := store.SetCampaignEscrowDeposit(ctx, db.SetCampaignEscrowDepositParams{
+				ChainID:         escrow.ChainID,
+				ContractVersion: escrow.ContractVersion,
+				CampaignID:      escrow.CampaignID,
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		campaign, err := store.GetIndexedCampaign(ctx, db.GetIndexedCampaignParams{
+			ChainID:         milestone.ChainID,
+			ContractVersion: milestone.ContractVersion,
+			CampaignID:      milestone.CampaignID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			logger.Info().Str("amount", milestone.Amount).Msg("milestone would be released")
+			continue
+		}
+
+		amount, _ := new(big.Int).SetString(milestone.Amount, 10)
+		hash, err := chain.Transfer(ctx, campaign.Owner, amount, escrowKey)
+		if err != nil {
+			logger.Error().Err(err).Msg("cannot release milestone")
+			failed++
+			continue
+		}
+
+		if _, err := store.ReleaseCampaignMilestone(ctx, db.ReleaseCampaignMilestoneParams{
+			ID:            milestone.ID,
+			ReleaseTxHash: sql.NullString{String: hash, Valid: true},
+		}); err != nil {
+			return err
+		}
+		logger.Info().Str("tx_hash", hash).Msg("milestone released")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d milestone transfers on chain %d failed", failed, chain.ID)
+	}
+	return nil
+}
@@ -24,6 +24,47 @@ type Config struct {
 	EmailPass            string        `mapstructure:"EMAIL_PASS"`
 	PassPhase            string        `mapstructure:"PASS_PHASE"`
 	RedisHost            string        `mapstructure:"REDIS_HOST"`
+	IndexerInterval      time.Duration `mapstructure:"INDEXER_INTERVAL"`
+	PriceInterval        time.Duration `mapstructure:"PRICE_INTERVAL"`
+	PriceSources         string        `mapstructure:"PRICE_SOURCES"`
+	PriceMaxAge          time.Duration `mapstructure:"PRICE_MAX_AGE"`
+	PriceCacheTTL        time.Duration `mapstructure:"PRICE_CACHE_TTL"`
+	PriceChainlink       string        `mapstructure:"PRICE_CHAINLINK"`
+	ChainlinkFeeds       string        `mapstructure:"CHAINLINK_FEEDS"`
+	TokenRegistry        string        `mapstructure:"TOKEN_REGISTRY"`
+	ChainsFile           string        `mapstructure:"CHAINS_FILE"`
+	DefaultChainID       int64         `mapstructure:"DEFAULT_CHAIN_ID"`
+	RPCMaxBlockLag       uint64        `mapstructure:"RPC_MAX_BLOCK_LAG"`
+	RPCMaxLatency        time.Duration `mapstructure:"RPC_MAX_LATENCY"`
+	RPCHealthInterval    time.Duration `mapstructure:"RPC_HEALTH_INTERVAL"`
+	ABICheck             string        `mapstructure:"ABI_CHECK"`
+	EscrowKey            string        `mapstructure:"ESCROW_PRIVATE_KEY"`
+	EscrowGasPrice       float64       `mapstructure:"ESCROW_GAS_PRICE"`
+	Moderators           string        `mapstructure:"MODERATORS"`
+	MaxDeadlineExtension time.Duration `mapstructure:"MAX_DEADLINE_EXTENSION"`
+	DeadlineVote         bool          `mapstructure:"DEADLINE_EXTENSION_VOTE"`
+	RecurringInterval    time.Duration `mapstructure:"RECURRING_INTERVAL"`
+	RecurringRetryDelay  time.Duration `mapstructure:"RECURRING_RETRY_DELAY"`
+	RecurringMaxAttempts int           `mapstructure:"RECURRING_MAX_ATTEMPTS"`
+	MatchingInterval     time.Duration `mapstructure:"MATCHING_INTERVAL"`
+	BadgeMinterKey       string        `mapstructure:"BADGE_MINTER_KEY"`
+	BadgeInterval        time.Duration `mapstructure:"BADGE_INTERVAL"`
+	RelayerKey           string        `mapstructure:"RELAYER_PRIVATE_KEY"`
+	GasBudget            float64       `mapstructure:"GAS_BUDGET"`
+	GasBudgetPeriod      time.Duration `mapstructure:"GAS_BUDGET_PERIOD"`
+	GasMaxGrants         int32         `mapstructure:"GAS_MAX_GRANTS"`
+	GasDailyCap          float64       `mapstructure:"GAS_DAILY_CAP"`
+	GasMaxPrice          float64       `mapstructure:"GAS_MAX_PRICE"`
+	GasWait              time.Duration `mapstructure:"GAS_WAIT"`
+	FaucetKey            string        `mapstructure:"FAUCET_PRIVATE_KEY"`
+	FaucetAmount         float64       `mapstructure:"FAUCET_AMOUNT"`
+	FaucetToken          string        `mapstructure:"FAUCET_TOKEN"`
+	FaucetTokenAmount    float64       `mapstructure:"FAUCET_TOKEN_AMOUNT"`
+	FaucetIPLimit        int64         `mapstructure:"FAUCET_IP_LIMIT"`
+	FaucetAlertGrants    int64         `mapstructure:"FAUCET_ALERT_GRANTS"`
+	FaucetAlertEmail     string        `mapstructure:"FAUCET_ALERT_EMAIL"`
+	OnRampProvider       string        `mapstructure:"ONRAMP_PROVIDER"`
+	OnRampWebhookSecret  string        `mapstructure:"ONRAMP_WEBHOOK_SECRET"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"html/template"
+	"io"
 
 	"gopkg.in/gomail.v2"
 )
@@ -13,6 +14,15 @@ type EmailInfo struct {
 	Details string
 	Otp     string
 	Subject string
+	// Template is a file in <path>/html, defaulting to the OTP template.
+	// Emails with their own template also use their own Subject.
+	Template    string
+	Attachments []EmailAttachment
+}
+
+type EmailAttachment struct {
+	Filename string
+	Data     []byte
 }
 
 func SendEmail(emailAddr string, username string, info EmailInfo, path string) (string, error) {
@@ -22,8 +32,15 @@ func SendEmail(emailAddr string, username string, info EmailInfo, path string) (
 		return "", err
 	}
 
+	templateName := "template.html"
+	subject := "OTP for DefiFundr"
+	if info.Template != "" {
+		templateName = info.Template
+		subject = info.Subject
+	}
+
 	// load from file path
-	filePath := fmt.Sprintf("%s/html/template.html", path)
+	filePath := fmt.Sprintf("%s/html/%s", path, templateName)
 
 	tp, err := template.ParseFiles(filePath)
 
@@ -33,9 +50,10 @@ func SendEmail(emailAddr string, username string, info EmailInfo, path string) (
 
 	var tpl bytes.Buffer
 	err = tp.Execute(&tpl, struct {
-		Name string
-		Otp  string
-	}{Name: info.Name, Otp: info.Otp})
+		Name    string
+		Otp     string
+		Details string
+	}{Name: info.Name, Otp: info.Otp, Details: info.Details})
 	if err != nil {
 		return "", err
 	}
@@ -46,9 +64,17 @@ func SendEmail(emailAddr string, username string, info EmailInfo, path string) (
 	m := gomail.NewMessage()
 	m.SetHeader("From", fromEmail)
 	m.SetHeader("To", emailAddr)
-	m.SetHeader("Subject", "OTP for DefiFundr")
+	m.SetHeader("Subject", subject)
 	m.SetBody("text/html", tpl.String()) 
 
+	for _, attachment := range info.Attachments {
+		data := attachment.Data
+		m.Attach(attachment.Filename, gomail.SetCopyFunc(func(w io.Writer) error {
+			_, err := w.Write(data)
+			return err
+		}))
+	}
+
 	d := gomail.NewDialer("smtp.gmail.com", 465, fromEmail, password)
 
 	if err := d.DialAndSend(m); err != nil {
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// weiPerEther is the number of wei in one ether
+var weiPerEther = new(big.Float).SetInt(big.NewInt(1e18))
+
+// WeiToEther converts a base-10 wei amount, as stored in NUMERIC columns, to ether
+func WeiToEther(wei string) float64 {
+	amount, ok := new(big.Float).SetString(wei)
+	if !ok {
+		return 0
+	}
+
+	ether, _ := new(big.Float).Quo(amount, weiPerEther).Float64()
+	return ether
+}
+
+// EtherToWei converts an ether amount to a base-10 wei string for NUMERIC columns
+func EtherToWei(ether float64) string {
+	return EtherToWeiInt(ether).String()
+}
+
+// EtherToWeiInt converts an ether amount to wei from its shortest decimal text, so 0.1 is exactly
+// 1e17 wei rather than the binary float's 100000000000000005
+func EtherToWeiInt(ether float64) *big.Int {
+	amount, ok := new(big.Rat).SetString(strconv.FormatFloat(ether, 'f', -1, 64))
+	if !ok {
+		return new(big.Int)
+	}
+	amount.Mul(amount, new(big.Rat).SetInt(big.NewInt(1e18)))
+	return new(big.Int).Quo(amount.Num(), amount.Denom())
+}
+
+// WeiToFiat values a base-10 wei amount at a per-ether price and returns it rounded to cents
+func WeiToFiat(wei string, price string) (string, error) {
+	amount, ok := new(big.Float).SetString(wei)
+	if !ok {
+		return "", fmt.Errorf("invalid wei amount %q", wei)
+	}
+
+	unitPrice, ok := new(big.Float).SetString(price)
+	if !ok {
+		return "", fmt.Errorf("invalid price %q", price)
+	}
+
+	value := new(big.Float).Mul(amount, unitPrice)
+	value.Quo(value, weiPerEther)
+	return value.Text('f', 2), nil
+}
+
+// FormatUnits renders an integer token amount with the token's decimals, without trailing zeros
+func FormatUnits(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		return "0"
+	}
+
+	negative := amount.Sign() < 0
+	digits := new(big.Int).Abs(amount).String()
+	if decimals > 0 {
+		if len(digits) <= int(decimals) {
+			digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+		}
+		point := len(digits) - int(decimals)
+		digits = strings.TrimRight(digits[:point]+"."+digits[point:], "0")
+		digits = strings.TrimSuffix(digits, ".")
+	}
+
+	if negative {
+		return "-" + digits
+	}
+	return digits
+}
+
+// RoundCents rounds a fiat value to two decimal places
+func RoundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
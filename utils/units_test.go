@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeiToEther(t *testing.T) {
+	require.Equal(t, 1.5, WeiToEther("1500000000000000000"))
+	require.Equal(t, 0.0, WeiToEther("0"))
+	require.Equal(t, 0.0, WeiToEther("not-a-number"))
+	require.Equal(t, 100000.0, WeiToEther("100000000000000000000000"))
+}
+
+func TestEtherToWei(t *testing.T) {
+	require.Equal(t, "1500000000000000000", EtherToWei(1.5))
+	require.Equal(t, "0", EtherToWei(0))
+	require.Equal(t, "1000000000000000000000", EtherToWei(1000))
+	require.Equal(t, "100000000000000000", EtherToWei(0.1))
+	require.Equal(t, "70000000000000000", EtherToWei(0.07))
+	require.Equal(t, "12345678900000000000", EtherToWei(12.3456789))
+}
+
+func TestWeiToFiat(t *testing.T) {
+	value, err := WeiToFiat("1500000000000000000", "3250.10")
+	require.NoError(t, err)
+	require.Equal(t, "4875.15", value)
+
+	value, err = WeiToFiat("0", "3250.10")
+	require.NoError(t, err)
+	require.Equal(t, "0.00", value)
+
+	_, err = WeiToFiat("wei", "3250.10")
+	require.Error(t, err)
+
+	_, err = WeiToFiat("1", "price")
+	require.Error(t, err)
+}
+
+func TestFormatUnits(t *testing.T) {
+	require.Equal(t, "1.5", FormatUnits(big.NewInt(1500000), 6))
+	require.Equal(t, "0.000001", FormatUnits(big.NewInt(1), 6))
+	require.Equal(t, "12", FormatUnits(big.NewInt(12000000), 6))
+	require.Equal(t, "42", FormatUnits(big.NewInt(42), 0))
+	require.Equal(t, "-0.25", FormatUnits(big.NewInt(-250000000000000000), 18))
+	require.Equal(t, "0", FormatUnits(nil, 18))
+}
+
+func TestRoundCents(t *testing.T) {
+	require.Equal(t, 4875.15, RoundCents(4875.1499999))
+	require.Equal(t, 0.0, RoundCents(0.004))
+}